@@ -45,13 +45,18 @@ type hasDestination interface {
 	Destination() string
 }
 
+type hasDestinations interface {
+	Destinations() []string
+}
+
 // Envelope defines an envelope wrapped on action with some envelope metadata.
 type Envelope struct {
-	version  uint32
-	nonce    uint64
-	gasLimit uint64
-	payload  actionPayload
-	gasPrice *big.Int
+	version          uint32
+	nonce            uint64
+	gasLimit         uint64
+	payload          actionPayload
+	gasPrice         *big.Int
+	validUntilHeight uint64
 }
 
 // SealedEnvelope is a signed action envelope.
@@ -60,6 +65,8 @@ type SealedEnvelope struct {
 
 	srcPubkey keypair.PublicKey
 	signature []byte
+	// ethChainID is non-zero if the action is signed as an Ethereum transaction for the chain of the ID
+	ethChainID uint32
 }
 
 // Version returns the version
@@ -68,6 +75,22 @@ func (elp *Envelope) Version() uint32 { return elp.version }
 // Nonce returns the nonce
 func (elp *Envelope) Nonce() uint64 { return elp.nonce }
 
+// LastNonce returns the last nonce taken by the action, which differs from the nonce only for a bundle
+func (elp *Envelope) LastNonce() uint64 {
+	if b, ok := elp.payload.(*Bundle); ok {
+		return b.LastNonce()
+	}
+	return elp.nonce
+}
+
+// ValidUntilHeight returns the height of the last block the action can be included in, or 0 if it never expires
+func (elp *Envelope) ValidUntilHeight() uint64 { return elp.validUntilHeight }
+
+// Expired tells if the action can no longer be included in the block of the given height
+func (elp *Envelope) Expired(height uint64) bool {
+	return elp.validUntilHeight != 0 && height > elp.validUntilHeight
+}
+
 // Destination returns the destination address
 func (elp *Envelope) Destination() (string, bool) {
 	r, ok := elp.payload.(hasDestination)
@@ -78,6 +101,18 @@ func (elp *Envelope) Destination() (string, bool) {
 	return r.Destination(), true
 }
 
+// Destinations returns all the distinct destination addresses, which are more than one only for the actions sending
+// to many recipients
+func (elp *Envelope) Destinations() []string {
+	if r, ok := elp.payload.(hasDestinations); ok {
+		return r.Destinations()
+	}
+	if dst, ok := elp.Destination(); ok && dst != "" {
+		return []string{dst}
+	}
+	return nil
+}
+
 // GasLimit returns the gas limit
 func (elp *Envelope) GasLimit() uint64 { return elp.gasLimit }
 
@@ -106,9 +141,10 @@ func (elp *Envelope) Action() Action { return elp.payload }
 // Proto convert Envelope to protobuf format.
 func (elp *Envelope) Proto() *iotextypes.ActionCore {
 	actCore := &iotextypes.ActionCore{
-		Version:  elp.version,
-		Nonce:    elp.nonce,
-		GasLimit: elp.gasLimit,
+		Version:          elp.version,
+		Nonce:            elp.nonce,
+		GasLimit:         elp.gasLimit,
+		ValidUntilHeight: elp.validUntilHeight,
 	}
 	if elp.gasPrice != nil {
 		actCore.GasPrice = elp.gasPrice.String()
@@ -141,6 +177,10 @@ func (elp *Envelope) Proto() *iotextypes.ActionCore {
 		actCore.Action = &iotextypes.ActionCore_DepositToRewardingFund{DepositToRewardingFund: act.Proto()}
 	case *PutPollResult:
 		actCore.Action = &iotextypes.ActionCore_PutPollResult{PutPollResult: act.Proto()}
+	case *Bundle:
+		actCore.Action = &iotextypes.ActionCore_Bundle{Bundle: act.Proto()}
+	case *MultiTransfer:
+		actCore.Action = &iotextypes.ActionCore_MultiTransfer{MultiTransfer: act.Proto()}
 	default:
 		log.S().Panicf("Cannot convert type of action %T.\r\n", act)
 	}
@@ -159,6 +199,7 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 	elp.version = pbAct.GetVersion()
 	elp.nonce = pbAct.GetNonce()
 	elp.gasLimit = pbAct.GetGasLimit()
+	elp.validUntilHeight = pbAct.GetValidUntilHeight()
 	elp.gasPrice = &big.Int{}
 	elp.gasPrice.SetString(pbAct.GetGasPrice(), 10)
 
@@ -235,6 +276,18 @@ func (elp *Envelope) LoadProto(pbAct *iotextypes.ActionCore) error {
 			return err
 		}
 		elp.payload = act
+	case pbAct.GetBundle() != nil:
+		act := &Bundle{}
+		if err := act.LoadProto(pbAct.GetBundle()); err != nil {
+			return err
+		}
+		elp.payload = act
+	case pbAct.GetMultiTransfer() != nil:
+		act := &MultiTransfer{}
+		if err := act.LoadProto(pbAct.GetMultiTransfer()); err != nil {
+			return err
+		}
+		elp.payload = act
 	default:
 		return errors.Errorf("no applicable action to handle in action proto %+v", pbAct)
 	}
@@ -251,8 +304,16 @@ func (elp *Envelope) Hash() hash.Hash256 {
 	return hash.Hash256b(elp.ByteStream())
 }
 
-// Hash returns the hash value of SealedEnvelope.
+// Hash returns the hash value of SealedEnvelope, which is the hash of the Ethereum transaction if the action is signed
+// as one.
 func (sealed *SealedEnvelope) Hash() hash.Hash256 {
+	if sealed.ethChainID != 0 {
+		tx, err := sealed.signedEthTransaction()
+		if err != nil {
+			log.S().Panicf("Cannot convert action to ethereum transaction: %v", err)
+		}
+		return hash.BytesToHash256(tx.Hash().Bytes())
+	}
 	return hash.Hash256b(byteutil.Must(proto.Marshal(sealed.Proto())))
 }
 
@@ -272,6 +333,7 @@ func (sealed SealedEnvelope) Proto() *iotextypes.Action {
 		Core:         sealed.Envelope.Proto(),
 		SenderPubKey: sealed.srcPubkey.Bytes(),
 		Signature:    sealed.signature,
+		EthChainID:   sealed.ethChainID,
 	}
 }
 
@@ -295,6 +357,12 @@ func (sealed *SealedEnvelope) LoadProto(pbAct *iotextypes.Action) error {
 	if err := sealed.Envelope.LoadProto(pbAct.GetCore()); err != nil {
 		return err
 	}
+	sealed.ethChainID = pbAct.GetEthChainID()
+	if sealed.ethChainID != 0 {
+		if _, err := sealed.signedEthTransaction(); err != nil {
+			return err
+		}
+	}
 
 	sealed.payload.SetEnvelopeContext(*sealed)
 	return nil
@@ -342,6 +410,13 @@ func AssembleSealedEnvelope(act Envelope, pk keypair.PublicKey, sig []byte) Seal
 // Verify verifies the action using sender's public key
 func Verify(sealed SealedEnvelope) error {
 	hash := sealed.Envelope.Hash()
+	if sealed.ethChainID != 0 {
+		h, err := sealed.ethSigningHash()
+		if err != nil {
+			return err
+		}
+		copy(hash[:], h)
+	}
 	if len(sealed.Signature()) != SignatureLength {
 		return errors.New("incorrect length of signature")
 	}
@@ -8,9 +8,11 @@ package action
 
 import (
 	"fmt"
+	"math"
 	"math/big"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/test/testaddress"
@@ -38,3 +40,34 @@ func TestActionProto(t *testing.T) {
 
 	require.Equal(selp.Hash(), nselp.Hash())
 }
+
+func TestActionValidUntilHeight(t *testing.T) {
+	require := require.New(t)
+	tsf, err := NewTransfer(1, big.NewInt(10), testaddress.Addrinfo["bravo"].String(), nil, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasPrice(big.NewInt(10)).SetGasLimit(uint64(100000)).SetAction(tsf).Build()
+	require.Equal(uint64(0), elp.ValidUntilHeight())
+	require.False(elp.Expired(math.MaxUint64))
+
+	bd = &EnvelopeBuilder{}
+	expiring := bd.SetNonce(1).SetGasPrice(big.NewInt(10)).SetGasLimit(uint64(100000)).SetAction(tsf).
+		SetValidUntilHeight(10).Build()
+	require.False(expiring.Expired(10))
+	require.True(expiring.Expired(11))
+	// the height is signed along with the action
+	require.NotEqual(elp.Hash(), expiring.Hash())
+
+	selp, err := Sign(expiring, testaddress.Keyinfo["alfa"].PriKey)
+	require.NoError(err)
+	nselp := &SealedEnvelope{}
+	require.NoError(nselp.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), nselp.Hash())
+	require.Equal(uint64(10), nselp.ValidUntilHeight())
+	require.NoError(Verify(*nselp))
+
+	// the valid until height of a bundle is set on the bundle rather than the actions in it
+	_, err = NewBundle([]Envelope{expiring})
+	require.Equal(ErrBundle, errors.Cause(err))
+}
@@ -116,6 +116,12 @@ func (b *EnvelopeBuilder) SetGasPriceByBytes(buf []byte) *EnvelopeBuilder {
 	return b
 }
 
+// SetValidUntilHeight sets the height of the last block the action can be included in.
+func (b *EnvelopeBuilder) SetValidUntilHeight(h uint64) *EnvelopeBuilder {
+	b.elp.validUntilHeight = h
+	return b
+}
+
 // SetAction sets the action payload for the Envelope Builder is building.
 func (b *EnvelopeBuilder) SetAction(action actionPayload) *EnvelopeBuilder {
 	b.elp.payload = action
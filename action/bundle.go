@@ -0,0 +1,229 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+)
+
+// MaxBundleSize is the maximum number of actions in a bundle
+const MaxBundleSize = 16
+
+var _ hasDestinations = (*Bundle)(nil)
+
+var (
+	// ErrBundle indicates the error of a malformed bundle
+	ErrBundle = errors.New("invalid bundle")
+	// ErrBundleReverted indicates that a bundle is reverted as one of its actions fails
+	ErrBundleReverted = errors.New("bundle reverted")
+)
+
+// Bundle is an ordered list of actions from the same sender with consecutive nonces, which take effect all together or
+// not at all. The envelope of a bundle takes the nonce of the first action, and the sum of the gas limits of all the
+// actions, which share the gas price of the bundle
+type Bundle struct {
+	AbstractAction
+
+	actions []Envelope
+	// sealed are the actions sealed by the sender of the bundle, which are set along with the envelope context
+	sealed []SealedEnvelope
+}
+
+// NewBundle returns a Bundle instance of the given actions
+func NewBundle(actions []Envelope) (*Bundle, error) {
+	if len(actions) == 0 {
+		return nil, errors.Wrap(ErrBundle, "empty bundle")
+	}
+	var gasLimit uint64
+	for _, elp := range actions {
+		if math.MaxUint64-gasLimit < elp.GasLimit() {
+			return nil, errors.Wrap(ErrBundle, "gas limit overflows")
+		}
+		gasLimit += elp.GasLimit()
+	}
+	b := &Bundle{
+		AbstractAction: AbstractAction{
+			version:  actions[0].Version(),
+			nonce:    actions[0].Nonce(),
+			gasLimit: gasLimit,
+			gasPrice: actions[0].GasPrice(),
+		},
+		actions: actions,
+	}
+	if err := b.SanityCheck(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Actions returns the actions in the bundle sealed by the sender of the bundle. They are not signed on their own, but
+// are covered by the signature of the bundle
+func (b *Bundle) Actions() []SealedEnvelope {
+	sealed := make([]SealedEnvelope, len(b.sealed))
+	copy(sealed, b.sealed)
+	return sealed
+}
+
+// LastNonce returns the last nonce taken by the bundle
+func (b *Bundle) LastNonce() uint64 {
+	n := uint64(len(b.actions))
+	if n == 0 || b.Nonce() > math.MaxUint64-n {
+		return b.Nonce()
+	}
+	return b.Nonce() + n - 1
+}
+
+// SanityCheck validates that the actions in the bundle are consistent with each other and with the envelope of the
+// bundle
+func (b *Bundle) SanityCheck() error {
+	if len(b.actions) == 0 {
+		return errors.Wrap(ErrBundle, "empty bundle")
+	}
+	if len(b.actions) > MaxBundleSize {
+		return errors.Wrapf(ErrBundle, "%d actions exceed the maximum bundle size %d", len(b.actions), MaxBundleSize)
+	}
+	if b.Nonce() > math.MaxUint64-uint64(len(b.actions)) {
+		return errors.Wrap(ErrBundle, "nonce overflows")
+	}
+	var gasLimit uint64
+	for i, elp := range b.actions {
+		if _, ok := elp.Action().(*Bundle); ok {
+			return errors.Wrap(ErrBundle, "nested bundle")
+		}
+		if elp.ValidUntilHeight() != 0 {
+			return errors.Wrapf(ErrBundle, "action %d has its own valid until height instead of the bundle", i)
+		}
+		if elp.Nonce() != b.Nonce()+uint64(i) {
+			return errors.Wrapf(ErrBundle, "nonce %d of action %d is not consecutive to %d", elp.Nonce(), i, b.Nonce())
+		}
+		if elp.GasPrice().Cmp(b.GasPrice()) != 0 {
+			return errors.Wrapf(ErrBundle, "gas price %s of action %d differs from the bundle", elp.GasPrice(), i)
+		}
+		if math.MaxUint64-gasLimit < elp.GasLimit() {
+			return errors.Wrap(ErrBundle, "gas limit overflows")
+		}
+		gasLimit += elp.GasLimit()
+	}
+	if gasLimit != b.GasLimit() {
+		return errors.Wrapf(ErrBundle, "gas limit %d differs from the sum %d of the actions", b.GasLimit(), gasLimit)
+	}
+	return nil
+}
+
+// SetEnvelopeContext sets the SealedEnvelope context to the bundle and seals the actions in it
+func (b *Bundle) SetEnvelopeContext(selp SealedEnvelope) {
+	if b == nil {
+		return
+	}
+	b.AbstractAction.SetEnvelopeContext(selp)
+	b.sealed = make([]SealedEnvelope, 0, len(b.actions))
+	for _, elp := range b.actions {
+		sealed := SealedEnvelope{Envelope: elp, srcPubkey: selp.SrcPubkey()}
+		sealed.payload.SetEnvelopeContext(sealed)
+		b.sealed = append(b.sealed, sealed)
+	}
+}
+
+// ByteStream returns a raw byte stream of the bundle
+func (b *Bundle) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(b.Proto()))
+}
+
+// Proto converts the bundle to protobuf's Bundle
+func (b *Bundle) Proto() *iotextypes.Bundle {
+	pb := &iotextypes.Bundle{Actions: make([]*iotextypes.ActionCore, 0, len(b.actions))}
+	for _, elp := range b.actions {
+		pb.Actions = append(pb.Actions, elp.Proto())
+	}
+	return pb
+}
+
+// LoadProto converts a protobuf's Bundle to Bundle
+func (b *Bundle) LoadProto(pbAct *iotextypes.Bundle) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if b == nil {
+		return errors.New("nil action to load proto")
+	}
+	*b = Bundle{}
+	if len(pbAct.GetActions()) > MaxBundleSize {
+		return errors.Wrapf(ErrBundle, "%d actions exceed the maximum bundle size %d", len(pbAct.GetActions()), MaxBundleSize)
+	}
+	b.actions = make([]Envelope, 0, len(pbAct.GetActions()))
+	for _, pbCore := range pbAct.GetActions() {
+		if pbCore.GetBundle() != nil {
+			return errors.Wrap(ErrBundle, "nested bundle")
+		}
+		elp := Envelope{}
+		if err := elp.LoadProto(pbCore); err != nil {
+			return err
+		}
+		b.actions = append(b.actions, elp)
+	}
+	return nil
+}
+
+// IntrinsicGas returns the sum of the intrinsic gas of the actions in the bundle
+func (b *Bundle) IntrinsicGas() (uint64, error) {
+	var intrinsicGas uint64
+	for _, elp := range b.actions {
+		gas, err := elp.IntrinsicGas()
+		if err != nil {
+			return 0, err
+		}
+		if math.MaxUint64-intrinsicGas < gas {
+			return 0, ErrOutOfGas
+		}
+		intrinsicGas += gas
+	}
+	return intrinsicGas, nil
+}
+
+// Cost returns the sum of the costs of the actions in the bundle
+func (b *Bundle) Cost() (*big.Int, error) {
+	cost := big.NewInt(0)
+	for _, elp := range b.actions {
+		c, err := elp.Cost()
+		if err != nil {
+			return nil, errors.Wrap(err, "error when getting cost of the action in bundle")
+		}
+		cost.Add(cost, c)
+	}
+	return cost, nil
+}
+
+// Destinations returns the distinct destinations of the actions in the bundle
+func (b *Bundle) Destinations() []string {
+	seen := make(map[string]bool)
+	dsts := make([]string, 0, len(b.actions))
+	for _, elp := range b.actions {
+		for _, dst := range elp.Destinations() {
+			if seen[dst] {
+				continue
+			}
+			seen[dst] = true
+			dsts = append(dsts, dst)
+		}
+	}
+	return dsts
+}
+
+// Unbundle returns the actions in the bundle if the given action is a bundle, or the action itself otherwise
+func Unbundle(selp SealedEnvelope) []SealedEnvelope {
+	if b, ok := selp.Action().(*Bundle); ok {
+		return b.Actions()
+	}
+	return []SealedEnvelope{selp}
+}
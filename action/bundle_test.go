@@ -0,0 +1,124 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func bundledTransfer(t *testing.T, nonce uint64, amount int64, gasPrice int64) Envelope {
+	tsf, err := NewTransfer(nonce, big.NewInt(amount), testaddress.Addrinfo["alfa"].String(), nil, 10000, big.NewInt(gasPrice))
+	require.NoError(t, err)
+	bd := &EnvelopeBuilder{}
+	return bd.SetNonce(nonce).SetGasPrice(big.NewInt(gasPrice)).SetGasLimit(10000).SetAction(tsf).Build()
+}
+
+func TestNewBundle(t *testing.T) {
+	require := require.New(t)
+
+	_, err := NewBundle(nil)
+	require.Equal(ErrBundle, errors.Cause(err))
+
+	// nonces are not consecutive
+	_, err = NewBundle([]Envelope{bundledTransfer(t, 1, 1, 1), bundledTransfer(t, 3, 1, 1)})
+	require.Equal(ErrBundle, errors.Cause(err))
+
+	// gas prices differ
+	_, err = NewBundle([]Envelope{bundledTransfer(t, 1, 1, 1), bundledTransfer(t, 2, 1, 2)})
+	require.Equal(ErrBundle, errors.Cause(err))
+
+	// nonce overflows
+	_, err = NewBundle([]Envelope{bundledTransfer(t, math.MaxUint64, 1, 1), bundledTransfer(t, 0, 1, 1)})
+	require.Equal(ErrBundle, errors.Cause(err))
+
+	// too many actions
+	actions := make([]Envelope, 0, MaxBundleSize+1)
+	for i := uint64(1); i <= MaxBundleSize+1; i++ {
+		actions = append(actions, bundledTransfer(t, i, 1, 1))
+	}
+	_, err = NewBundle(actions)
+	require.Equal(ErrBundle, errors.Cause(err))
+
+	// nested bundle
+	inner, err := NewBundle([]Envelope{bundledTransfer(t, 2, 1, 1)})
+	require.NoError(err)
+	bd := &EnvelopeBuilder{}
+	nested := bd.SetNonce(2).SetGasPrice(big.NewInt(1)).SetGasLimit(10000).SetAction(inner).Build()
+	_, err = NewBundle([]Envelope{bundledTransfer(t, 1, 1, 1), nested})
+	require.Equal(ErrBundle, errors.Cause(err))
+
+	b, err := NewBundle([]Envelope{bundledTransfer(t, 1, 1, 1), bundledTransfer(t, 2, 2, 1), bundledTransfer(t, 3, 3, 1)})
+	require.NoError(err)
+	require.Equal(uint64(1), b.Nonce())
+	require.Equal(uint64(3), b.LastNonce())
+	require.Equal(uint64(30000), b.GasLimit())
+	require.Equal(big.NewInt(1), b.GasPrice())
+	intrinsicGas, err := b.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(3*TransferBaseIntrinsicGas, intrinsicGas)
+	cost, err := b.Cost()
+	require.NoError(err)
+	require.Equal(big.NewInt(6+3*int64(TransferBaseIntrinsicGas)), cost)
+}
+
+func TestBundleSignAndLoadProto(t *testing.T) {
+	require := require.New(t)
+	senderKey := testaddress.Keyinfo["producer"]
+
+	b, err := NewBundle([]Envelope{bundledTransfer(t, 1, 1, 1), bundledTransfer(t, 2, 2, 1)})
+	require.NoError(err)
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(b.Nonce()).SetGasPrice(b.GasPrice()).SetGasLimit(b.GasLimit()).SetAction(b).Build()
+	require.Equal(uint64(2), elp.LastNonce())
+	selp, err := Sign(elp, senderKey.PriKey)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+
+	unbundled := Unbundle(selp)
+	require.Equal(2, len(unbundled))
+	for i, act := range unbundled {
+		require.Equal(uint64(i+1), act.Nonce())
+		require.Equal(senderKey.PubKey, act.SrcPubkey())
+		tsf, ok := act.Action().(*Transfer)
+		require.True(ok)
+		require.Equal(big.NewInt(int64(i+1)), tsf.Amount())
+	}
+
+	loaded := SealedEnvelope{}
+	require.NoError(loaded.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), loaded.Hash())
+	require.NoError(Verify(loaded))
+	require.Equal(uint64(2), loaded.LastNonce())
+	require.Equal(2, len(Unbundle(loaded)))
+
+	// a single action is unbundled to itself
+	tsf, err := Sign(bundledTransfer(t, 3, 1, 1), senderKey.PriKey)
+	require.NoError(err)
+	require.Equal(uint64(3), tsf.LastNonce())
+	require.Equal([]SealedEnvelope{tsf}, Unbundle(tsf))
+
+	// nested bundle is rejected when loading the proto
+	innerElp := bundledTransfer(t, 2, 1, 1)
+	pb := selp.Proto()
+	pb.Core.GetBundle().Actions[1] = &iotextypes.ActionCore{
+		Nonce:    2,
+		GasPrice: "1",
+		GasLimit: 10000,
+		Action: &iotextypes.ActionCore_Bundle{
+			Bundle: &iotextypes.Bundle{Actions: []*iotextypes.ActionCore{innerElp.Proto()}},
+		},
+	}
+	require.Equal(ErrBundle, errors.Cause(loaded.LoadProto(pb)))
+}
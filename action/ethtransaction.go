@@ -0,0 +1,153 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
+	"github.com/iotexproject/iotex-core/pkg/version"
+)
+
+// ErrEthTransaction indicates error of an action signed as an Ethereum transaction
+var ErrEthTransaction = errors.New("invalid ethereum transaction")
+
+// EthChainID returns the ID of the chain the action is signed for as an Ethereum transaction, or 0 if the action core
+// is signed
+func (sealed *SealedEnvelope) EthChainID() uint32 { return sealed.ethChainID }
+
+// DecodeEthTransaction decodes the RLP encoded and EIP-155 signed Ethereum transaction into the action it stands for,
+// which is a transfer if the transaction sends tokens to an address without any data, or an execution otherwise
+func DecodeEthTransaction(raw []byte, chainID uint32) (SealedEnvelope, error) {
+	tx := &types.Transaction{}
+	if err := rlp.DecodeBytes(raw, tx); err != nil {
+		return SealedEnvelope{}, errors.Wrap(err, "failed to decode ethereum transaction")
+	}
+	if !tx.Protected() || tx.ChainId().Cmp(new(big.Int).SetUint64(uint64(chainID))) != 0 {
+		return SealedEnvelope{}, errors.Wrapf(ErrEthTransaction, "transaction is not signed for chain %d", chainID)
+	}
+	var payload actionPayload
+	switch {
+	case tx.To() != nil && len(tx.Data()) == 0:
+		recipient, err := address.FromBytes(tx.To().Bytes())
+		if err != nil {
+			return SealedEnvelope{}, err
+		}
+		payload, _ = NewTransfer(tx.Nonce(), tx.Value(), recipient.String(), nil, tx.Gas(), tx.GasPrice())
+	default:
+		contract := EmptyAddress
+		if tx.To() != nil {
+			addr, err := address.FromBytes(tx.To().Bytes())
+			if err != nil {
+				return SealedEnvelope{}, err
+			}
+			contract = addr.String()
+		}
+		payload, _ = NewExecution(contract, tx.Nonce(), tx.Value(), tx.Gas(), tx.GasPrice(), tx.Data())
+	}
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(tx.Nonce()).
+		SetGasLimit(tx.Gas()).
+		SetGasPrice(tx.GasPrice()).
+		SetAction(payload).Build()
+
+	// Turn the signature into the [R || S || V] format where V is 0 or 1
+	v, r, s := tx.RawSignatureValues()
+	recID := new(big.Int).Sub(v, new(big.Int).SetUint64(uint64(chainID)*2+35))
+	if !recID.IsUint64() || recID.Uint64() > 1 {
+		return SealedEnvelope{}, errors.Wrap(ErrEthTransaction, "invalid signature value v")
+	}
+	sig := make([]byte, SignatureLength)
+	copy(sig[32-len(r.Bytes()):32], r.Bytes())
+	copy(sig[64-len(s.Bytes()):64], s.Bytes())
+	sig[64] = byte(recID.Uint64())
+	h := types.NewEIP155Signer(tx.ChainId()).Hash(tx)
+	pub, err := crypto.SigToPub(h[:], sig)
+	if err != nil {
+		return SealedEnvelope{}, errors.Wrap(err, "failed to recover sender's public key")
+	}
+	pk, err := keypair.BytesToPublicKey(crypto.FromECDSAPub(pub))
+	if err != nil {
+		return SealedEnvelope{}, err
+	}
+	sealed := SealedEnvelope{
+		Envelope:   elp,
+		srcPubkey:  pk,
+		signature:  sig,
+		ethChainID: chainID,
+	}
+	sealed.payload.SetEnvelopeContext(sealed)
+	return sealed, nil
+}
+
+// ethTransaction returns the unsigned Ethereum transaction standing for the envelope. Only a transfer without payload,
+// or an execution with data or deploying a contract, can be signed as an Ethereum transaction, so that an action has
+// only one transaction and a transaction only one action.
+func (elp *Envelope) ethTransaction() (*types.Transaction, error) {
+	if elp.version != version.ProtocolVersion || elp.validUntilHeight != 0 {
+		return nil, errors.Wrap(ErrEthTransaction, "action has fields out of ethereum transaction")
+	}
+	var (
+		to     string
+		amount *big.Int
+		data   []byte
+	)
+	switch act := elp.payload.(type) {
+	case *Transfer:
+		if len(act.Payload()) != 0 || act.Recipient() == EmptyAddress {
+			return nil, errors.Wrap(ErrEthTransaction, "transfer with payload or without recipient")
+		}
+		to, amount = act.Recipient(), act.Amount()
+	case *Execution:
+		if len(act.Data()) == 0 && act.Contract() != EmptyAddress {
+			return nil, errors.Wrap(ErrEthTransaction, "execution calling a contract without data")
+		}
+		to, amount, data = act.Contract(), act.Amount(), act.Data()
+	default:
+		return nil, errors.Wrapf(ErrEthTransaction, "action of type %T", act)
+	}
+	if amount == nil {
+		amount = big.NewInt(0)
+	}
+	if to == EmptyAddress {
+		return types.NewContractCreation(elp.nonce, amount, elp.gasLimit, elp.GasPrice(), data), nil
+	}
+	addr, err := address.FromString(to)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewTransaction(elp.nonce, common.BytesToAddress(addr.Bytes()), amount, elp.gasLimit, elp.GasPrice(), data), nil
+}
+
+// ethSigningHash returns the hash signed by the sender of the Ethereum transaction standing for the action
+func (sealed *SealedEnvelope) ethSigningHash() ([]byte, error) {
+	tx, err := sealed.ethTransaction()
+	if err != nil {
+		return nil, err
+	}
+	h := types.NewEIP155Signer(new(big.Int).SetUint64(uint64(sealed.ethChainID))).Hash(tx)
+	return h[:], nil
+}
+
+// signedEthTransaction returns the signed Ethereum transaction standing for the action
+func (sealed *SealedEnvelope) signedEthTransaction() (*types.Transaction, error) {
+	if len(sealed.signature) != SignatureLength {
+		return nil, errors.New("incorrect length of signature")
+	}
+	tx, err := sealed.ethTransaction()
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(types.NewEIP155Signer(new(big.Int).SetUint64(uint64(sealed.ethChainID))), sealed.signature)
+}
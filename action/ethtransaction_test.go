@@ -0,0 +1,88 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestEthTransaction(t *testing.T) {
+	require := require.New(t)
+
+	const chainID = 4689
+	sk := testaddress.Keyinfo["alfa"].PriKey
+	bravo := testaddress.Addrinfo["bravo"]
+	signer := types.NewEIP155Signer(big.NewInt(chainID))
+	sign := func(tx *types.Transaction) []byte {
+		tx, err := types.SignTx(tx, signer, sk.EcdsaPrivateKey())
+		require.NoError(err)
+		raw, err := rlp.EncodeToBytes(tx)
+		require.NoError(err)
+		return raw
+	}
+
+	// a transaction sending tokens without data is a transfer
+	tx := types.NewTransaction(1, common.BytesToAddress(bravo.Bytes()), big.NewInt(10), 10000, big.NewInt(100), nil)
+	selp, err := DecodeEthTransaction(sign(tx), chainID)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+	require.Equal(uint32(chainID), selp.EthChainID())
+	require.Equal(sk.PublicKey().Bytes(), selp.SrcPubkey().Bytes())
+	signed, err := types.SignTx(tx, signer, sk.EcdsaPrivateKey())
+	require.NoError(err)
+	h := selp.Hash()
+	require.Equal(signed.Hash().Bytes(), h[:])
+	tsf, ok := selp.Action().(*Transfer)
+	require.True(ok)
+	require.Equal(bravo.String(), tsf.Recipient())
+	require.Equal(big.NewInt(10), tsf.Amount())
+	require.Equal(uint64(1), selp.Nonce())
+
+	// the action keeps the signature and the hash through the proto
+	nselp := SealedEnvelope{}
+	require.NoError(nselp.LoadProto(selp.Proto()))
+	require.NoError(Verify(nselp))
+	require.Equal(h, nselp.Hash())
+
+	// a transaction with data, or without recipient, is an execution
+	tx = types.NewContractCreation(2, big.NewInt(0), 100000, big.NewInt(100), []byte{1, 2})
+	selp, err = DecodeEthTransaction(sign(tx), chainID)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+	ex, ok := selp.Action().(*Execution)
+	require.True(ok)
+	require.Equal(EmptyAddress, ex.Contract())
+	require.Equal([]byte{1, 2}, ex.Data())
+
+	// the transaction signed for another chain, or not for a chain, is rejected
+	_, err = DecodeEthTransaction(sign(tx), chainID+1)
+	require.Equal(ErrEthTransaction, errors.Cause(err))
+	unprotected, err := types.SignTx(tx, types.HomesteadSigner{}, sk.EcdsaPrivateKey())
+	require.NoError(err)
+	raw, err := rlp.EncodeToBytes(unprotected)
+	require.NoError(err)
+	_, err = DecodeEthTransaction(raw, chainID)
+	require.Equal(ErrEthTransaction, errors.Cause(err))
+
+	// the action signed for another chain, or changed out of the transaction, fails the verification
+	pb := selp.Proto()
+	pb.EthChainID = chainID + 1
+	require.NoError(nselp.LoadProto(pb))
+	require.Error(Verify(nselp))
+	pb = selp.Proto()
+	pb.Core.ValidUntilHeight = 10
+	require.Equal(ErrEthTransaction, errors.Cause(nselp.LoadProto(pb)))
+}
@@ -0,0 +1,187 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math"
+	"math/big"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/pkg/version"
+	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+)
+
+const (
+	// MultiTransferBaseIntrinsicGas represents the base intrinsic gas for multi-transfer
+	MultiTransferBaseIntrinsicGas = uint64(10000)
+	// MultiTransferRecipientGas represents the intrinsic gas per recipient of multi-transfer, which costs no less than
+	// a transfer to the recipient
+	MultiTransferRecipientGas = TransferBaseIntrinsicGas
+	// MultiTransferPayloadGas represents the multi-transfer payload gas per uint
+	MultiTransferPayloadGas = uint64(100)
+)
+
+var _ hasDestinations = (*MultiTransfer)(nil)
+
+// ErrMultiTransfer indicates the error of an invalid multi-transfer
+var ErrMultiTransfer = errors.New("invalid multi-transfer")
+
+// TransferEntry is a recipient of a multi-transfer with the amount and the payload sent to it
+type TransferEntry struct {
+	Recipient string
+	Amount    *big.Int
+	Payload   []byte
+}
+
+// MultiTransfer defines the struct of transferring tokens from the sender to many recipients in one action
+type MultiTransfer struct {
+	AbstractAction
+
+	entries []TransferEntry
+}
+
+// NewMultiTransfer returns a MultiTransfer instance
+func NewMultiTransfer(
+	nonce uint64,
+	entries []TransferEntry,
+	gasLimit uint64,
+	gasPrice *big.Int,
+) (*MultiTransfer, error) {
+	if len(entries) == 0 {
+		return nil, errors.New("no recipient of multi-transfer")
+	}
+	return &MultiTransfer{
+		AbstractAction: AbstractAction{
+			version:  version.ProtocolVersion,
+			nonce:    nonce,
+			gasLimit: gasLimit,
+			gasPrice: gasPrice,
+		},
+		entries: entries,
+	}, nil
+}
+
+// Entries returns the recipients with the amounts and the payloads sent to them
+func (mt *MultiTransfer) Entries() []TransferEntry {
+	entries := make([]TransferEntry, len(mt.entries))
+	copy(entries, mt.entries)
+	return entries
+}
+
+// Amount returns the total amount sent to all the recipients
+func (mt *MultiTransfer) Amount() *big.Int {
+	amount := big.NewInt(0)
+	for _, entry := range mt.entries {
+		if entry.Amount != nil {
+			amount.Add(amount, entry.Amount)
+		}
+	}
+	return amount
+}
+
+// Destinations returns the distinct recipients in the order they first appear
+func (mt *MultiTransfer) Destinations() []string {
+	seen := make(map[string]bool, len(mt.entries))
+	dsts := make([]string, 0, len(mt.entries))
+	for _, entry := range mt.entries {
+		if seen[entry.Recipient] {
+			continue
+		}
+		seen[entry.Recipient] = true
+		dsts = append(dsts, entry.Recipient)
+	}
+	return dsts
+}
+
+// TotalSize returns the total size of this MultiTransfer
+func (mt *MultiTransfer) TotalSize() uint32 {
+	size := mt.BasicActionSize()
+	for _, entry := range mt.entries {
+		if entry.Amount != nil && len(entry.Amount.Bytes()) > 0 {
+			size += uint32(len(entry.Amount.Bytes()))
+		}
+		size += uint32(len(entry.Recipient) + len(entry.Payload))
+	}
+	return size
+}
+
+// ByteStream returns a raw byte stream of this MultiTransfer
+func (mt *MultiTransfer) ByteStream() []byte {
+	return byteutil.Must(proto.Marshal(mt.Proto()))
+}
+
+// Proto converts MultiTransfer to protobuf's Action
+func (mt *MultiTransfer) Proto() *iotextypes.MultiTransfer {
+	act := &iotextypes.MultiTransfer{Transfers: make([]*iotextypes.Transfer, 0, len(mt.entries))}
+	for _, entry := range mt.entries {
+		tsf := &iotextypes.Transfer{
+			Recipient: entry.Recipient,
+			Payload:   entry.Payload,
+		}
+		if entry.Amount != nil {
+			tsf.Amount = entry.Amount.String()
+		}
+		act.Transfers = append(act.Transfers, tsf)
+	}
+	return act
+}
+
+// LoadProto converts a protobuf's Action to MultiTransfer
+func (mt *MultiTransfer) LoadProto(pbAct *iotextypes.MultiTransfer) error {
+	if pbAct == nil {
+		return errors.New("empty action proto to load")
+	}
+	if mt == nil {
+		return errors.New("nil action to load proto")
+	}
+	*mt = MultiTransfer{}
+
+	mt.entries = make([]TransferEntry, 0, len(pbAct.GetTransfers()))
+	for _, tsf := range pbAct.GetTransfers() {
+		amount, ok := new(big.Int).SetString(tsf.GetAmount(), 10)
+		if !ok {
+			return errors.Wrapf(ErrMultiTransfer, "invalid amount %s to %s", tsf.GetAmount(), tsf.GetRecipient())
+		}
+		mt.entries = append(mt.entries, TransferEntry{
+			Recipient: tsf.GetRecipient(),
+			Amount:    amount,
+			Payload:   tsf.GetPayload(),
+		})
+	}
+	return nil
+}
+
+// IntrinsicGas returns the intrinsic gas of a multi-transfer, which charges the base gas once, and the gas of the
+// recipient and its payload for every recipient
+func (mt *MultiTransfer) IntrinsicGas() (uint64, error) {
+	intrinsicGas := MultiTransferBaseIntrinsicGas
+	for _, entry := range mt.entries {
+		payloadSize := uint64(len(entry.Payload))
+		if (math.MaxUint64-MultiTransferRecipientGas)/MultiTransferPayloadGas < payloadSize {
+			return 0, ErrOutOfGas
+		}
+		gas := payloadSize*MultiTransferPayloadGas + MultiTransferRecipientGas
+		if math.MaxUint64-intrinsicGas < gas {
+			return 0, ErrOutOfGas
+		}
+		intrinsicGas += gas
+	}
+	return intrinsicGas, nil
+}
+
+// Cost returns the total cost of a multi-transfer
+func (mt *MultiTransfer) Cost() (*big.Int, error) {
+	intrinsicGas, err := mt.IntrinsicGas()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get intrinsic gas for the multi-transfer")
+	}
+	transferFee := big.NewInt(0).Mul(mt.GasPrice(), big.NewInt(0).SetUint64(intrinsicGas))
+	return big.NewInt(0).Add(mt.Amount(), transferFee), nil
+}
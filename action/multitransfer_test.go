@@ -0,0 +1,61 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package action
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestMultiTransfer(t *testing.T) {
+	require := require.New(t)
+	alfa := testaddress.Addrinfo["alfa"].String()
+	bravo := testaddress.Addrinfo["bravo"].String()
+	senderKey := testaddress.Keyinfo["producer"]
+
+	_, err := NewMultiTransfer(1, nil, 100000, big.NewInt(10))
+	require.Error(err)
+
+	mt, err := NewMultiTransfer(1, []TransferEntry{
+		{Recipient: alfa, Amount: big.NewInt(10), Payload: []byte("salary")},
+		{Recipient: bravo, Amount: big.NewInt(20)},
+		{Recipient: alfa, Amount: big.NewInt(30)},
+	}, 100000, big.NewInt(10))
+	require.NoError(err)
+	require.Equal(big.NewInt(60), mt.Amount())
+	require.Equal([]string{alfa, bravo}, mt.Destinations())
+	intrinsicGas, err := mt.IntrinsicGas()
+	require.NoError(err)
+	require.Equal(MultiTransferBaseIntrinsicGas+3*MultiTransferRecipientGas+6*MultiTransferPayloadGas, intrinsicGas)
+	cost, err := mt.Cost()
+	require.NoError(err)
+	require.Equal(new(big.Int).SetUint64(60+10*intrinsicGas), cost)
+
+	bd := &EnvelopeBuilder{}
+	elp := bd.SetNonce(1).SetGasLimit(100000).SetGasPrice(big.NewInt(10)).SetAction(mt).Build()
+	require.Equal([]string{alfa, bravo}, elp.Destinations())
+	selp, err := Sign(elp, senderKey.PriKey)
+	require.NoError(err)
+	require.NoError(Verify(selp))
+
+	loaded := SealedEnvelope{}
+	require.NoError(loaded.LoadProto(selp.Proto()))
+	require.Equal(selp.Hash(), loaded.Hash())
+	loadedMt, ok := loaded.Action().(*MultiTransfer)
+	require.True(ok)
+	require.Equal(mt.Entries(), loadedMt.Entries())
+
+	// the amount has to be a number
+	pb := mt.Proto()
+	pb.Transfers[1].Amount = "twenty"
+	require.Equal(ErrMultiTransfer, errors.Cause((&MultiTransfer{}).LoadProto(pb)))
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/action/protocol/rewarding"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/state"
+)
+
+const (
+	// MultiTransferSizeLimit is the maximum size of multi-transfer allowed
+	MultiTransferSizeLimit = 64 * 1024
+	// MultiTransferRecipientLimit is the maximum number of recipients of multi-transfer allowed
+	MultiTransferRecipientLimit = 256
+)
+
+// handleMultiTransfer handles a multi-transfer, which takes effect as a whole. The recipients, and the balance of the
+// sender for the gas fee and the amounts to all the recipients, are checked before any change, and the receipt records
+// the result of every transfer
+func (p *Protocol) handleMultiTransfer(
+	ctx context.Context,
+	act action.Action,
+	sm protocol.StateManager,
+) (*action.Receipt, error) {
+	raCtx := protocol.MustGetRunActionsCtx(ctx)
+	mt, ok := act.(*action.MultiTransfer)
+	if !ok {
+		return nil, nil
+	}
+	if raCtx.BlockHeight < raCtx.MultiTransferHeight {
+		return nil, errors.Wrap(action.ErrMultiTransfer, "multi-transfer is not enabled yet")
+	}
+	entries := mt.Entries()
+	for _, entry := range entries {
+		if err := validateTransferEntry(entry); err != nil {
+			return nil, err
+		}
+	}
+	// check sender
+	sender, err := accountutil.LoadOrCreateAccount(sm, raCtx.Caller.String(), big.NewInt(0))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load or create the account of sender %s", raCtx.Caller.String())
+	}
+
+	if raCtx.GasLimit < raCtx.IntrinsicGas {
+		return nil, action.ErrHitGasLimit
+	}
+
+	gasFee := big.NewInt(0).Mul(mt.GasPrice(), big.NewInt(0).SetUint64(raCtx.IntrinsicGas))
+	amount := mt.Amount()
+	if big.NewInt(0).Add(amount, gasFee).Cmp(sender.Balance) == 1 {
+		return nil, errors.Wrapf(
+			state.ErrNotEnoughBalance,
+			"sender %s balance %s, required amount %s",
+			raCtx.Caller.String(),
+			sender.Balance,
+			big.NewInt(0).Add(amount, gasFee),
+		)
+	}
+
+	// charge sender gas
+	if err := sender.SubBalance(gasFee); err != nil {
+		return nil, errors.Wrapf(err, "failed to charge the gas for sender %s", raCtx.Caller.String())
+	}
+	if err := rewarding.DepositGas(ctx, sm, gasFee, raCtx.Registry); err != nil {
+		return nil, err
+	}
+	// update sender Balance
+	if err := sender.SubBalance(amount); err != nil {
+		return nil, errors.Wrapf(err, "failed to update the Balance of sender %s", raCtx.Caller.String())
+	}
+	// update sender Nonce
+	accountutil.SetNonce(mt, sender)
+	// put updated sender's state to trie
+	if err := accountutil.StoreAccount(sm, raCtx.Caller.String(), sender); err != nil {
+		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if err := updateVotingWeight(sm, raCtx.BlockHeight, sender.Votee, new(big.Int).Neg(amount)); err != nil {
+		return nil, errors.Wrap(err, "failed to update the votee of sender")
+	}
+
+	receipt := &action.Receipt{
+		Status:          action.SuccessReceiptStatus,
+		ActHash:         raCtx.ActionHash,
+		GasConsumed:     raCtx.IntrinsicGas,
+		TransferResults: make([]*action.TransferResult, 0, len(entries)),
+	}
+	for _, entry := range entries {
+		receipt.TransferResults = append(receipt.TransferResults, &action.TransferResult{
+			Recipient: entry.Recipient,
+			Amount:    entry.Amount,
+			Status:    action.SuccessReceiptStatus,
+		})
+		// check recipient, which is loaded after the sender is stored, as the sender may also be one of the recipients
+		recipient, err := accountutil.LoadOrCreateAccount(sm, entry.Recipient, big.NewInt(0))
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to load or create the account of recipient %s", entry.Recipient)
+		}
+		if err := recipient.AddBalance(entry.Amount); err != nil {
+			return nil, errors.Wrapf(err, "failed to update the Balance of recipient %s", entry.Recipient)
+		}
+		// put updated recipient's state to trie
+		if err := accountutil.StoreAccount(sm, entry.Recipient, recipient); err != nil {
+			return nil, errors.Wrap(err, "failed to update pending account changes to trie")
+		}
+		if err := updateVotingWeight(sm, raCtx.BlockHeight, recipient.Votee, entry.Amount); err != nil {
+			return nil, errors.Wrap(err, "failed to update the votee of recipient")
+		}
+	}
+	return receipt, nil
+}
+
+func (p *Protocol) validateMultiTransfer(ctx context.Context, act action.Action) error {
+	mt, ok := act.(*action.MultiTransfer)
+	if !ok {
+		return nil
+	}
+	vaCtx := protocol.MustGetValidateActionsCtx(ctx)
+	// Reject multi-transfer before it is enabled
+	if vaCtx.BlockHeight < vaCtx.MultiTransferHeight {
+		return errors.Wrap(action.ErrMultiTransfer, "multi-transfer is not enabled yet")
+	}
+	// Reject oversized multi-transfer
+	if mt.TotalSize() > MultiTransferSizeLimit {
+		return errors.Wrap(action.ErrActPool, "oversized data")
+	}
+	entries := mt.Entries()
+	if len(entries) == 0 {
+		return errors.Wrap(action.ErrActPool, "no recipient")
+	}
+	if len(entries) > MultiTransferRecipientLimit {
+		return errors.Wrapf(action.ErrActPool, "%d recipients exceed the limit %d", len(entries),
+			MultiTransferRecipientLimit)
+	}
+	for _, entry := range entries {
+		if err := validateTransferEntry(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateTransferEntry validates the amount and the recipient of a transfer in a multi-transfer
+func validateTransferEntry(entry action.TransferEntry) error {
+	// Reject transfer of negative amount
+	if entry.Amount == nil || entry.Amount.Sign() < 0 {
+		return errors.Wrap(action.ErrBalance, "negative value")
+	}
+	// check if recipient's address is valid
+	if _, err := address.FromString(entry.Recipient); err != nil {
+		return errors.Wrapf(err, "error when validating recipient's address %s", entry.Recipient)
+	}
+	return nil
+}
@@ -0,0 +1,199 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package account
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+)
+
+func TestProtocol_HandleMultiTransfer(t *testing.T) {
+	require := require.New(t)
+
+	cfg := config.Default
+	ctx := context.Background()
+	sf, err := factory.NewFactory(cfg, factory.InMemTrieOption())
+	require.NoError(err)
+	require.NoError(sf.Start(ctx))
+	defer func() {
+		require.NoError(sf.Stop(ctx))
+	}()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+
+	p := NewProtocol()
+
+	account1 := state.Account{
+		Balance: big.NewInt(10),
+		Votee:   testaddress.Addrinfo["charlie"].String(),
+	}
+	account2 := state.Account{
+		Votee: testaddress.Addrinfo["delta"].String(),
+	}
+	account3 := state.Account{
+		VotingWeight: big.NewInt(10),
+	}
+	pubKeyHash1 := hash.BytesToHash160(testaddress.Addrinfo["alfa"].Bytes())
+	pubKeyHash2 := hash.BytesToHash160(testaddress.Addrinfo["bravo"].Bytes())
+	pubKeyHash3 := hash.BytesToHash160(testaddress.Addrinfo["charlie"].Bytes())
+	pubKeyHash4 := hash.BytesToHash160(testaddress.Addrinfo["delta"].Bytes())
+
+	require.NoError(ws.PutState(pubKeyHash1, &account1))
+	require.NoError(ws.PutState(pubKeyHash2, &account2))
+	require.NoError(ws.PutState(pubKeyHash3, &account3))
+
+	bravo := testaddress.Addrinfo["bravo"].String()
+	delta := testaddress.Addrinfo["delta"].String()
+	actHash := hash.Hash256b([]byte("multi-transfer"))
+	raCtx := protocol.RunActionsCtx{
+		Producer:   testaddress.Addrinfo["producer"],
+		Caller:     testaddress.Addrinfo["alfa"],
+		GasLimit:   uint64(1000000),
+		ActionHash: actHash,
+	}
+	handle := func(entries ...action.TransferEntry) (*action.Receipt, error) {
+		mt, err := action.NewMultiTransfer(uint64(1), entries, uint64(100000), big.NewInt(0))
+		require.NoError(err)
+		raCtx.IntrinsicGas, err = mt.IntrinsicGas()
+		require.NoError(err)
+		return p.Handle(protocol.WithRunActionsCtx(context.Background(), raCtx), mt, ws)
+	}
+	balance := func(addr string) *big.Int {
+		acct, err := accountutil.LoadOrCreateAccount(ws, addr, big.NewInt(0))
+		require.NoError(err)
+		return acct.Balance
+	}
+
+	// nothing is transferred if the balance is not enough for all the recipients, or any recipient is invalid
+	_, err = handle(
+		action.TransferEntry{Recipient: bravo, Amount: big.NewInt(3)},
+		action.TransferEntry{Recipient: delta, Amount: big.NewInt(4)},
+		action.TransferEntry{Recipient: bravo, Amount: big.NewInt(5)},
+	)
+	require.Equal(state.ErrNotEnoughBalance, errors.Cause(err))
+	_, err = handle(
+		action.TransferEntry{Recipient: bravo, Amount: big.NewInt(3)},
+		action.TransferEntry{Recipient: delta, Amount: big.NewInt(-4)},
+	)
+	require.Equal(action.ErrBalance, errors.Cause(err))
+	_, err = handle(
+		action.TransferEntry{Recipient: bravo, Amount: big.NewInt(3)},
+		action.TransferEntry{Recipient: delta + "aaa", Amount: big.NewInt(4)},
+	)
+	require.Error(err)
+	require.Equal(big.NewInt(10), balance(testaddress.Addrinfo["alfa"].String()))
+	require.Equal(big.NewInt(0), balance(bravo))
+
+	// the multi-transfer cannot run before it is enabled
+	raCtx.BlockHeight, raCtx.MultiTransferHeight = 1, 2
+	_, err = handle(action.TransferEntry{Recipient: bravo, Amount: big.NewInt(3)})
+	require.Equal(action.ErrMultiTransfer, errors.Cause(err))
+	raCtx.MultiTransferHeight = 0
+
+	receipt, err := handle(
+		action.TransferEntry{Recipient: bravo, Amount: big.NewInt(3)},
+		action.TransferEntry{Recipient: delta, Amount: big.NewInt(4)},
+		action.TransferEntry{Recipient: bravo, Amount: big.NewInt(2)},
+	)
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+
+	require.Equal(action.SuccessReceiptStatus, receipt.Status)
+	require.Equal(actHash, receipt.ActHash)
+	require.Equal(raCtx.IntrinsicGas, receipt.GasConsumed)
+	require.Equal([]*action.TransferResult{
+		{Recipient: bravo, Amount: big.NewInt(3), Status: action.SuccessReceiptStatus},
+		{Recipient: delta, Amount: big.NewInt(4), Status: action.SuccessReceiptStatus},
+		{Recipient: bravo, Amount: big.NewInt(2), Status: action.SuccessReceiptStatus},
+	}, receipt.TransferResults)
+
+	var s1 state.Account
+	require.NoError(sf.State(pubKeyHash1, &s1))
+	var s2 state.Account
+	require.NoError(sf.State(pubKeyHash2, &s2))
+	var s3 state.Account
+	require.NoError(sf.State(pubKeyHash3, &s3))
+	var s4 state.Account
+	require.NoError(sf.State(pubKeyHash4, &s4))
+
+	require.Equal("1", s1.Balance.String())
+	require.Equal(uint64(1), s1.Nonce)
+	require.Equal("5", s2.Balance.String())
+	require.Equal("1", s3.VotingWeight.String())
+	require.Equal("4", s4.Balance.String())
+	require.Equal("5", s4.VotingWeight.String())
+
+	// the gas fee has to be paid in full
+	mt, err := action.NewMultiTransfer(uint64(2), []action.TransferEntry{
+		{Recipient: bravo, Amount: big.NewInt(1)},
+	}, uint64(100000), big.NewInt(1))
+	require.NoError(err)
+	ws, err = sf.NewWorkingSet()
+	require.NoError(err)
+	_, err = p.Handle(protocol.WithRunActionsCtx(context.Background(), raCtx), mt, ws)
+	require.Equal(state.ErrNotEnoughBalance, errors.Cause(err))
+}
+
+func TestProtocol_ValidateMultiTransfer(t *testing.T) {
+	require := require.New(t)
+	p := NewProtocol()
+	bravo := testaddress.Addrinfo["bravo"].String()
+	ctx := protocol.WithValidateActionsCtx(context.Background(), protocol.ValidateActionsCtx{BlockHeight: 1})
+
+	// Case I: Oversized data
+	mt, err := action.NewMultiTransfer(uint64(1), []action.TransferEntry{
+		{Recipient: bravo, Amount: big.NewInt(1), Payload: make([]byte, MultiTransferSizeLimit)},
+	}, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(p.Validate(ctx, mt)))
+	// Case II: Too many recipients
+	entries := make([]action.TransferEntry, MultiTransferRecipientLimit+1)
+	for i := range entries {
+		entries[i] = action.TransferEntry{Recipient: bravo, Amount: big.NewInt(1)}
+	}
+	mt, err = action.NewMultiTransfer(uint64(1), entries, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(p.Validate(ctx, mt)))
+	// Case III: Negative amount
+	mt, err = action.NewMultiTransfer(uint64(1), []action.TransferEntry{
+		{Recipient: bravo, Amount: big.NewInt(1)},
+		{Recipient: bravo, Amount: big.NewInt(-1)},
+	}, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(p.Validate(ctx, mt)))
+	// Case IV: Invalid recipient address
+	mt, err = action.NewMultiTransfer(uint64(1), []action.TransferEntry{
+		{Recipient: bravo + "aaa", Amount: big.NewInt(1)},
+	}, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.Error(p.Validate(ctx, mt))
+
+	mt, err = action.NewMultiTransfer(uint64(1), []action.TransferEntry{
+		{Recipient: bravo, Amount: big.NewInt(1)},
+	}, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(p.Validate(ctx, mt))
+	// Case V: Multi-transfer not enabled yet
+	ctx = protocol.WithValidateActionsCtx(context.Background(), protocol.ValidateActionsCtx{
+		BlockHeight:         1,
+		MultiTransferHeight: 2,
+	})
+	require.Equal(action.ErrMultiTransfer, errors.Cause(p.Validate(ctx, mt)))
+}
@@ -33,6 +33,8 @@ func (p *Protocol) Handle(ctx context.Context, act action.Action, sm protocol.St
 	switch act := act.(type) {
 	case *action.Transfer:
 		return p.handleTransfer(ctx, act, sm)
+	case *action.MultiTransfer:
+		return p.handleMultiTransfer(ctx, act, sm)
 	}
 	return nil, nil
 }
@@ -44,6 +46,10 @@ func (p *Protocol) Validate(ctx context.Context, act action.Action) error {
 		if err := p.validateTransfer(ctx, act); err != nil {
 			return errors.Wrap(err, "error when validating transfer action")
 		}
+	case *action.MultiTransfer:
+		if err := p.validateMultiTransfer(ctx, act); err != nil {
+			return errors.Wrap(err, "error when validating multi-transfer action")
+		}
 	}
 	return nil
 }
@@ -81,24 +81,8 @@ func (p *Protocol) handleTransfer(ctx context.Context, act action.Action, sm pro
 		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
 	}
 	// Update sender votes
-	if len(sender.Votee) > 0 {
-		// sender already voted to a different person
-		voteeOfSender, err := accountutil.LoadOrCreateAccount(sm, sender.Votee, big.NewInt(0))
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to load or create the account of sender's votee %s", sender.Votee)
-		}
-		voteeOfSender.VotingWeight.Sub(voteeOfSender.VotingWeight, tsf.Amount())
-		// put updated state of sender's votee to trie
-		if err := accountutil.StoreAccount(sm, sender.Votee, voteeOfSender); err != nil {
-			return nil, errors.Wrap(err, "failed to update pending account changes to trie")
-		}
-
-		// Update candidate
-		if voteeOfSender.IsCandidate {
-			if err := candidatesutil.LoadAndUpdateCandidates(sm, raCtx.BlockHeight, sender.Votee, voteeOfSender.VotingWeight); err != nil {
-				return nil, errors.Wrap(err, "failed to load and update candidates")
-			}
-		}
+	if err := updateVotingWeight(sm, raCtx.BlockHeight, sender.Votee, new(big.Int).Neg(tsf.Amount())); err != nil {
+		return nil, errors.Wrap(err, "failed to update the votee of sender")
 	}
 	// check recipient
 	recipient, err := accountutil.LoadOrCreateAccount(sm, tsf.Recipient(), big.NewInt(0))
@@ -114,25 +98,33 @@ func (p *Protocol) handleTransfer(ctx context.Context, act action.Action, sm pro
 		return nil, errors.Wrap(err, "failed to update pending account changes to trie")
 	}
 	// Update recipient votes
-	if len(recipient.Votee) > 0 {
-		// recipient already voted to a different person
-		voteeOfRecipient, err := accountutil.LoadOrCreateAccount(sm, recipient.Votee, big.NewInt(0))
-		if err != nil {
-			return nil, errors.Wrapf(err, "failed to load or create the account of recipient's votee %s", recipient.Votee)
-		}
-		voteeOfRecipient.VotingWeight.Add(voteeOfRecipient.VotingWeight, tsf.Amount())
-		// put updated state of recipient's votee to trie
-		if err := accountutil.StoreAccount(sm, recipient.Votee, voteeOfRecipient); err != nil {
-			return nil, errors.Wrap(err, "failed to update pending account changes to trie")
-		}
+	if err := updateVotingWeight(sm, raCtx.BlockHeight, recipient.Votee, tsf.Amount()); err != nil {
+		return nil, errors.Wrap(err, "failed to update the votee of recipient")
+	}
+	return &action.Receipt{GasConsumed: raCtx.IntrinsicGas}, nil
+}
 
-		if voteeOfRecipient.IsCandidate {
-			if err := candidatesutil.LoadAndUpdateCandidates(sm, raCtx.BlockHeight, recipient.Votee, voteeOfRecipient.VotingWeight); err != nil {
-				return nil, errors.Wrap(err, "failed to load and update candidates")
-			}
+// updateVotingWeight adds the delta to the voting weight of the votee if any, and updates the candidates if the votee
+// is a candidate
+func updateVotingWeight(sm protocol.StateManager, blockHeight uint64, votee string, delta *big.Int) error {
+	if len(votee) == 0 {
+		return nil
+	}
+	voteeAcct, err := accountutil.LoadOrCreateAccount(sm, votee, big.NewInt(0))
+	if err != nil {
+		return errors.Wrapf(err, "failed to load or create the account of votee %s", votee)
+	}
+	voteeAcct.VotingWeight.Add(voteeAcct.VotingWeight, delta)
+	// put updated state of votee to trie
+	if err := accountutil.StoreAccount(sm, votee, voteeAcct); err != nil {
+		return errors.Wrap(err, "failed to update pending account changes to trie")
+	}
+	if voteeAcct.IsCandidate {
+		if err := candidatesutil.LoadAndUpdateCandidates(sm, blockHeight, votee, voteeAcct.VotingWeight); err != nil {
+			return errors.Wrap(err, "failed to load and update candidates")
 		}
 	}
-	return &action.Receipt{GasConsumed: raCtx.IntrinsicGas}, nil
+	return nil
 }
 
 // validateTransfer validates a transfer
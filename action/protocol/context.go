@@ -35,6 +35,14 @@ type RunActionsCtx struct {
 	ActionHash hash.Hash256
 	// ActionGasLimit is the action gas limit
 	ActionGasLimit uint64
+	// EVMErrorStatusHeight is the height since which an execution hitting an error in the evm gets the failure status
+	EVMErrorStatusHeight uint64
+	// Web3Height is the height since which every receipt carries the hash of its action
+	Web3Height uint64
+	// BundleHeight is the height since which a bundle of actions can be run
+	BundleHeight uint64
+	// MultiTransferHeight is the height since which a multi-transfer can be run
+	MultiTransferHeight uint64
 	// GasPrice is the action gas price
 	GasPrice *big.Int
 	// IntrinsicGas is the action intrinsic gas
@@ -53,6 +61,12 @@ type ValidateActionsCtx struct {
 	ProducerAddr string
 	// Caller is the address of whom issues the action
 	Caller address.Address
+	// Web3Height is the height since which an action can be signed as an Ethereum transaction
+	Web3Height uint64
+	// BundleHeight is the height since which a bundle of actions is valid
+	BundleHeight uint64
+	// MultiTransferHeight is the height since which a multi-transfer is valid
+	MultiTransferHeight uint64
 }
 
 // WithRunActionsCtx add RunActionsCtx into context.
@@ -139,6 +139,50 @@ func (c *contract) Snapshot() Contract {
 
 // NewContract returns a Contract instance
 func newContract(addr hash.Hash160, state *state.Account, dao db.KVStore, batch db.CachedBatch) (Contract, error) {
+	tr, err := newStorageTrie(addr, state.Root, dao, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contract{
+		Account: state,
+		root:    state.Root,
+		dao:     dao,
+		trie:    tr,
+	}, nil
+}
+
+// ProveStorage returns the value of a key in the storage of a contract, and the proof of it against the storage root
+func ProveStorage(dao db.KVStore, addr hash.Hash160, root hash.Hash256, key hash.Hash256) ([]byte, [][]byte, error) {
+	tr, err := newStorageTrie(addr, root, dao, db.NewCachedBatch())
+	if err != nil {
+		return nil, nil, err
+	}
+	value, err := tr.Get(key[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	proof, err := tr.Prove(key[:])
+	if err != nil {
+		return nil, nil, err
+	}
+	return value, proof, nil
+}
+
+// VerifyStorageProof verifies the proof of a key in the storage of a contract against the storage root, and returns
+// the value of the key
+func VerifyStorageProof(addr hash.Hash160, root hash.Hash256, key hash.Hash256, proof [][]byte) ([]byte, error) {
+	return trie.VerifyProofWithHashFunc(storageHashFunc(addr), root[:], key[:], proof)
+}
+
+// storageHashFunc returns the hash function of the storage trie, which is salted by the contract address
+func storageHashFunc(addr hash.Hash160) trie.HashFunc {
+	return func(data []byte) []byte {
+		return trie.DefaultHashFunc(append(addr[:], data...))
+	}
+}
+
+func newStorageTrie(addr hash.Hash160, root hash.Hash256, dao db.KVStore, batch db.CachedBatch) (trie.Trie, error) {
 	dbForTrie, err := db.NewKVStoreForTrie(ContractKVNameSpace, dao, db.CachedBatchOption(batch))
 	if err != nil {
 		return nil, err
@@ -146,12 +190,10 @@ func newContract(addr hash.Hash160, state *state.Account, dao db.KVStore, batch
 	options := []trie.Option{
 		trie.KVStoreOption(dbForTrie),
 		trie.KeyLengthOption(len(hash.Hash256{})),
-		trie.HashFuncOption(func(data []byte) []byte {
-			return trie.DefaultHashFunc(append(addr[:], data...))
-		}),
+		trie.HashFuncOption(storageHashFunc(addr)),
 	}
-	if state.Root != hash.ZeroHash256 {
-		options = append(options, trie.RootHashOption(state.Root[:]))
+	if root != hash.ZeroHash256 {
+		options = append(options, trie.RootHashOption(root[:]))
 	}
 
 	tr, err := trie.NewTrie(options...)
@@ -161,11 +203,5 @@ func newContract(addr hash.Hash160, state *state.Account, dao db.KVStore, batch
 	if err := tr.Start(context.Background()); err != nil {
 		return nil, err
 	}
-
-	return &contract{
-		Account: state,
-		root:    state.Root,
-		dao:     dao,
-		trie:    tr,
-	}, nil
+	return tr, nil
 }
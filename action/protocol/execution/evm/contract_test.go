@@ -251,3 +251,37 @@ func TestSnapshot(t *testing.T) {
 	require.Equal(big.NewInt(5), c2.SelfState().Balance)
 	require.NotEqual(c1.RootHash(), c2.RootHash())
 }
+
+func TestStorageProof(t *testing.T) {
+	require := require.New(t)
+
+	addr := hash.BytesToHash160(testaddress.Addrinfo["alfa"].Bytes())
+	k1 := hash.Hash256b([]byte("cat"))
+	v1 := hash.Hash256b([]byte("cat"))
+	k2 := hash.Hash256b([]byte("dog"))
+	v2 := hash.Hash256b([]byte("dog"))
+	dao := db.NewMemKVStore()
+	cb := db.NewCachedBatch()
+	c, err := newContract(addr, &state.Account{Balance: big.NewInt(0), VotingWeight: big.NewInt(0)}, dao, cb)
+	require.NoError(err)
+	require.NoError(c.SetState(k1, v1[:]))
+	require.NoError(c.SetState(k2, v2[:]))
+	require.NoError(c.Commit())
+	require.NoError(dao.Commit(cb))
+	root := c.RootHash()
+
+	value, proof, err := ProveStorage(dao, addr, root, k1)
+	require.NoError(err)
+	require.Equal(v1[:], value)
+	value, err = VerifyStorageProof(addr, root, k1, proof)
+	require.NoError(err)
+	require.Equal(v1[:], value)
+	// the storage trie is salted by the contract address
+	_, err = VerifyStorageProof(hash.BytesToHash160(testaddress.Addrinfo["bravo"].Bytes()), root, k1, proof)
+	require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyStorageProof(addr, root, k2, proof)
+	require.Equal(trie.ErrInvalidProof, errors.Cause(err))
+
+	_, _, err = ProveStorage(dao, addr, root, hash.Hash256b([]byte("cow")))
+	require.Equal(trie.ErrNotExist, errors.Cause(err))
+}
@@ -7,7 +7,9 @@
 package evm
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"math"
 	"math/big"
 
@@ -26,6 +28,13 @@ import (
 // ErrInconsistentNonce is the error that the nonce is different from executor's nonce
 var ErrInconsistentNonce = errors.New("Nonce is not identical to executor nonce")
 
+// revertReasonSelector is the selector of Error(string), in which the revert reason is encoded
+var revertReasonSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+// errExecutionReverted has the message of the error of the REVERT opcode, which is not exported by the evm, so that the
+// error is told by its message
+var errExecutionReverted = errors.New("evm: execution reverted")
+
 // CanTransfer checks whether the from account has enough balance
 func CanTransfer(db vm.StateDB, fromHash common.Address, balance *big.Int) bool {
 	return db.GetBalance(fromHash).Cmp(balance) >= 0
@@ -46,6 +55,8 @@ type Params struct {
 	contract           *common.Address
 	gas                uint64
 	data               []byte
+	// failOnEVMError tells whether an error in the evm fails the execution, or only the errors before running it do
+	failOnEVMError bool
 }
 
 // NewParams creates a new context for use in the EVM.
@@ -98,6 +109,7 @@ func NewParams(raCtx protocol.RunActionsCtx, execution *action.Execution, stateD
 		contractAddrPointer,
 		execution.GasLimit(),
 		execution.Data(),
+		raCtx.BlockHeight >= raCtx.EVMErrorStatusHeight,
 	}, nil
 }
 
@@ -144,7 +156,12 @@ func ExecuteContract(
 	if err != nil {
 		return nil, err
 	}
-	retval, depositGas, remainingGas, contractAddress, err := executeInEVM(ps, stateDB, raCtx.GasLimit)
+	var config vm.Config
+	if tracer, ok := GetTracer(ctx); ok {
+		config.Debug = true
+		config.Tracer = tracer
+	}
+	retval, depositGas, remainingGas, contractAddress, err := executeInEVM(ps, stateDB, raCtx.GasLimit, config)
 	receipt := &action.Receipt{
 		ReturnValue:     retval,
 		GasConsumed:     ps.gas - remainingGas,
@@ -153,6 +170,10 @@ func ExecuteContract(
 	}
 	if err != nil {
 		receipt.Status = action.FailureReceiptStatus
+		receipt.ErrorClass = executionErrorClass(err)
+		if receipt.ErrorClass == action.ExecutionReverted {
+			receipt.RevertReason = decodeRevertReason(retval)
+		}
 	} else {
 		receipt.Status = action.SuccessReceiptStatus
 	}
@@ -184,12 +205,16 @@ func getChainConfig() *params.ChainConfig {
 	return &chainConfig
 }
 
-func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, gasLimit uint64) ([]byte, uint64, uint64, string, error) {
+func executeInEVM(
+	evmParams *Params,
+	stateDB *StateDBAdapter,
+	gasLimit uint64,
+	config vm.Config,
+) ([]byte, uint64, uint64, string, error) {
 	remainingGas := evmParams.gas
 	if err := securityDeposit(evmParams, stateDB, gasLimit); err != nil {
 		return nil, 0, 0, action.EmptyAddress, err
 	}
-	var config vm.Config
 	chainConfig := getChainConfig()
 	evm := vm.NewEVM(evmParams.context, stateDB, chainConfig, config)
 	intriGas, err := intrinsicGas(evmParams.data)
@@ -232,13 +257,51 @@ func executeInEVM(evmParams *Params, stateDB *StateDBAdapter, gasLimit uint64) (
 		refund = stateDB.GetRefund()
 	}
 	remainingGas += refund
-	if err != nil {
-		// TODO (zhi) should we refund if any error
-		// return nil, evmParams.gas, 0, contractRawAddress, err
+	if !evmParams.failOnEVMError {
+		err = nil
 	}
 	// TODO (zhi) figure out what the following function does
 	// stateDB.Finalise(true)
-	return ret, evmParams.gas, remainingGas, contractRawAddress, nil
+	return ret, evmParams.gas, remainingGas, contractRawAddress, err
+}
+
+// executionErrorClass classifies the error returned by the evm
+func executionErrorClass(err error) int {
+	err = errors.Cause(err)
+	if err.Error() == errExecutionReverted.Error() {
+		return action.ExecutionReverted
+	}
+	switch err {
+	case vm.ErrOutOfGas, vm.ErrCodeStoreOutOfGas, action.ErrOutOfGas:
+		return action.ExecutionOutOfGas
+	case vm.ErrInsufficientBalance, action.ErrInsufficientBalanceForGas:
+		return action.ExecutionInsufficientBalance
+	}
+	// the evm makes a new error for each invalid opcode instead of a sentinel one
+	var op int
+	if _, scanErr := fmt.Sscanf(err.Error(), "invalid opcode 0x%x", &op); scanErr == nil {
+		return action.ExecutionInvalidOpcode
+	}
+	return action.ExecutionOtherError
+}
+
+// decodeRevertReason decodes the reason from the data returned by the REVERT opcode, which is abi encoded as a call
+// to Error(string). It returns an empty string if the data is not in such form.
+func decodeRevertReason(data []byte) string {
+	if len(data) < 4+32+32 || !bytes.Equal(data[:4], revertReasonSelector) {
+		return ""
+	}
+	data = data[4:]
+	offset := new(big.Int).SetBytes(data[:32])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(data)-32) {
+		return ""
+	}
+	data = data[offset.Uint64():]
+	size := new(big.Int).SetBytes(data[:32])
+	if !size.IsUint64() || size.Uint64() > uint64(len(data)-32) {
+		return ""
+	}
+	return string(data[32 : 32+size.Uint64()])
 }
 
 // intrinsicGas returns the intrinsic gas of an execution
@@ -15,7 +15,11 @@ import (
 
 	"github.com/iotexproject/iotex-core/test/identityset"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -58,6 +62,19 @@ func TestLogReceipt(t *testing.T) {
 	require.Equal(receipt.Logs[0], actualReceipt.Logs[0])
 	require.Equal(len(receipt.Logs), len(actualReceipt.Logs))
 	require.Equal(receipt.ActHash, actualReceipt.ActHash)
+
+	// the error class and the revert reason are serialized, but not hashed
+	h := receipt.Hash()
+	receipt.Status = action.FailureReceiptStatus
+	receipt.ErrorClass = action.ExecutionReverted
+	receipt.RevertReason = "insufficient allowance"
+	s, err = receipt.Serialize()
+	require.NoError(err)
+	require.NoError(actualReceipt.Deserialize(s))
+	require.Equal(receipt.ErrorClass, actualReceipt.ErrorClass)
+	require.Equal(receipt.RevertReason, actualReceipt.RevertReason)
+	receipt.Status = 5
+	require.Equal(h, receipt.Hash())
 }
 
 func TestExecuteContractFailure(t *testing.T) {
@@ -90,5 +107,42 @@ func TestExecuteContractFailure(t *testing.T) {
 	receipt, err := ExecuteContract(ctx, sm, e, cm)
 	require.NotNil(t, receipt)
 	assert.Equal(t, action.FailureReceiptStatus, receipt.Status)
+	assert.Equal(t, action.ExecutionOtherError, receipt.ErrorClass)
 	require.NoError(t, err)
 }
+
+func TestExecutionErrorClass(t *testing.T) {
+	assert.Equal(t, action.ExecutionOutOfGas, executionErrorClass(vm.ErrOutOfGas))
+	assert.Equal(t, action.ExecutionOutOfGas, executionErrorClass(vm.ErrCodeStoreOutOfGas))
+	assert.Equal(t, action.ExecutionOutOfGas, executionErrorClass(action.ErrOutOfGas))
+	assert.Equal(t, action.ExecutionInsufficientBalance, executionErrorClass(vm.ErrInsufficientBalance))
+	assert.Equal(t, action.ExecutionInsufficientBalance, executionErrorClass(action.ErrInsufficientBalanceForGas))
+	assert.Equal(t, action.ExecutionReverted, executionErrorClass(errExecutionReverted))
+	assert.Equal(t, action.ExecutionReverted, executionErrorClass(errors.Wrap(errExecutionReverted, "wrapped")))
+	assert.Equal(t, action.ExecutionOtherError, executionErrorClass(errors.New("evm: other error")))
+	assert.Equal(t, action.ExecutionInvalidOpcode, executionErrorClass(errors.New("invalid opcode 0xfe")))
+	assert.Equal(t, action.ExecutionOtherError, executionErrorClass(vm.ErrDepth))
+
+	// the error of the evm running REVERT is told by its message
+	evm := vm.NewEVM(vm.Context{BlockNumber: new(big.Int)}, nil, getChainConfig(), vm.Config{})
+	contract := vm.NewContract(vm.AccountRef{}, vm.AccountRef{}, new(big.Int), params.TxGas)
+	contract.Code = []byte{byte(vm.PUSH1), 0, byte(vm.DUP1), byte(vm.REVERT)}
+	_, err := evm.Interpreter().Run(contract, nil, false)
+	assert.Equal(t, action.ExecutionReverted, executionErrorClass(err))
+}
+
+func TestDecodeRevertReason(t *testing.T) {
+	reason := "insufficient allowance"
+	data := append([]byte{}, revertReasonSelector...)
+	data = append(data, common.LeftPadBytes([]byte{0x20}, 32)...)
+	data = append(data, common.LeftPadBytes([]byte{byte(len(reason))}, 32)...)
+	data = append(data, common.RightPadBytes([]byte(reason), 32)...)
+	assert.Equal(t, reason, decodeRevertReason(data))
+
+	// not in the form of Error(string)
+	assert.Equal(t, "", decodeRevertReason(nil))
+	assert.Equal(t, "", decodeRevertReason(common.LeftPadBytes([]byte{0x2a}, 32)))
+	// the size exceeds the data
+	data[4+32+31] = 0xff
+	assert.Equal(t, "", decodeRevertReason(data))
+}
@@ -0,0 +1,250 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/iotexproject/iotex-core/address"
+)
+
+type tracerCtxKey struct{}
+
+// WithTracer attaches a tracer to the context, so that the contract executed with the context is traced
+func WithTracer(ctx context.Context, tracer vm.Tracer) context.Context {
+	return context.WithValue(ctx, tracerCtxKey{}, tracer)
+}
+
+// GetTracer gets the tracer attached to the context
+func GetTracer(ctx context.Context) (vm.Tracer, bool) {
+	tracer, ok := ctx.Value(tracerCtxKey{}).(vm.Tracer)
+	return tracer, ok
+}
+
+// CallFrame is a message call or a contract creation made during an execution
+type CallFrame struct {
+	// Type is the opcode starting the frame, i.e., CALL, CALLCODE, DELEGATECALL, STATICCALL, CREATE or CREATE2
+	Type    string
+	From    string
+	To      string
+	Value   *big.Int
+	Gas     uint64
+	GasUsed uint64
+	Input   []byte
+	Output  []byte
+	// Error is empty if the frame succeeds
+	Error string
+	Calls []*CallFrame
+
+	// parentGas is the gas left to the caller after it pays for the call
+	parentGas uint64
+	depth     int
+}
+
+// Tracer records the opcode trace of an execution as a structured logger does, and builds the call tree of it
+type Tracer struct {
+	*vm.StructLogger
+
+	root     *CallFrame
+	frames   []*CallFrame
+	pending  *CallFrame
+	reverted bool
+}
+
+// NewTracer returns a tracer
+func NewTracer(cfg *vm.LogConfig) *Tracer {
+	return &Tracer{StructLogger: vm.NewStructLogger(cfg)}
+}
+
+// CallFrame returns the root frame of the call tree
+func (t *Tracer) CallFrame() *CallFrame { return t.root }
+
+// RevertData returns the data returned by the REVERT opcode if the execution is reverted
+func (t *Tracer) RevertData() []byte {
+	if !t.reverted || t.root == nil {
+		return nil
+	}
+	return t.root.Output
+}
+
+// CaptureStart implements the vm.Tracer interface, which is called before the execution starts
+func (t *Tracer) CaptureStart(
+	from common.Address,
+	to common.Address,
+	create bool,
+	input []byte,
+	gas uint64,
+	value *big.Int,
+) error {
+	frameType := vm.CALL
+	if create {
+		frameType = vm.CREATE
+	}
+	t.root = &CallFrame{
+		Type:  frameType.String(),
+		From:  toIoAddress(from),
+		To:    toIoAddress(to),
+		Value: new(big.Int).Set(value),
+		Gas:   gas,
+		Input: common.CopyBytes(input),
+	}
+	t.frames = []*CallFrame{t.root}
+	return t.StructLogger.CaptureStart(from, to, create, input, gas, value)
+}
+
+// CaptureState implements the vm.Tracer interface, which is called before each opcode is executed
+func (t *Tracer) CaptureState(
+	env *vm.EVM,
+	pc uint64,
+	op vm.OpCode,
+	gas, cost uint64,
+	memory *vm.Memory,
+	stack *vm.Stack,
+	contract *vm.Contract,
+	depth int,
+	err error,
+) error {
+	if t.pending != nil {
+		if depth == t.pending.depth {
+			// the first opcode of the callee
+			t.pending.Gas = gas
+			if t.pending.Type == vm.CREATE.String() || t.pending.Type == vm.CREATE2.String() {
+				t.pending.To = toIoAddress(contract.Address())
+			}
+			t.push(t.pending)
+		} else {
+			// the callee does not run any code, e.g., a transfer to an account without code, so all the gas given to
+			// it returns to the caller
+			t.pending.Gas = 0
+			if gas > t.pending.parentGas {
+				t.pending.Gas = gas - t.pending.parentGas
+			}
+			t.push(t.pending)
+			t.pop(gas, stack)
+		}
+		t.pending = nil
+	}
+	for len(t.frames) > depth && len(t.frames) > 1 {
+		t.pop(gas, stack)
+	}
+	if err != nil {
+		// the opcode fails before it is executed, e.g., out of gas
+		t.fail(depth, err)
+		return t.StructLogger.CaptureState(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+	}
+	frame := t.frames[len(t.frames)-1]
+	switch op {
+	case vm.RETURN, vm.REVERT:
+		frame.Output = memoryCopy(memory, stack.Back(0), stack.Back(1))
+		t.reverted = op == vm.REVERT && depth == 1
+	case vm.CALL, vm.CALLCODE:
+		t.pending = &CallFrame{
+			Value: new(big.Int).Set(stack.Back(2)),
+			Gas:   stack.Back(0).Uint64(),
+			Input: memoryCopy(memory, stack.Back(3), stack.Back(4)),
+		}
+	case vm.DELEGATECALL, vm.STATICCALL:
+		t.pending = &CallFrame{
+			Value: big.NewInt(0),
+			Gas:   stack.Back(0).Uint64(),
+			Input: memoryCopy(memory, stack.Back(2), stack.Back(3)),
+		}
+	case vm.CREATE, vm.CREATE2:
+		t.pending = &CallFrame{
+			Value: new(big.Int).Set(stack.Back(0)),
+			Input: memoryCopy(memory, stack.Back(1), stack.Back(2)),
+		}
+	}
+	if t.pending != nil {
+		t.pending.Type = op.String()
+		t.pending.From = toIoAddress(contract.Address())
+		if op != vm.CREATE && op != vm.CREATE2 {
+			t.pending.To = toIoAddress(common.BigToAddress(stack.Back(1)))
+		}
+		t.pending.parentGas = gas - cost
+		t.pending.depth = depth + 1
+	}
+	return t.StructLogger.CaptureState(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+}
+
+// CaptureFault implements the vm.Tracer interface, which is called when an executed opcode fails
+func (t *Tracer) CaptureFault(
+	env *vm.EVM,
+	pc uint64,
+	op vm.OpCode,
+	gas, cost uint64,
+	memory *vm.Memory,
+	stack *vm.Stack,
+	contract *vm.Contract,
+	depth int,
+	err error,
+) error {
+	t.fail(depth, err)
+	return t.StructLogger.CaptureFault(env, pc, op, gas, cost, memory, stack, contract, depth, err)
+}
+
+// CaptureEnd implements the vm.Tracer interface, which is called after the execution ends
+func (t *Tracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.root != nil {
+		t.frames = t.frames[:1]
+		t.root.GasUsed = gasUsed
+		t.root.Output = common.CopyBytes(output)
+		if err != nil {
+			t.root.Error = err.Error()
+		}
+	}
+	return t.StructLogger.CaptureEnd(output, gasUsed, d, err)
+}
+
+func (t *Tracer) fail(depth int, err error) {
+	if depth > 0 && depth <= len(t.frames) {
+		t.frames[depth-1].Error = err.Error()
+	}
+}
+
+func (t *Tracer) push(frame *CallFrame) {
+	parent := t.frames[len(t.frames)-1]
+	parent.Calls = append(parent.Calls, frame)
+	t.frames = append(t.frames, frame)
+}
+
+// pop closes the innermost frame, given the gas and the stack of the caller right after the call returns
+func (t *Tracer) pop(gas uint64, stack *vm.Stack) {
+	frame := t.frames[len(t.frames)-1]
+	t.frames = t.frames[:len(t.frames)-1]
+	if gas >= frame.parentGas && frame.Gas >= gas-frame.parentGas {
+		frame.GasUsed = frame.Gas - (gas - frame.parentGas)
+	}
+	// the call pushes 0 onto the stack of the caller on failure, and the create pushes the contract address on success
+	result := stack.Back(0)
+	if result.Sign() == 0 && frame.Error == "" {
+		frame.Error = "execution failed"
+	}
+	if frame.To == "" && result.Sign() != 0 {
+		frame.To = toIoAddress(common.BigToAddress(result))
+	}
+}
+
+func toIoAddress(addr common.Address) string {
+	ioAddr, err := address.FromBytes(addr.Bytes())
+	if err != nil {
+		return ""
+	}
+	return ioAddr.String()
+}
+
+func memoryCopy(memory *vm.Memory, offset, size *big.Int) []byte {
+	if !offset.IsInt64() || !size.IsInt64() || offset.Int64()+size.Int64() > int64(memory.Len()) {
+		return nil
+	}
+	return memory.Get(offset.Int64(), size.Int64())
+}
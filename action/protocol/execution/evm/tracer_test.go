@@ -0,0 +1,100 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package evm
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracer(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		caller = common.HexToAddress("0x1000")
+		callee = common.HexToAddress("0x2000")
+		empty  = common.HexToAddress("0x3000")
+		// mstore(0, 42) and revert(0, 32)
+		revertCode = []byte{0x60, 0x2a, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xfd}
+		// call the callee and the account without code, then stop
+		callerCode = []byte{
+			0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x61, 0x20, 0x00, 0x61, 0xff, 0xff, 0xf1, 0x50,
+			0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x60, 0x00, 0x61, 0x30, 0x00, 0x61, 0xff, 0xff, 0xf1, 0x50,
+			0x00,
+		}
+		revertData = common.LeftPadBytes([]byte{0x2a}, 32)
+	)
+	trace := func(to common.Address) *Tracer {
+		sdb, err := state.New(common.Hash{}, state.NewDatabase(ethdb.NewMemDatabase()))
+		require.NoError(err)
+		sdb.SetCode(caller, callerCode)
+		sdb.SetCode(callee, revertCode)
+		tracer := NewTracer(nil)
+		_, _, _ = runtime.Call(to, nil, &runtime.Config{
+			ChainConfig: getChainConfig(),
+			State:       sdb,
+			GasLimit:    1000000,
+			EVMConfig:   vm.Config{Debug: true, Tracer: tracer},
+		})
+		return tracer
+	}
+
+	tracer := trace(caller)
+	require.Nil(tracer.RevertData())
+	require.NotEmpty(tracer.StructLogs())
+	root := tracer.CallFrame()
+	require.Equal("CALL", root.Type)
+	require.Equal(toIoAddress(caller), root.To)
+	require.Empty(root.Error)
+	require.True(root.GasUsed > 0)
+	require.Equal(2, len(root.Calls))
+
+	reverted := root.Calls[0]
+	require.Equal("CALL", reverted.Type)
+	require.Equal(toIoAddress(caller), reverted.From)
+	require.Equal(toIoAddress(callee), reverted.To)
+	require.Equal("evm: execution reverted", reverted.Error)
+	require.Equal(revertData, reverted.Output)
+	require.True(reverted.GasUsed > 0)
+	require.True(reverted.GasUsed < reverted.Gas)
+	require.Equal(0, len(reverted.Calls))
+
+	transfer := root.Calls[1]
+	require.Equal(toIoAddress(empty), transfer.To)
+	require.Empty(transfer.Error)
+	require.Equal(uint64(0), transfer.GasUsed)
+	require.True(root.GasUsed > reverted.GasUsed)
+
+	var depths []int
+	for _, l := range tracer.StructLogs() {
+		depths = append(depths, l.Depth)
+	}
+	require.Contains(depths, 2)
+
+	tracer = trace(callee)
+	require.Equal(revertData, tracer.RevertData())
+	require.Equal("evm: execution reverted", tracer.CallFrame().Error)
+	require.Equal(0, len(tracer.CallFrame().Calls))
+}
+
+func TestTracerContext(t *testing.T) {
+	require := require.New(t)
+
+	_, ok := GetTracer(context.Background())
+	require.False(ok)
+	tracer := NewTracer(nil)
+	got, ok := GetTracer(WithTracer(context.Background(), tracer))
+	require.True(ok)
+	require.Equal(tracer, got)
+}
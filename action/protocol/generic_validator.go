@@ -42,6 +42,30 @@ func (v *GenericValidator) Validate(ctx context.Context, act action.SealedEnvelo
 	if intrinsicGas > act.GasLimit() || err != nil {
 		return errors.Wrap(action.ErrInsufficientBalanceForGas, "insufficient gas")
 	}
+	// Reject bundle before it is enabled, or malformed bundle, or the one with an action of insufficient gas limit
+	if bundle, ok := act.Action().(*action.Bundle); ok {
+		if vaCtx.BlockHeight < vaCtx.BundleHeight {
+			return errors.Wrap(action.ErrBundle, "bundle is not enabled yet")
+		}
+		if err := bundle.SanityCheck(); err != nil {
+			return err
+		}
+		for _, inner := range bundle.Actions() {
+			intrinsicGas, err := inner.IntrinsicGas()
+			if intrinsicGas > inner.GasLimit() || err != nil {
+				return errors.Wrapf(action.ErrInsufficientBalanceForGas, "insufficient gas of action %d", inner.Nonce())
+			}
+		}
+	}
+	// Reject action signed as an Ethereum transaction before it is enabled, or for another chain
+	if act.EthChainID() != 0 {
+		if vaCtx.BlockHeight < vaCtx.Web3Height {
+			return errors.Wrap(action.ErrEthTransaction, "ethereum transaction is not enabled yet")
+		}
+		if act.EthChainID() != v.cm.ChainID() {
+			return errors.Wrapf(action.ErrEthTransaction, "transaction is signed for chain %d", act.EthChainID())
+		}
+	}
 	// Verify action using action sender's public key
 	if err := action.Verify(act); err != nil {
 		return errors.Wrap(err, "failed to verify action signature")
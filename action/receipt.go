@@ -7,6 +7,8 @@
 package action
 
 import (
+	"math/big"
+
 	"github.com/golang/protobuf/proto"
 
 	"github.com/iotexproject/iotex-core/pkg/hash"
@@ -21,6 +23,21 @@ const (
 	SuccessReceiptStatus = uint64(1)
 )
 
+const (
+	// ExecutionNoError is the error class of a successful execution
+	ExecutionNoError = iota
+	// ExecutionReverted is the error class of an execution reverted by the contract
+	ExecutionReverted
+	// ExecutionOutOfGas is the error class of an execution running out of gas
+	ExecutionOutOfGas
+	// ExecutionInvalidOpcode is the error class of an execution hitting an invalid opcode
+	ExecutionInvalidOpcode
+	// ExecutionInsufficientBalance is the error class of an execution without enough balance to transfer or pay gas
+	ExecutionInsufficientBalance
+	// ExecutionOtherError is the error class of an execution failing for any other reason
+	ExecutionOtherError
+)
+
 // Receipt represents the result of a contract
 type Receipt struct {
 	ReturnValue     []byte
@@ -29,6 +46,16 @@ type Receipt struct {
 	GasConsumed     uint64
 	ContractAddress string
 	Logs            []*Log
+	ErrorClass      int
+	RevertReason    string
+	TransferResults []*TransferResult
+}
+
+// TransferResult stores the result of the transfer to a recipient of a multi-transfer
+type TransferResult struct {
+	Recipient string
+	Amount    *big.Int
+	Status    uint64
 }
 
 // Log stores an evm contract event
@@ -53,6 +80,15 @@ func (receipt *Receipt) ConvertToReceiptPb() *iotextypes.Receipt {
 	for _, log := range receipt.Logs {
 		r.Logs = append(r.Logs, log.ConvertToLogPb())
 	}
+	r.ErrorClass = iotextypes.ExecutionErrorClass(receipt.ErrorClass)
+	r.RevertReason = receipt.RevertReason
+	for _, result := range receipt.TransferResults {
+		r.TransferResults = append(r.TransferResults, &iotextypes.TransferResult{
+			Recipient: result.Recipient,
+			Amount:    result.Amount.String(),
+			Status:    result.Status,
+		})
+	}
 	return r
 }
 
@@ -69,6 +105,18 @@ func (receipt *Receipt) ConvertFromReceiptPb(pbReceipt *iotextypes.Receipt) {
 		receipt.Logs[i] = &Log{}
 		receipt.Logs[i].ConvertFromLogPb(log)
 	}
+	receipt.ErrorClass = int(pbReceipt.GetErrorClass())
+	receipt.RevertReason = pbReceipt.GetRevertReason()
+	receipt.TransferResults = nil
+	for _, pbResult := range pbReceipt.GetTransferResults() {
+		amount := big.NewInt(0)
+		amount.SetString(pbResult.GetAmount(), 10)
+		receipt.TransferResults = append(receipt.TransferResults, &TransferResult{
+			Recipient: pbResult.GetRecipient(),
+			Amount:    amount,
+			Status:    pbResult.GetStatus(),
+		})
+	}
 }
 
 // Serialize returns a serialized byte stream for the Receipt
@@ -86,9 +134,13 @@ func (receipt *Receipt) Deserialize(buf []byte) error {
 	return nil
 }
 
-// Hash returns the hash of receipt
+// Hash returns the hash of receipt. The error class and the revert reason are not covered, so that the receipt root
+// of a block stays the same as it was before they were recorded
 func (receipt *Receipt) Hash() hash.Hash256 {
-	data, err := receipt.Serialize()
+	r := receipt.ConvertToReceiptPb()
+	r.ErrorClass = iotextypes.ExecutionErrorClass_ExecutionNoError
+	r.RevertReason = ""
+	data, err := proto.Marshal(r)
 	if err != nil {
 		log.L().Panic("Error when serializing a receipt")
 	}
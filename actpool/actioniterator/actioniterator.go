@@ -40,12 +40,15 @@ func (s *actionByPrice) Pop() interface{} {
 // ActionIterator define the interface of action iterator
 type ActionIterator interface {
 	Next() (action.SealedEnvelope, bool)
+	// PopAccount removes the remaining actions of the account of the action last returned by Next
 	PopAccount()
 }
 
 type actionIterator struct {
 	accountActs map[string][]action.SealedEnvelope
 	heads       actionByPrice
+	// lastSender is the account of the action last returned by Next, whose next action is not loaded yet
+	lastSender string
 }
 
 // NewActionIterator return a new action iterator
@@ -70,33 +73,38 @@ func NewActionIterator(accountActs map[string][]action.SealedEnvelope) ActionIte
 	}
 }
 
-// LoadNext load next action of account of top action
-func (ai *actionIterator) loadNextActionForTopAccount() {
-	sender := ai.heads[0].SrcPubkey()
-	callerAddr, _ := address.FromBytes(sender.Hash())
-	callerAddrStr := callerAddr.String()
-	if actions, ok := ai.accountActs[callerAddrStr]; ok && len(actions) > 0 {
-		ai.heads[0], ai.accountActs[callerAddrStr] = actions[0], actions[1:]
-		heap.Fix(&ai.heads, 0)
-	} else {
-		heap.Pop(&ai.heads)
+// loadNextActionForLastAccount loads the next action of the account of the action last returned
+func (ai *actionIterator) loadNextActionForLastAccount() {
+	if ai.lastSender == "" {
+		return
 	}
+	if actions, ok := ai.accountActs[ai.lastSender]; ok && len(actions) > 0 {
+		heap.Push(&ai.heads, actions[0])
+		ai.accountActs[ai.lastSender] = actions[1:]
+	}
+	ai.lastSender = ""
 }
 
 // Next load next action of account of top action
 func (ai *actionIterator) Next() (action.SealedEnvelope, bool) {
+	ai.loadNextActionForLastAccount()
 	if len(ai.heads) == 0 {
 		return action.SealedEnvelope{}, false
 	}
 
-	headAction := ai.heads[0]
-	ai.loadNextActionForTopAccount()
+	headAction := heap.Pop(&ai.heads).(action.SealedEnvelope)
+	callerAddr, err := address.FromBytes(headAction.SrcPubkey().Hash())
+	if err == nil {
+		ai.lastSender = callerAddr.String()
+	}
 	return headAction, true
 }
 
 // PopAccount will remove all actions related to this account
 func (ai *actionIterator) PopAccount() {
-	if len(ai.heads) != 0 {
-		heap.Pop(&ai.heads)
+	if ai.lastSender == "" {
+		return
 	}
+	delete(ai.accountActs, ai.lastSender)
+	ai.lastSender = ""
 }
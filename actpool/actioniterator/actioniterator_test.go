@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/test/testaddress"
 )
 
@@ -97,3 +98,48 @@ func TestActionIterator(t *testing.T) {
 	}
 	require.Equal(appliedActionList, []action.SealedEnvelope{selp3, selp1, selp2, selp4, selp5, selp6})
 }
+
+func TestActionIterator_PopAccount(t *testing.T) {
+	require := require.New(t)
+
+	a := testaddress.Addrinfo["alfa"]
+	priKeyA := testaddress.Keyinfo["alfa"].PriKey
+	b := testaddress.Addrinfo["bravo"]
+	priKeyB := testaddress.Keyinfo["bravo"].PriKey
+	signedTransfer := func(nonce uint64, gasPrice int64, priKey keypair.PrivateKey) action.SealedEnvelope {
+		tsf, err := action.NewTransfer(nonce, big.NewInt(100), "1", nil, uint64(0), big.NewInt(gasPrice))
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(nonce).
+			SetGasPrice(big.NewInt(gasPrice)).
+			SetAction(tsf).Build()
+		selp, err := action.Sign(elp, priKey)
+		require.NoError(err)
+		return selp
+	}
+	selp1 := signedTransfer(1, 20, priKeyA)
+	selp2 := signedTransfer(2, 30, priKeyA)
+	selp3 := signedTransfer(3, 5, priKeyA)
+	selp4 := signedTransfer(1, 10, priKeyB)
+	selp5 := signedTransfer(2, 10, priKeyB)
+	accMap := map[string][]action.SealedEnvelope{
+		a.String(): {selp1, selp2, selp3},
+		b.String(): {selp4, selp5},
+	}
+
+	ai := NewActionIterator(accMap)
+	appliedActionList := make([]action.SealedEnvelope, 0)
+	for {
+		bestAction, ok := ai.Next()
+		if !ok {
+			break
+		}
+		// the action of alfa with nonce 2 does not fit, so neither does the one with nonce 3
+		if bestAction.Nonce() == 2 && bestAction.GasPrice().Cmp(big.NewInt(30)) == 0 {
+			ai.PopAccount()
+			continue
+		}
+		appliedActionList = append(appliedActionList, bestAction)
+	}
+	require.Equal([]action.SealedEnvelope{selp1, selp4, selp5}, appliedActionList)
+}
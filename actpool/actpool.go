@@ -8,10 +8,13 @@ package actpool
 
 import (
 	"context"
+	"math/big"
 	"sync"
+	"time"
 
 	"github.com/iotexproject/iotex-core/pkg/prometheustimer"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action"
@@ -20,17 +23,70 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/lifecycle"
 	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
+var (
+	// ErrReplaced indicates that the action is replaced by another one with the same nonce and a higher gas price
+	ErrReplaced = errors.New("replaced by an action with the same nonce and a higher gas price")
+	// ErrReplacementCost indicates that the action is unpayable after an earlier action of the sender is replaced by
+	// one costing more
+	ErrReplacementCost = errors.New("unpayable after an earlier action is replaced by a costlier one")
+	// ErrEvicted indicates that the action is evicted from the full pool by another one with a higher gas price
+	ErrEvicted = errors.New("evicted by an action with a higher gas price as the pool is full")
+	// ErrConfirmed indicates that the action is removed from the pool as the nonce has been confirmed in a block
+	ErrConfirmed = errors.New("nonce confirmed in a block")
+	// ErrTimedOut indicates that the action is kept in the pool longer than the expiry
+	ErrTimedOut = errors.New("timed out in pool")
+	// ErrGasPriceTooLow indicates that the gas price of the action is lower than the minimum accepted by this node
+	ErrGasPriceTooLow = errors.New("gas price lower than the minimum of the node")
+	// ErrSenderRateLimited indicates that the sender has more actions accepted per second than the limit
+	ErrSenderRateLimited = errors.New("too many actions of the sender")
+	// ErrPeerRateLimited indicates that the peer has gossiped more actions per second than the limit
+	ErrPeerRateLimited = errors.New("too many actions gossiped by the peer")
+
+	evictionMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_actpool_eviction",
+			Help: "Action pool eviction counter.",
+		},
+		[]string{"type"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(evictionMtc)
+}
+
+// Subscriber is notified of the actions accepted by and dropped from the pool. It is called with the pool locked, so
+// it must not block or call back into the pool
+type Subscriber interface {
+	// HandleAcceptedAction is called with the action accepted by the pool
+	HandleAcceptedAction(act action.SealedEnvelope)
+	// HandleDroppedAction is called with the action dropped from the pool and the reason, which is one of ErrConfirmed,
+	// ErrReplaced, ErrReplacementCost, ErrEvicted, ErrTimedOut, action.ErrExpired and action.ErrBalance, or the reason
+	// given to ActPool.DropAction
+	HandleDroppedAction(act action.SealedEnvelope, reason error)
+}
+
 // ActPool is the interface of actpool
 type ActPool interface {
+	lifecycle.StartStopper
 	// Reset resets actpool state
 	Reset()
 	// PendingActionMap returns an action map with all accepted actions
 	PendingActionMap() map[string][]action.SealedEnvelope
 	// Add adds an action into the pool after passing validation
 	Add(act action.SealedEnvelope) error
+	// AddLocal adds an action submitted to this node into the pool after passing validation. The action is kept
+	// longer than the gossiped ones, and is broadcast again if it is not included after a number of blocks
+	AddLocal(act action.SealedEnvelope) error
+	// AddFromPeer adds an action gossiped by a peer into the pool after passing validation
+	AddFromPeer(act action.SealedEnvelope, peer string) error
+	// DropAction removes the action, which can never be included, like a bundle reverted in minting, from the pool for
+	// the reason, along with the later actions of the sender
+	DropAction(act action.SealedEnvelope, reason error)
 	// GetPendingNonce returns pending nonce in pool given an account address
 	GetPendingNonce(addr string) (uint64, error)
 	// GetUnconfirmedActs returns unconfirmed actions in pool given an account address
@@ -41,10 +97,46 @@ type ActPool interface {
 	GetSize() uint64
 	// GetCapacity returns the act pool capacity
 	GetCapacity() uint64
+	// GetStatus returns the numbers of pending and queued actions and of accounts in pool
+	GetStatus() Status
+	// GetContent returns the actions in pool grouped by sender
+	GetContent() map[string]AccountContent
 	// AddActionValidators add validators
 	AddActionValidators(...protocol.ActionValidator)
 
 	AddActionEnvelopeValidators(...protocol.ActionEnvelopeValidator)
+	// AddSubscriber adds a subscriber watching the pool
+	AddSubscriber(Subscriber) error
+	// RemoveSubscriber removes a subscriber watching the pool
+	RemoveSubscriber(Subscriber) error
+}
+
+// Status is the status of the pool
+type Status struct {
+	// NumPending is the number of actions which are executable, i.e., the nonces are consecutive to the confirmed
+	// nonce of the sender, and the balance covers the cost
+	NumPending uint64
+	// NumQueued is the number of actions which are not executable yet
+	NumQueued   uint64
+	Capacity    uint64
+	NumAccounts uint64
+}
+
+// NonceGap is a range of missing nonces which blocks the queued actions of an account
+type NonceGap struct {
+	// Start is the first missing nonce
+	Start uint64
+	// End is the last missing nonce
+	End uint64
+}
+
+// AccountContent is the actions in pool of an account
+type AccountContent struct {
+	PendingNonce uint64
+	Pending      []action.SealedEnvelope
+	Queued       []action.SealedEnvelope
+	// Gaps are the missing nonces from the pending nonce up to the last queued action
+	Gaps []NonceGap
 }
 
 // actPool implements ActPool interface
@@ -57,18 +149,50 @@ type actPool struct {
 	actionEnvelopeValidators []protocol.ActionEnvelopeValidator
 	validators               []protocol.ActionValidator
 	timerFactory             *prometheustimer.TimerFactory
+	subscribers              []Subscriber
+	broadcastHandler         BroadcastOutbound
+	// outbound are the actions to broadcast once the pool is unlocked
+	outbound []action.SealedEnvelope
+	journal  *journal
+	// journaled are the actions loaded from journal, which are replayed when the pool starts
+	journaled      []journalRecord
+	lastCompaction time.Time
+	localActs      map[hash.Hash256]*localAction
+	minGasPrice    *big.Int
+	senderLimiter  *rateLimiter
+	peerLimiter    *rateLimiter
+}
+
+// localAction is an action submitted to this node, which is broadcast again until it is included
+type localAction struct {
+	act action.SealedEnvelope
+	// rebroadcastHeight is the height since which the action is to be broadcast again
+	rebroadcastHeight uint64
+	// interval is the number of blocks to wait before the next re-broadcast, which doubles after each one
+	interval uint64
 }
 
 // NewActPool constructs a new actpool
-func NewActPool(bc blockchain.Blockchain, cfg config.ActPool) (ActPool, error) {
+func NewActPool(bc blockchain.Blockchain, cfg config.ActPool, opts ...ActPoolOption) (ActPool, error) {
 	if bc == nil {
 		return nil, errors.New("Try to attach a nil blockchain")
 	}
+	minGasPrice, err := cfg.MinGasPriceRau()
+	if err != nil {
+		return nil, err
+	}
 	ap := &actPool{
-		cfg:         cfg,
-		bc:          bc,
-		accountActs: make(map[string]ActQueue),
-		allActions:  make(map[hash.Hash256]action.SealedEnvelope),
+		cfg:           cfg,
+		bc:            bc,
+		accountActs:   make(map[string]ActQueue),
+		allActions:    make(map[hash.Hash256]action.SealedEnvelope),
+		localActs:     make(map[hash.Hash256]*localAction),
+		minGasPrice:   minGasPrice,
+		senderLimiter: newRateLimiter(cfg.MaxActsPerSenderPerSecond),
+		peerLimiter:   newRateLimiter(cfg.MaxGossipActsPerPeerPerSecond),
+	}
+	for _, opt := range opts {
+		opt.SetActPoolOption(ap)
 	}
 	timerFactory, err := prometheustimer.New(
 		"iotex_action_pool_perf",
@@ -80,6 +204,13 @@ func NewActPool(bc blockchain.Blockchain, cfg config.ActPool) (ActPool, error) {
 		return nil, err
 	}
 	ap.timerFactory = timerFactory
+	if cfg.JournalPath != "" {
+		ap.journal = newJournal(cfg.JournalPath)
+		if ap.journaled, err = ap.journal.load(); err != nil {
+			return nil, errors.Wrap(err, "failed to load actpool journal")
+		}
+		ap.lastCompaction = time.Now()
+	}
 	return ap, nil
 }
 
@@ -92,6 +223,32 @@ func (ap *actPool) AddActionEnvelopeValidators(fs ...protocol.ActionEnvelopeVali
 	ap.actionEnvelopeValidators = append(ap.actionEnvelopeValidators, fs...)
 }
 
+// AddSubscriber adds a subscriber watching the pool
+func (ap *actPool) AddSubscriber(s Subscriber) error {
+	if s == nil {
+		return errors.New("subscriber could not be nil")
+	}
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	ap.subscribers = append(ap.subscribers, s)
+	return nil
+}
+
+// RemoveSubscriber removes a subscriber watching the pool
+func (ap *actPool) RemoveSubscriber(s Subscriber) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	for i, sub := range ap.subscribers {
+		if sub == s {
+			ap.subscribers = append(ap.subscribers[:i], ap.subscribers[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("cannot find subscription")
+}
+
 // Reset resets actpool state
 // Step I: remove all the actions in actpool that have already been committed to block
 // Step II: update pending balance of each account if it still exists in pool
@@ -102,15 +259,42 @@ func (ap *actPool) AddActionEnvelopeValidators(fs ...protocol.ActionEnvelopeVali
 // balance is sufficient, and remove all the subsequent actions once the pending balance becomes insufficient
 func (ap *actPool) Reset() {
 	ap.mutex.Lock()
-	defer ap.mutex.Unlock()
+	defer ap.unlockAndBroadcast()
 
 	ap.reset()
 }
 
+// Start syncs the pool with the started chain, which replays the journaled actions, so that they are served and
+// broadcast again before the next block arrives
+func (ap *actPool) Start(_ context.Context) error {
+	ap.mutex.Lock()
+	defer ap.unlockAndBroadcast()
+
+	if ap.journaled != nil {
+		ap.replayJournal()
+	}
+	ap.reset()
+	return nil
+}
+
+// Stop closes the journal
+func (ap *actPool) Stop(_ context.Context) error {
+	ap.mutex.Lock()
+	defer ap.mutex.Unlock()
+
+	if ap.journal == nil {
+		return nil
+	}
+	if err := ap.journal.close(); err != nil {
+		return errors.Wrap(err, "failed to close actpool journal")
+	}
+	return nil
+}
+
 // PendingActionIterator returns an action interator with all accepted actions
 func (ap *actPool) PendingActionMap() map[string][]action.SealedEnvelope {
 	ap.mutex.Lock()
-	defer ap.mutex.Unlock()
+	defer ap.unlockAndBroadcast()
 
 	// Remove the actions that are already timeout
 	ap.reset()
@@ -124,46 +308,74 @@ func (ap *actPool) PendingActionMap() map[string][]action.SealedEnvelope {
 
 func (ap *actPool) Add(act action.SealedEnvelope) error {
 	ap.mutex.Lock()
-	defer ap.mutex.Unlock()
-	// Reject action if pool space is full
-	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
-		return errors.Wrap(action.ErrActPool, "insufficient space for action")
+	defer ap.unlockAndBroadcast()
+
+	return ap.accept(act)
+}
+
+// AddLocal adds an action submitted to this node into the pool
+func (ap *actPool) AddLocal(act action.SealedEnvelope) error {
+	ap.mutex.Lock()
+	defer ap.unlockAndBroadcast()
+
+	if err := ap.accept(act); err != nil {
+		return err
 	}
-	hash := act.Hash()
-	// Reject action if it already exists in pool
-	if _, exist := ap.allActions[hash]; exist {
-		return errors.Errorf("reject existed action: %x", hash)
+	caller, err := address.FromBytes(act.SrcPubkey().Hash())
+	if err != nil {
+		return err
 	}
+	if queue, ok := ap.accountActs[caller.String()]; ok && ap.cfg.LocalActionExpiry != 0 {
+		queue.SetTimeOut(act.Nonce(), ap.cfg.LocalActionExpiry)
+	}
+	ap.localActs[act.Hash()] = &localAction{
+		act:               act,
+		rebroadcastHeight: ap.bc.TipHeight() + ap.cfg.LocalRebroadcastInterval,
+		interval:          ap.cfg.LocalRebroadcastInterval,
+	}
+	return nil
+}
+
+// AddFromPeer adds an action gossiped by a peer into the pool
+func (ap *actPool) AddFromPeer(act action.SealedEnvelope, peer string) error {
+	ap.mutex.Lock()
+	defer ap.unlockAndBroadcast()
+
+	// Count all the actions gossiped by the peer, including the rejected ones, as they cost as much to validate
+	if !ap.peerLimiter.allow(peer) {
+		return errors.Wrapf(ErrPeerRateLimited, "peer %s", peer)
+	}
+	ap.peerLimiter.take(peer)
+	return ap.accept(act)
+}
 
+// DropAction removes the action and the later actions of the sender from the pool
+func (ap *actPool) DropAction(act action.SealedEnvelope, reason error) {
+	ap.mutex.Lock()
+	defer ap.unlockAndBroadcast()
+
+	hash := act.Hash()
+	if _, ok := ap.allActions[hash]; !ok {
+		return
+	}
 	caller, err := address.FromBytes(act.SrcPubkey().Hash())
 	if err != nil {
-		return err
+		return
 	}
-	// envelope validation
-	for _, validator := range ap.actionEnvelopeValidators {
-		ctx := protocol.WithValidateActionsCtx(
-			context.Background(),
-			protocol.ValidateActionsCtx{
-				Caller: caller,
-			},
-		)
-		if err := validator.Validate(ctx, act); err != nil {
-			return errors.Wrapf(err, "reject invalid action: %x", hash)
+	sender := caller.String()
+	queue := ap.accountActs[sender]
+	var dropped []action.SealedEnvelope
+	for !queue.Empty() {
+		acts := queue.AllActs()
+		if acts[len(acts)-1].Nonce() < act.Nonce() {
+			break
 		}
+		dropped = append(dropped, queue.RemoveTail())
 	}
-	// Reject action if it's invalid
-	for _, validator := range ap.validators {
-		ctx := protocol.WithValidateActionsCtx(
-			context.Background(),
-			protocol.ValidateActionsCtx{
-				Caller: caller,
-			},
-		)
-		if err := validator.Validate(ctx, act.Action()); err != nil {
-			return errors.Wrapf(err, "reject invalid action: %x", hash)
-		}
+	ap.removeInvalidActs(dropped, reason)
+	if err := ap.resetAccount(sender, action.ErrBalance); err != nil {
+		log.L().Error("Error when resetting account after dropping action.", zap.Error(err))
 	}
-	return ap.enqueueAction(caller.String(), act, hash, act.Nonce())
 }
 
 // GetPendingNonce returns pending nonce in pool or confirmed nonce given an account address
@@ -218,9 +430,161 @@ func (ap *actPool) GetCapacity() uint64 {
 	return ap.cfg.MaxNumActsPerPool
 }
 
+// GetStatus returns the numbers of pending and queued actions and of accounts in pool
+func (ap *actPool) GetStatus() Status {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+
+	status := Status{
+		Capacity:    ap.cfg.MaxNumActsPerPool,
+		NumAccounts: uint64(len(ap.accountActs)),
+	}
+	for _, queue := range ap.accountActs {
+		numPending := uint64(len(queue.PendingActs()))
+		status.NumPending += numPending
+		status.NumQueued += uint64(queue.Len()) - numPending
+	}
+	return status
+}
+
+// GetContent returns the actions in pool grouped by sender
+func (ap *actPool) GetContent() map[string]AccountContent {
+	ap.mutex.RLock()
+	defer ap.mutex.RUnlock()
+
+	content := make(map[string]AccountContent, len(ap.accountActs))
+	for addr, queue := range ap.accountActs {
+		pendingNonce := queue.PendingNonce()
+		acct := AccountContent{
+			PendingNonce: pendingNonce,
+			Pending:      make([]action.SealedEnvelope, 0),
+			Queued:       make([]action.SealedEnvelope, 0),
+			Gaps:         make([]NonceGap, 0),
+		}
+		// The actions are sorted by nonce, and the ones before the pending nonce are pending
+		nextNonce := pendingNonce
+		for _, act := range queue.AllActs() {
+			if act.Nonce() < pendingNonce {
+				acct.Pending = append(acct.Pending, act)
+				continue
+			}
+			if act.Nonce() > nextNonce {
+				acct.Gaps = append(acct.Gaps, NonceGap{Start: nextNonce, End: act.Nonce() - 1})
+			}
+			acct.Queued = append(acct.Queued, act)
+			nextNonce = act.LastNonce() + 1
+		}
+		content[addr] = acct
+	}
+	return content
+}
+
 //======================================
 // private functions
 //======================================
+// accept adds the action into the pool and journals it
+func (ap *actPool) accept(act action.SealedEnvelope) error {
+	caller, err := address.FromBytes(act.SrcPubkey().Hash())
+	if err != nil {
+		return err
+	}
+	if !ap.senderLimiter.allow(caller.String()) {
+		return errors.Wrapf(ErrSenderRateLimited, "sender %s", caller.String())
+	}
+	if err := ap.add(act); err != nil {
+		return err
+	}
+	ap.senderLimiter.take(caller.String())
+	for _, s := range ap.subscribers {
+		s.HandleAcceptedAction(act)
+	}
+	if ap.journal != nil {
+		if err := ap.journal.insert(act, time.Now()); err != nil {
+			hash := act.Hash()
+			log.L().Warn("Failed to journal action.", log.Hex("hash", hash[:]), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func (ap *actPool) add(act action.SealedEnvelope) error {
+	caller, err := address.FromBytes(act.SrcPubkey().Hash())
+	if err != nil {
+		return err
+	}
+	// Reject action if the gas price is lower than the minimum of this node
+	if act.GasPrice().Cmp(ap.minGasPrice) < 0 {
+		return errors.Wrapf(
+			ErrGasPriceTooLow,
+			"gas price %s is lower than %s",
+			act.GasPrice().String(),
+			ap.minGasPrice.String(),
+		)
+	}
+	// Reject action if it cannot be included in the next block since the expiry is enabled. The tip height is only
+	// read for the actions which may expire
+	if act.ValidUntilHeight() != 0 {
+		if nextHeight := ap.bc.TipHeight() + 1; nextHeight >= ap.bc.Genesis().ActionExpiryHeight && act.Expired(nextHeight) {
+			return errors.Wrapf(
+				action.ErrExpired,
+				"action is valid until height %d, while the tip height is %d",
+				act.ValidUntilHeight(),
+				nextHeight-1,
+			)
+		}
+	}
+	// Reject action if pool space is full, unless it replaces an action in pool or another action can be evicted
+	if uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool {
+		if queue, ok := ap.accountActs[caller.String()]; !ok || !queue.Overlaps(act) {
+			if _, _, ok := ap.evictionCandidate(caller.String(), act.GasPrice()); !ok {
+				evictionMtc.WithLabelValues("rejected").Inc()
+				return errors.Wrap(action.ErrActPool, "insufficient space for action")
+			}
+		}
+	}
+	hash := act.Hash()
+	// Reject action if it already exists in pool
+	if _, exist := ap.allActions[hash]; exist {
+		return errors.Errorf("reject existed action: %x", hash)
+	}
+
+	// envelope validation
+	for _, validator := range ap.actionEnvelopeValidators {
+		ctx := protocol.WithValidateActionsCtx(
+			context.Background(),
+			protocol.ValidateActionsCtx{
+				BlockHeight:         ap.bc.TipHeight() + 1,
+				Caller:              caller,
+				Web3Height:          ap.bc.Genesis().Web3Height,
+				BundleHeight:        ap.bc.Genesis().BundleHeight,
+				MultiTransferHeight: ap.bc.Genesis().MultiTransferHeight,
+			},
+		)
+		if err := validator.Validate(ctx, act); err != nil {
+			return errors.Wrapf(err, "reject invalid action: %x", hash)
+		}
+	}
+	// Reject action if it's invalid, or any action in it is invalid if it's a bundle
+	for _, validator := range ap.validators {
+		ctx := protocol.WithValidateActionsCtx(
+			context.Background(),
+			protocol.ValidateActionsCtx{
+				BlockHeight:         ap.bc.TipHeight() + 1,
+				Caller:              caller,
+				Web3Height:          ap.bc.Genesis().Web3Height,
+				BundleHeight:        ap.bc.Genesis().BundleHeight,
+				MultiTransferHeight: ap.bc.Genesis().MultiTransferHeight,
+			},
+		)
+		for _, inner := range action.Unbundle(act) {
+			if err := validator.Validate(ctx, inner.Action()); err != nil {
+				return errors.Wrapf(err, "reject invalid action: %x", hash)
+			}
+		}
+	}
+	return ap.enqueueAction(caller.String(), act, hash, act.Nonce())
+}
+
 func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash hash.Hash256, actNonce uint64) error {
 	confirmedNonce, err := ap.bc.Nonce(sender)
 	if err != nil {
@@ -243,11 +607,11 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash
 		queue.SetPendingBalance(balance)
 	}
 	if queue.Overlaps(act) {
-		// Nonce already exists
-		return errors.Wrapf(action.ErrNonce, "duplicate nonce for action %x", hash)
+		// Nonce already exists, try to replace the action with the same nonce
+		return ap.replaceAction(sender, queue, act, hash)
 	}
 
-	if actNonce-confirmedNonce-1 >= ap.cfg.MaxNumActsPerAcct {
+	if act.LastNonce()-confirmedNonce-1 >= ap.cfg.MaxNumActsPerAcct {
 		// Nonce exceeds current range
 		log.L().Debug("Rejecting action because nonce is too large.",
 			log.Hex("hash", hash[:]),
@@ -271,18 +635,216 @@ func (ap *actPool) enqueueAction(sender string, act action.SealedEnvelope, hash
 		)
 	}
 
+	var (
+		full         = uint64(len(ap.allActions)) >= ap.cfg.MaxNumActsPerPool
+		evictFrom    string
+		evictPending bool
+	)
+	if full {
+		// Only an action which is pending can take the place of another, since a queued one may never be executed
+		if actNonce != queue.PendingNonce() {
+			evictionMtc.WithLabelValues("rejected").Inc()
+			return errors.Wrapf(action.ErrActPool, "insufficient space for queued action %x", hash)
+		}
+		var ok bool
+		if evictFrom, evictPending, ok = ap.evictionCandidate(sender, act.GasPrice()); !ok {
+			evictionMtc.WithLabelValues("rejected").Inc()
+			return errors.Wrapf(
+				action.ErrActPool,
+				"insufficient space for action %x, no action with gas price lower than %s to evict",
+				hash,
+				act.GasPrice().String(),
+			)
+		}
+	}
 	if err := queue.Put(act); err != nil {
 		return errors.Wrapf(err, "cannot put action %x into ActQueue", hash)
 	}
 	ap.allActions[hash] = act
+	// The action is evicted only once the new one is put, so that the pool does not lose an action for nothing
+	if full {
+		if err := ap.evict(evictFrom, evictPending); err != nil {
+			return errors.Wrapf(err, "failed to evict action for %x", hash)
+		}
+	}
 	// If the pending nonce equals this nonce, update queue
 	nonce := queue.PendingNonce()
 	if actNonce == nonce {
-		ap.updateAccount(sender)
+		ap.updateAccount(sender, action.ErrBalance)
+	}
+	return nil
+}
+
+// replaceAction replaces the action with the same nonce in the queue, if the gas price is bumped enough and the
+// pending balance is sufficient for the new action
+func (ap *actPool) replaceAction(
+	sender string,
+	queue ActQueue,
+	act action.SealedEnvelope,
+	hash hash.Hash256,
+) error {
+	var (
+		old   action.SealedEnvelope
+		found bool
+	)
+	for _, pending := range queue.AllActs() {
+		if pending.Nonce() == act.Nonce() {
+			old, found = pending, true
+			break
+		}
+	}
+	// A bundle only replaces an action taking exactly the same nonces, and vice versa
+	if !found || old.LastNonce() != act.LastNonce() {
+		return errors.Wrapf(
+			action.ErrNonce,
+			"nonces %d to %d of action %x partially overlap the actions in pool",
+			act.Nonce(),
+			act.LastNonce(),
+			hash,
+		)
+	}
+	// The gas price has to be higher than the old one by at least the bump percentage
+	minGasPrice := new(big.Int).Mul(old.GasPrice(), big.NewInt(int64(100+ap.cfg.MinGasPriceBumpPercent)))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+	if act.GasPrice().Cmp(old.GasPrice()) <= 0 || act.GasPrice().Cmp(minGasPrice) < 0 {
+		return errors.Wrapf(
+			action.ErrGasPrice,
+			"gas price of action %x is too low to replace the action with nonce %d, gas price = %s, minimum = %s",
+			hash,
+			act.Nonce(),
+			act.GasPrice().String(),
+			minGasPrice.String(),
+		)
+	}
+	cost, err := act.Cost()
+	if err != nil {
+		return errors.Wrapf(err, "failed to get cost of action %x", hash)
+	}
+	// The cost of the old action has been deducted from the pending balance if it is pending
+	balance := new(big.Int).Set(queue.PendingBalance())
+	if act.Nonce() < queue.PendingNonce() {
+		oldCost, err := old.Cost()
+		if err != nil {
+			return errors.Wrapf(err, "failed to get cost of action %x", old.Hash())
+		}
+		balance.Add(balance, oldCost)
+	}
+	if balance.Cmp(cost) < 0 {
+		return errors.Wrapf(
+			action.ErrBalance,
+			"insufficient balance for action %x, cost = %s, pending balance = %s",
+			hash,
+			cost.String(),
+			balance.String(),
+		)
+	}
+
+	if _, err := queue.Replace(act); err != nil {
+		return errors.Wrapf(err, "cannot replace action in ActQueue by %x", hash)
+	}
+	oldHash := old.Hash()
+	delete(ap.allActions, oldHash)
+	ap.allActions[hash] = act
+	log.L().Debug("Replaced action.", log.Hex("hash", oldHash[:]), log.Hex("replacement", hash[:]))
+
+	// Recompute the pending balance and nonce of the account, since the cost of the action changes, which drops the
+	// later actions of the sender if the balance no longer covers them
+	if err := ap.resetAccount(sender, ErrReplacementCost); err != nil {
+		return errors.Wrapf(err, "failed to reset account after replacing action by %x", hash)
+	}
+
+	for _, s := range ap.subscribers {
+		s.HandleDroppedAction(old, ErrReplaced)
+	}
+	ap.outbound = append(ap.outbound, act)
+	return nil
+}
+
+// evictionCandidate finds the action to evict from the full pool for an action of the sender with the given gas price.
+// Only the action with the highest nonce of an account can be evicted, so that the nonces of the rest are kept
+// consecutive. The queued ones, which cannot be executed yet, are evicted before the pending ones, and the one with the
+// lowest gas price is evicted first. It returns the sender of the candidate and whether it is pending.
+func (ap *actPool) evictionCandidate(sender string, gasPrice *big.Int) (string, bool, bool) {
+	var (
+		candidate       action.SealedEnvelope
+		candidateSender string
+		pending         bool
+		found           bool
+	)
+	for from, queue := range ap.accountActs {
+		// The actions of the sender itself are never evicted, since the new action may depend on them
+		if from == sender || queue.Empty() {
+			continue
+		}
+		acts := queue.AllActs()
+		tail := acts[len(acts)-1]
+		isPending := tail.Nonce() < queue.PendingNonce()
+		if tail.GasPrice().Cmp(gasPrice) >= 0 {
+			continue
+		}
+		if found {
+			if isPending && !pending {
+				continue
+			}
+			if isPending == pending && tail.GasPrice().Cmp(candidate.GasPrice()) >= 0 {
+				continue
+			}
+		}
+		candidate, candidateSender, pending, found = tail, from, isPending, true
+	}
+	return candidateSender, pending, found
+}
+
+// evict evicts the action with the highest nonce of the account found by evictionCandidate from the full pool
+func (ap *actPool) evict(from string, pending bool) error {
+	act := ap.accountActs[from].RemoveTail()
+	hash := act.Hash()
+	delete(ap.allActions, hash)
+	if pending {
+		evictionMtc.WithLabelValues("pending").Inc()
+	} else {
+		evictionMtc.WithLabelValues("queued").Inc()
+	}
+	log.L().Debug("Evicted action.",
+		log.Hex("hash", hash[:]),
+		zap.String("sender", from),
+		zap.Bool("pending", pending),
+		zap.String("gasPrice", act.GasPrice().String()))
+	// The pending balance of the account changes if the evicted action is pending
+	if err := ap.resetAccount(from, action.ErrBalance); err != nil {
+		return err
+	}
+	for _, s := range ap.subscribers {
+		s.HandleDroppedAction(act, ErrEvicted)
 	}
 	return nil
 }
 
+// resetAccount recomputes the pending balance and nonce of the account from the confirmed state, and removes the
+// actions which become unpayable for the given reason
+func (ap *actPool) resetAccount(addr string, unpayable error) error {
+	queue, ok := ap.accountActs[addr]
+	if !ok {
+		return nil
+	}
+	if queue.Empty() {
+		delete(ap.accountActs, addr)
+		return nil
+	}
+	balance, err := ap.bc.Balance(addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get balance of %s", addr)
+	}
+	confirmedNonce, err := ap.bc.Nonce(addr)
+	if err != nil {
+		return errors.Wrapf(err, "failed to get nonce of %s", addr)
+	}
+	queue.SetPendingBalance(balance)
+	queue.SetPendingNonce(confirmedNonce + 1)
+	ap.updateAccount(addr, unpayable)
+	return nil
+}
+
 // removeConfirmedActs removes processed (committed to block) actions from pool
 func (ap *actPool) removeConfirmedActs() {
 	for from, queue := range ap.accountActs {
@@ -294,7 +856,7 @@ func (ap *actPool) removeConfirmedActs() {
 		pendingNonce := confirmedNonce + 1
 		// Remove all actions that are committed to new block
 		acts := queue.FilterNonce(pendingNonce)
-		ap.removeInvalidActs(acts)
+		ap.removeInvalidActs(acts, ErrConfirmed)
 
 		// Delete the queue entry if it becomes empty
 		if queue.Empty() {
@@ -303,21 +865,24 @@ func (ap *actPool) removeConfirmedActs() {
 	}
 }
 
-func (ap *actPool) removeInvalidActs(acts []action.SealedEnvelope) {
+func (ap *actPool) removeInvalidActs(acts []action.SealedEnvelope, reason error) {
 	for _, act := range acts {
 		hash := act.Hash()
-		log.L().Debug("Removed invalidated action.", log.Hex("hash", hash[:]))
+		log.L().Debug("Removed invalidated action.", log.Hex("hash", hash[:]), zap.Error(reason))
 		delete(ap.allActions, hash)
+		for _, s := range ap.subscribers {
+			s.HandleDroppedAction(act, reason)
+		}
 	}
 }
 
-// updateAccount updates queue's status and remove invalidated actions from pool if necessary
-func (ap *actPool) updateAccount(sender string) {
+// updateAccount updates queue's status and remove invalidated actions from pool if necessary, for the given reason if
+// they become unpayable
+func (ap *actPool) updateAccount(sender string, unpayable error) {
 	queue := ap.accountActs[sender]
-	acts := queue.UpdateQueue(queue.PendingNonce())
-	if len(acts) > 0 {
-		ap.removeInvalidActs(acts)
-	}
+	ap.removeInvalidActs(queue.CleanTimeout(), ErrTimedOut)
+	// The actions following an unpayable one are removed
+	ap.removeInvalidActs(queue.UpdateQueue(queue.PendingNonce()), unpayable)
 	// Delete the queue entry if it becomes empty
 	if queue.Empty() {
 		delete(ap.accountActs, sender)
@@ -330,7 +895,14 @@ func (ap *actPool) reset() {
 
 	// Remove confirmed actions in actpool
 	ap.removeConfirmedActs()
+	nextHeight := ap.bc.TipHeight() + 1
+	expiryEnabled := len(ap.accountActs) != 0 && nextHeight >= ap.bc.Genesis().ActionExpiryHeight
 	for from, queue := range ap.accountActs {
+		// Remove the actions which can no longer be included in a block
+		if expiryEnabled {
+			ap.removeInvalidActs(queue.FilterExpired(nextHeight), action.ErrExpired)
+		}
+
 		// Reset pending balance for each account
 		balance, err := ap.bc.Balance(from)
 		if err != nil {
@@ -347,6 +919,99 @@ func (ap *actPool) reset() {
 		}
 		pendingNonce := confirmedNonce + 1
 		queue.SetPendingNonce(pendingNonce)
-		ap.updateAccount(from)
+		ap.updateAccount(from, action.ErrBalance)
+	}
+	ap.senderLimiter.prune()
+	ap.peerLimiter.prune()
+	if ap.journal != nil && time.Since(ap.lastCompaction) >= ap.cfg.JournalCompactInterval {
+		ap.compactJournal()
+	}
+	ap.rebroadcastLocalActs()
+}
+
+// unlockAndBroadcast unlocks the pool and then broadcasts the actions collected while it was locked, so that the
+// broadcast handler neither blocks the pool nor deadlocks by calling back into it
+func (ap *actPool) unlockAndBroadcast() {
+	acts := ap.outbound
+	ap.outbound = nil
+	ap.mutex.Unlock()
+
+	if ap.broadcastHandler == nil {
+		return
+	}
+	chainID := ap.bc.ChainID()
+	for _, act := range acts {
+		if err := ap.broadcastHandler(context.Background(), chainID, act.Proto()); err != nil {
+			hash := act.Hash()
+			log.L().Warn("Failed to broadcast action.", log.Hex("hash", hash[:]), zap.Error(err))
+		}
+	}
+}
+
+// rebroadcastLocalActs collects to broadcast again the pending actions submitted to this node, which are not included after the
+// interval, and backs off the interval of each
+func (ap *actPool) rebroadcastLocalActs() {
+	tipHeight := ap.bc.TipHeight()
+	for hash, local := range ap.localActs {
+		// Stop tracking the actions which are included, replaced or dropped
+		if _, ok := ap.allActions[hash]; !ok {
+			delete(ap.localActs, hash)
+			continue
+		}
+		if local.interval == 0 || tipHeight < local.rebroadcastHeight {
+			continue
+		}
+		caller, err := address.FromBytes(local.act.SrcPubkey().Hash())
+		if err != nil {
+			continue
+		}
+		// The queued actions cannot be included anyway until the gap of nonce is filled
+		if queue, ok := ap.accountActs[caller.String()]; !ok || local.act.Nonce() >= queue.PendingNonce() {
+			continue
+		}
+		ap.outbound = append(ap.outbound, local.act)
+		log.L().Debug("Broadcast the local action again.",
+			log.Hex("hash", hash[:]),
+			zap.Uint64("height", tipHeight),
+			zap.Uint64("interval", local.interval))
+		local.interval *= 2
+		if ap.cfg.MaxLocalRebroadcastInterval != 0 && local.interval > ap.cfg.MaxLocalRebroadcastInterval {
+			local.interval = ap.cfg.MaxLocalRebroadcastInterval
+		}
+		local.rebroadcastHeight = tipHeight + local.interval
+	}
+}
+
+// replayJournal adds the journaled actions back to pool, dropping the ones which have expired or become invalid
+func (ap *actPool) replayJournal() {
+	var numReplayed int
+	for _, record := range ap.journaled {
+		hash := record.act.Hash()
+		if ap.cfg.ActionExpiry != 0 && time.Since(record.acceptedAt) >= ap.cfg.ActionExpiry {
+			log.L().Debug("Dropped expired journaled action.", log.Hex("hash", hash[:]))
+			continue
+		}
+		if err := ap.add(record.act); err != nil {
+			log.L().Debug("Dropped invalid journaled action.", log.Hex("hash", hash[:]), zap.Error(err))
+			continue
+		}
+		numReplayed++
+	}
+	log.L().Info("Replayed actpool journal.",
+		zap.Int("numJournaled", len(ap.journaled)),
+		zap.Int("numReplayed", numReplayed))
+	ap.journaled = nil
+	ap.compactJournal()
+}
+
+// compactJournal rewrites the journal to the actions remaining in pool, which drops the confirmed ones
+func (ap *actPool) compactJournal() {
+	acts := make([]action.SealedEnvelope, 0, len(ap.allActions))
+	for _, queue := range ap.accountActs {
+		acts = append(acts, queue.AllActs()...)
+	}
+	if err := ap.journal.compact(acts); err != nil {
+		log.L().Error("Failed to compact actpool journal.", zap.Error(err))
 	}
+	ap.lastCompaction = time.Now()
 }
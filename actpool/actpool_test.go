@@ -8,12 +8,20 @@ package actpool
 
 import (
 	"context"
+	"io/ioutil"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/facebookgo/clock"
 	"github.com/golang/mock/gomock"
+	"github.com/golang/protobuf/proto"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 
@@ -25,6 +33,8 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
 	"github.com/iotexproject/iotex-core/test/testaddress"
 	"github.com/iotexproject/iotex-core/testutil"
@@ -223,7 +233,7 @@ func TestActPool_AddActs(t *testing.T) {
 	replaceTsf, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
 	err = ap.Add(replaceTsf)
-	require.Equal(action.ErrNonce, errors.Cause(err))
+	require.Equal(action.ErrGasPrice, errors.Cause(err))
 	replaceVote, err := action.NewVote(4, "", uint64(100000), big.NewInt(0))
 	require.NoError(err)
 
@@ -236,7 +246,7 @@ func TestActPool_AddActs(t *testing.T) {
 	require.NoError(err)
 
 	err = ap.Add(selp)
-	require.Equal(action.ErrNonce, errors.Cause(err))
+	require.Equal(action.ErrGasPrice, errors.Cause(err))
 	// Case IV: Nonce is too large
 	outOfBoundsTsf, err := testutil.SignedTransfer(addr1, priKey1, ap.cfg.MaxNumActsPerAcct+1, big.NewInt(1), []byte{}, uint64(100000), big.NewInt(0))
 	require.NoError(err)
@@ -292,6 +302,326 @@ func TestActPool_AddActs(t *testing.T) {
 	require.Equal(action.ErrInsufficientBalanceForGas, errors.Cause(err))
 }
 
+func TestActPool_ReplaceAction(t *testing.T) {
+	require := require.New(t)
+	bc := blockchain.NewBlockchain(
+		config.Default,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+	)
+	require.NoError(bc.Start(context.Background()))
+	_, err := bc.CreateState(addr1, big.NewInt(1000000))
+	require.NoError(err)
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 2
+	apConfig.MinGasPriceBumpPercent = 10
+	var (
+		ap          *actPool
+		broadcasted []proto.Message
+	)
+	// the handler is called with the pool unlocked, so it can call back into the pool
+	Ap, err := NewActPool(bc, apConfig, WithBroadcastOutbound(
+		func(_ context.Context, _ uint32, msg proto.Message) error {
+			require.NotZero(ap.GetSize())
+			broadcasted = append(broadcasted, msg)
+			return nil
+		},
+	))
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	sub := &testSubscriber{}
+	require.NoError(ap.AddSubscriber(sub))
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(20), []byte{}, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	pBalance, _ := ap.getPendingBalance(addr1)
+	require.Equal(uint64(799970), pBalance.Uint64())
+
+	// the gas price is not bumped enough
+	underpriced, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(1), []byte{}, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(action.ErrGasPrice, errors.Cause(ap.Add(underpriced)))
+	require.Equal(0, len(sub.dropped))
+
+	// replace the action although the pool is full
+	replacement, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(11))
+	require.NoError(err)
+	require.NoError(ap.Add(replacement))
+	require.Equal(uint64(2), ap.GetSize())
+	_, err = ap.GetActionByHash(tsf1.Hash())
+	require.Error(err)
+	act, err := ap.GetActionByHash(replacement.Hash())
+	require.NoError(err)
+	require.Equal(replacement, act)
+	pBalance, _ = ap.getPendingBalance(addr1)
+	require.Equal(uint64(789970), pBalance.Uint64())
+	pNonce, _ := ap.getPendingNonce(addr1)
+	require.Equal(uint64(3), pNonce)
+	require.Equal([]action.SealedEnvelope{tsf1}, sub.dropped)
+	require.Equal([]error{ErrReplaced}, sub.reasons)
+	require.Equal([]proto.Message{replacement.Proto()}, broadcasted)
+
+	// the balance is insufficient for the replacement
+	overBalTsf, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(0), []byte{}, uint64(100000), big.NewInt(100))
+	require.NoError(err)
+	require.Equal(action.ErrBalance, errors.Cause(ap.Add(overBalTsf)))
+
+	// no space for a new action
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, uint64(3), big.NewInt(30), []byte{}, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(tsf3)))
+
+	// the later action unpayable after a costlier replacement is dropped for the replacement, which happens when the
+	// pending balance is out of date with the confirmed one
+	ap.accountActs[addr1].SetPendingBalance(big.NewInt(1000000))
+	costlier, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(800000), []byte{}, uint64(100000), big.NewInt(13))
+	require.NoError(err)
+	require.NoError(ap.Add(costlier))
+	require.Equal(uint64(1), ap.GetSize())
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2, replacement}, sub.dropped)
+	require.Equal([]error{ErrReplaced, ErrReplacementCost, ErrReplaced}, sub.reasons)
+
+	require.NoError(ap.RemoveSubscriber(sub))
+	require.Error(ap.RemoveSubscriber(sub))
+}
+
+func TestActPool_Evict(t *testing.T) {
+	require := require.New(t)
+	bc := blockchain.NewBlockchain(
+		config.Default,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+	)
+	require.NoError(bc.Start(context.Background()))
+	_, err := bc.CreateState(addr1, big.NewInt(1000000))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2, big.NewInt(1000000))
+	require.NoError(err)
+	_, err = bc.CreateState(addr3, big.NewInt(1000000))
+	require.NoError(err)
+	apConfig := getActPoolCfg()
+	apConfig.MaxNumActsPerPool = 3
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	sub := &testSubscriber{}
+	require.NoError(ap.AddSubscriber(sub))
+
+	pending, err := testutil.SignedTransfer(addr4, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(10))
+	require.NoError(err)
+	queued, err := testutil.SignedTransfer(addr4, priKey1, uint64(3), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(5))
+	require.NoError(err)
+	tsf, err := testutil.SignedTransfer(addr4, priKey2, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(20))
+	require.NoError(err)
+	require.NoError(ap.Add(pending))
+	require.NoError(ap.Add(queued))
+	require.NoError(ap.Add(tsf))
+
+	// no action has a lower gas price
+	underpriced, err := testutil.SignedTransfer(addr4, priKey3, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(5))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(underpriced)))
+	require.Equal(0, len(sub.dropped))
+
+	// an action queued because of a nonce gap evicts nothing
+	gapped, err := testutil.SignedTransfer(addr4, priKey3, uint64(3), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(50))
+	require.NoError(err)
+	require.Equal(action.ErrActPool, errors.Cause(ap.Add(gapped)))
+	require.Equal(uint64(3), ap.GetSize())
+	require.Equal(0, len(sub.dropped))
+
+	// the queued action is evicted first
+	tsf1, err := testutil.SignedTransfer(addr4, priKey3, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(6))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.Equal(uint64(3), ap.GetSize())
+	_, err = ap.GetActionByHash(queued.Hash())
+	require.Error(err)
+	require.Equal([]action.SealedEnvelope{queued}, sub.dropped)
+	require.Equal([]error{ErrEvicted}, sub.reasons)
+
+	// the pending action with the lowest gas price is evicted next, and the actions of the sender itself are kept
+	tsf2, err := testutil.SignedTransfer(addr4, priKey3, uint64(2), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(30))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf2))
+	require.Equal(uint64(3), ap.GetSize())
+	_, err = ap.GetActionByHash(pending.Hash())
+	require.Error(err)
+	_, ok = ap.accountActs[addr1]
+	require.False(ok)
+	pNonce, err := ap.GetPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(1), pNonce)
+	require.Equal([]action.SealedEnvelope{queued, pending}, sub.dropped)
+	require.Equal([]error{ErrEvicted, ErrEvicted}, sub.reasons)
+	pNonce, err = ap.GetPendingNonce(addr3)
+	require.NoError(err)
+	require.Equal(uint64(3), pNonce)
+}
+
+func TestActPool_Journal(t *testing.T) {
+	require := require.New(t)
+	bc := blockchain.NewBlockchain(
+		config.Default,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+	)
+	bc.GetFactory().AddActionHandlers(account.NewProtocol())
+	require.NoError(bc.Start(context.Background()))
+	_, err := bc.CreateState(addr1, big.NewInt(100))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2, big.NewInt(200))
+	require.NoError(err)
+	dir, err := ioutil.TempDir(os.TempDir(), "actpool")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+
+	apConfig := getActPoolCfg()
+	apConfig.ActionExpiry = time.Hour
+	apConfig.JournalPath = filepath.Join(dir, "actpool.journal")
+	newActPool := func() *actPool {
+		Ap, err := NewActPool(bc, apConfig)
+		require.NoError(err)
+		ap, ok := Ap.(*actPool)
+		require.True(ok)
+		ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+		ap.AddActionValidators(account.NewProtocol())
+		return ap
+	}
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(20), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey2, uint64(1), big.NewInt(30), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr1, priKey2, uint64(2), big.NewInt(40), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	ap1 := newActPool()
+	require.NoError(ap1.Add(tsf1))
+	require.NoError(ap1.Add(tsf2))
+	require.NoError(ap1.Add(tsf3))
+	require.Error(ap1.Add(tsf3))
+	// an action accepted long ago
+	require.NoError(ap1.journal.insert(tsf4, time.Now().Add(-2*time.Hour)))
+
+	// tsf1 is confirmed while the node is down
+	sf := bc.GetFactory()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	ctx := protocol.WithRunActionsCtx(context.Background(),
+		protocol.RunActionsCtx{
+			Producer: testaddress.Addrinfo["producer"],
+			GasLimit: uint64(1000000),
+		})
+	_, err = ws.RunActions(ctx, 0, []action.SealedEnvelope{tsf1})
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+
+	// the journal is replayed when the pool starts
+	ap2 := newActPool()
+	require.Equal(uint64(0), ap2.GetSize())
+	require.NoError(ap2.Start(context.Background()))
+	require.Equal(uint64(2), ap2.GetSize())
+	_, err = ap2.GetActionByHash(tsf2.Hash())
+	require.NoError(err)
+	_, err = ap2.GetActionByHash(tsf3.Hash())
+	require.NoError(err)
+	pNonce, err := ap2.GetPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(3), pNonce)
+
+	// the journal is compacted to the actions remaining in pool
+	records, err := newJournal(apConfig.JournalPath).load()
+	require.NoError(err)
+	hashes := make([]hash.Hash256, 0, len(records))
+	for _, record := range records {
+		hashes = append(hashes, record.act.Hash())
+	}
+	require.ElementsMatch([]hash.Hash256{tsf2.Hash(), tsf3.Hash()}, hashes)
+
+	// the journal is closed when the pool stops
+	require.NoError(ap2.Stop(context.Background()))
+	require.Nil(ap2.journal.file)
+}
+
+func TestActPool_AddLocal(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var (
+		height uint64
+		nonce1 uint64
+	)
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().DoAndReturn(func() uint64 { return height }).AnyTimes()
+	bc.EXPECT().Genesis().Return(genesis.Default).AnyTimes()
+	bc.EXPECT().Nonce(gomock.Any()).DoAndReturn(func(addr string) (uint64, error) {
+		if addr == addr1 {
+			return nonce1, nil
+		}
+		return 0, nil
+	}).AnyTimes()
+	bc.EXPECT().Balance(gomock.Any()).Return(big.NewInt(1000000), nil).AnyTimes()
+	bc.EXPECT().ChainID().Return(uint32(1)).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.ActionExpiry = time.Minute
+	apConfig.LocalActionExpiry = time.Hour
+	apConfig.LocalRebroadcastInterval = 2
+	apConfig.MaxLocalRebroadcastInterval = 4
+	var broadcasted []proto.Message
+	Ap, err := NewActPool(bc, apConfig, WithBroadcastOutbound(
+		func(_ context.Context, _ uint32, msg proto.Message) error {
+			broadcasted = append(broadcasted, msg)
+			return nil
+		},
+	))
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr1, priKey2, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, uint64(3), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(ap.AddLocal(tsf1))
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.AddLocal(tsf3))
+
+	// the local actions are kept longer than the gossiped ones
+	deadlines := make(map[uint64]time.Time)
+	for _, item := range ap.accountActs[addr1].(*actQueue).index {
+		deadlines[item.nonce] = item.deadline
+	}
+	require.True(time.Until(deadlines[1]) > 30*time.Minute)
+	require.True(time.Until(deadlines[3]) > 30*time.Minute)
+	require.True(time.Until(ap.accountActs[addr2].(*actQueue).index[0].deadline) < 2*time.Minute)
+
+	// only the pending local action is broadcast again, with the interval backed off
+	for _, h := range []uint64{1, 2, 3, 5, 6, 9, 10} {
+		height = h
+		ap.Reset()
+	}
+	require.Equal([]proto.Message{tsf1.Proto(), tsf1.Proto(), tsf1.Proto()}, broadcasted)
+
+	// the action is not tracked once it is included
+	nonce1 = 1
+	ap.Reset()
+	_, ok = ap.localActs[tsf1.Hash()]
+	require.False(ok)
+	_, ok = ap.localActs[tsf3.Hash()]
+	require.True(ok)
+}
+
 func TestActPool_PickActs(t *testing.T) {
 	createActPool := func(cfg config.ActPool) (*actPool, []action.SealedEnvelope, []action.SealedEnvelope, []action.SealedEnvelope) {
 		require := require.New(t)
@@ -858,7 +1188,7 @@ func TestActPool_removeInvalidActs(t *testing.T) {
 	acts := []action.SealedEnvelope{tsf1, vote4}
 	require.NotNil(ap.allActions[hash1])
 	require.NotNil(ap.allActions[hash2])
-	ap.removeInvalidActs(acts)
+	ap.removeInvalidActs(acts, action.ErrBalance)
 	require.Equal(action.SealedEnvelope{}, ap.allActions[hash1])
 	require.Equal(action.SealedEnvelope{}, ap.allActions[hash2])
 }
@@ -1063,6 +1393,361 @@ func (ap *actPool) getPendingNonce(addr string) (uint64, error) {
 	return pendingNonce, err
 }
 
+func TestActPool_Subscriber(t *testing.T) {
+	require := require.New(t)
+	bc := blockchain.NewBlockchain(
+		config.Default,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+	)
+	bc.GetFactory().AddActionHandlers(account.NewProtocol())
+	require.NoError(bc.Start(context.Background()))
+	_, err := bc.CreateState(addr1, big.NewInt(100))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2, big.NewInt(100))
+	require.NoError(err)
+	apConfig := getActPoolCfg()
+	apConfig.ActionExpiry = time.Hour
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	sub := &testSubscriber{}
+	require.NoError(ap.AddSubscriber(sub))
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey2, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf1))
+	require.NoError(ap.Add(tsf2))
+	require.NoError(ap.AddLocal(tsf3))
+	require.Error(ap.Add(tsf3))
+	require.Equal([]action.SealedEnvelope{tsf1, tsf2, tsf3}, sub.accepted)
+	require.Equal(0, len(sub.dropped))
+
+	// tsf1 is confirmed, and tsf3 expires
+	sf := bc.GetFactory()
+	ws, err := sf.NewWorkingSet()
+	require.NoError(err)
+	ctx := protocol.WithRunActionsCtx(context.Background(),
+		protocol.RunActionsCtx{
+			Producer: testaddress.Addrinfo["producer"],
+			GasLimit: uint64(1000000),
+		})
+	_, err = ws.RunActions(ctx, 0, []action.SealedEnvelope{tsf1})
+	require.NoError(err)
+	require.NoError(sf.Commit(ws))
+	ap.accountActs[addr2].SetTimeOut(1, -time.Minute)
+	ap.Reset()
+	require.Equal([]action.SealedEnvelope{tsf1, tsf3}, sub.dropped)
+	require.Equal([]error{ErrConfirmed, ErrTimedOut}, sub.reasons)
+	require.Equal(uint64(1), ap.GetSize())
+}
+
+func TestActPool_GetContent(t *testing.T) {
+	require := require.New(t)
+	bc := blockchain.NewBlockchain(
+		config.Default,
+		blockchain.InMemStateFactoryOption(),
+		blockchain.InMemDaoOption(),
+	)
+	require.NoError(bc.Start(context.Background()))
+	_, err := bc.CreateState(addr1, big.NewInt(100))
+	require.NoError(err)
+	_, err = bc.CreateState(addr2, big.NewInt(10))
+	require.NoError(err)
+	apConfig := getActPoolCfg()
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, uint64(1), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, uint64(4), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf7, err := testutil.SignedTransfer(addr2, priKey1, uint64(7), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf5, err := testutil.SignedTransfer(addr1, priKey2, uint64(3), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	for _, tsf := range []action.SealedEnvelope{tsf1, tsf2, tsf4, tsf7, tsf5} {
+		require.NoError(ap.Add(tsf))
+	}
+
+	require.Equal(Status{
+		NumPending:  2,
+		NumQueued:   3,
+		Capacity:    apConfig.MaxNumActsPerPool,
+		NumAccounts: 2,
+	}, ap.GetStatus())
+
+	content := ap.GetContent()
+	require.Equal(2, len(content))
+	require.Equal(AccountContent{
+		PendingNonce: 3,
+		Pending:      []action.SealedEnvelope{tsf1, tsf2},
+		Queued:       []action.SealedEnvelope{tsf4, tsf7},
+		Gaps:         []NonceGap{{Start: 3, End: 3}, {Start: 5, End: 6}},
+	}, content[addr1])
+	require.Equal(AccountContent{
+		PendingNonce: 1,
+		Pending:      []action.SealedEnvelope{},
+		Queued:       []action.SealedEnvelope{tsf5},
+		Gaps:         []NonceGap{{Start: 1, End: 2}},
+	}, content[addr2])
+}
+
+func TestActPool_AddBundle(t *testing.T) {
+	require := require.New(t)
+	newPool := func(bundleHeight uint64) *actPool {
+		cfg := config.Default
+		cfg.Genesis.BundleHeight = bundleHeight
+		bc := blockchain.NewBlockchain(
+			cfg,
+			blockchain.InMemStateFactoryOption(),
+			blockchain.InMemDaoOption(),
+		)
+		require.NoError(bc.Start(context.Background()))
+		_, err := bc.CreateState(addr1, big.NewInt(100))
+		require.NoError(err)
+		Ap, err := NewActPool(bc, getActPoolCfg())
+		require.NoError(err)
+		ap, ok := Ap.(*actPool)
+		require.True(ok)
+		ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+		ap.AddActionValidators(account.NewProtocol())
+		return ap
+	}
+
+	bundle := func(amounts ...int64) action.SealedEnvelope {
+		elps := make([]action.Envelope, 0, len(amounts))
+		for i, amount := range amounts {
+			tsf, err := testutil.SignedTransfer(addr2, priKey1, uint64(i+1), big.NewInt(amount), []byte{}, uint64(100000), big.NewInt(0))
+			require.NoError(err)
+			elps = append(elps, tsf.Envelope)
+		}
+		selp, err := testutil.SignedBundle(priKey1, elps)
+		require.NoError(err)
+		return selp
+	}
+
+	// a bundle is rejected before it is enabled
+	ap := newPool(2)
+	require.Equal(action.ErrBundle, errors.Cause(ap.Add(bundle(10, 20, 30))))
+
+	// a bundle is rejected if any action in it is invalid
+	ap = newPool(1)
+	sub := &testSubscriber{}
+	require.NoError(ap.AddSubscriber(sub))
+	err := ap.Add(bundle(10, -20, 30))
+	require.Equal(action.ErrBalance, errors.Cause(err))
+
+	// the bundle takes nonces 1 to 3 as a whole
+	bundle1 := bundle(10, 20, 30)
+	require.NoError(ap.Add(bundle1))
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, uint64(2), big.NewInt(10), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.Equal(action.ErrNonce, errors.Cause(ap.Add(tsf2)))
+	tsf4, err := testutil.SignedTransfer(addr2, priKey1, uint64(4), big.NewInt(40), []byte{}, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(ap.Add(tsf4))
+
+	pendingNonce, err := ap.getPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(5), pendingNonce)
+	pendingBalance, err := ap.getPendingBalance(addr1)
+	require.NoError(err)
+	require.Equal(0, pendingBalance.Sign())
+	require.Equal([]action.SealedEnvelope{bundle1, tsf4}, ap.PendingActionMap()[addr1])
+	require.Equal(AccountContent{
+		PendingNonce: 5,
+		Pending:      []action.SealedEnvelope{bundle1, tsf4},
+		Queued:       []action.SealedEnvelope{},
+		Gaps:         []NonceGap{},
+	}, ap.GetContent()[addr1])
+
+	// the bundle reverted in minting is dropped along with the later actions of the sender
+	ap.DropAction(bundle1, action.ErrBundleReverted)
+	require.Equal(uint64(0), ap.GetSize())
+	require.Equal([]action.SealedEnvelope{tsf4, bundle1}, sub.dropped)
+	require.Equal([]error{action.ErrBundleReverted, action.ErrBundleReverted}, sub.reasons)
+	pendingNonce, err = ap.GetPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(1), pendingNonce)
+}
+
+func TestActPool_ValidUntilHeight(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tipHeight := uint64(10)
+	g := genesis.Default
+	g.ActionExpiryHeight = 0
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().DoAndReturn(func() uint64 { return tipHeight }).AnyTimes()
+	bc.EXPECT().Genesis().DoAndReturn(func() genesis.Genesis { return g }).AnyTimes()
+	bc.EXPECT().Nonce(gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	bc.EXPECT().Balance(gomock.Any()).Return(big.NewInt(100000000), nil).AnyTimes()
+	Ap, err := NewActPool(bc, getActPoolCfg())
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	sub := &testSubscriber{}
+	require.NoError(ap.AddSubscriber(sub))
+
+	tsf := func(nonce, validUntilHeight uint64) action.SealedEnvelope {
+		transfer, err := action.NewTransfer(nonce, big.NewInt(10), addr2, nil, uint64(100000), big.NewInt(0))
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(nonce).SetGasLimit(100000).SetAction(transfer).SetValidUntilHeight(validUntilHeight).Build()
+		selp, err := action.Sign(elp, priKey1)
+		require.NoError(err)
+		return selp
+	}
+
+	// the action cannot be included in the next block
+	err = ap.Add(tsf(1, 10))
+	require.Equal(action.ErrExpired, errors.Cause(err))
+	tsf1 := tsf(1, 11)
+	tsf2 := tsf(2, 12)
+	tsf3 := tsf(3, 0)
+	for _, act := range []action.SealedEnvelope{tsf1, tsf2, tsf3} {
+		require.NoError(ap.Add(act))
+	}
+	pendingNonce, err := ap.getPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(4), pendingNonce)
+
+	// the expired action is dropped once the chain passes the height, and the following ones are no longer pending
+	tipHeight = 11
+	ap.Reset()
+	require.Equal([]action.SealedEnvelope{tsf1}, sub.dropped)
+	require.Equal(action.ErrExpired, sub.reasons[0])
+	require.Equal([]action.SealedEnvelope{tsf2, tsf3}, ap.GetUnconfirmedActs(addr1))
+	pendingNonce, err = ap.getPendingNonce(addr1)
+	require.NoError(err)
+	require.Equal(uint64(1), pendingNonce)
+
+	// the actions do not expire below the activation height
+	tipHeight = 12
+	g.ActionExpiryHeight = 14
+	ap.Reset()
+	require.Equal([]action.SealedEnvelope{tsf2, tsf3}, ap.GetUnconfirmedActs(addr1))
+	tsf4 := tsf(1, 10)
+	require.NoError(ap.Add(tsf4))
+	g.ActionExpiryHeight = 13
+	ap.Reset()
+	require.Equal([]action.SealedEnvelope{tsf1, tsf4, tsf2}, sub.dropped)
+	require.Equal([]action.SealedEnvelope{tsf3}, ap.GetUnconfirmedActs(addr1))
+}
+
+func TestActPool_EthTransaction(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	g := genesis.Default
+	g.Web3Height = 12
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().Return(uint64(10)).AnyTimes()
+	bc.EXPECT().Genesis().DoAndReturn(func() genesis.Genesis { return g }).AnyTimes()
+	bc.EXPECT().ChainID().Return(uint32(1)).AnyTimes()
+	bc.EXPECT().Nonce(gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	bc.EXPECT().Balance(gomock.Any()).Return(big.NewInt(100000000), nil).AnyTimes()
+	Ap, err := NewActPool(bc, getActPoolCfg())
+	require.NoError(err)
+	Ap.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+
+	ethTsf := func(nonce uint64, chainID int64) action.SealedEnvelope {
+		tx, err := types.SignTx(
+			types.NewTransaction(nonce, common.BytesToAddress(testaddress.Addrinfo["bravo"].Bytes()), big.NewInt(10), 100000, big.NewInt(0), nil),
+			types.NewEIP155Signer(big.NewInt(chainID)),
+			priKey1.EcdsaPrivateKey(),
+		)
+		require.NoError(err)
+		raw, err := rlp.EncodeToBytes(tx)
+		require.NoError(err)
+		selp, err := action.DecodeEthTransaction(raw, uint32(chainID))
+		require.NoError(err)
+		return selp
+	}
+
+	// the action signed as an ethereum transaction is rejected before the next block enables it
+	err = Ap.Add(ethTsf(1, 1))
+	require.Equal(action.ErrEthTransaction, errors.Cause(err))
+	g.Web3Height = 11
+	require.NoError(Ap.Add(ethTsf(1, 1)))
+	// the action signed for another chain is rejected
+	err = Ap.Add(ethTsf(2, 2))
+	require.Equal(action.ErrEthTransaction, errors.Cause(err))
+}
+
+func TestActPool_AdmissionLimits(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bc := mock_blockchain.NewMockBlockchain(ctrl)
+	bc.EXPECT().TipHeight().Return(uint64(0)).AnyTimes()
+	bc.EXPECT().Genesis().Return(genesis.Default).AnyTimes()
+	bc.EXPECT().Nonce(gomock.Any()).Return(uint64(0), nil).AnyTimes()
+	bc.EXPECT().Balance(gomock.Any()).Return(big.NewInt(100000000), nil).AnyTimes()
+	apConfig := getActPoolCfg()
+	apConfig.MinGasPrice = "10"
+	apConfig.MaxActsPerSenderPerSecond = 2
+	apConfig.MaxGossipActsPerPeerPerSecond = 3
+	Ap, err := NewActPool(bc, apConfig)
+	require.NoError(err)
+	ap, ok := Ap.(*actPool)
+	require.True(ok)
+	c := clock.NewMock()
+	ap.senderLimiter.clock = c
+	ap.peerLimiter.clock = c
+
+	tsf := func(priKey keypair.PrivateKey, nonce uint64, gasPrice int64) action.SealedEnvelope {
+		selp, err := testutil.SignedTransfer(addr2, priKey, nonce, big.NewInt(10), []byte{}, uint64(100000), big.NewInt(gasPrice))
+		require.NoError(err)
+		return selp
+	}
+
+	// the gas price is lower than the minimum
+	err = ap.Add(tsf(priKey1, 1, 9))
+	require.Equal(ErrGasPriceTooLow, errors.Cause(err))
+	require.NoError(ap.Add(tsf(priKey1, 1, 10)))
+
+	// the sender has accepted more actions than the limit, while the rejected ones are not counted
+	require.Error(ap.Add(tsf(priKey1, 1, 10)))
+	require.NoError(ap.AddLocal(tsf(priKey1, 2, 10)))
+	err = ap.Add(tsf(priKey1, 3, 10))
+	require.Equal(ErrSenderRateLimited, errors.Cause(err))
+	require.NoError(ap.Add(tsf(priKey2, 1, 10)))
+	c.Add(500 * time.Millisecond)
+	require.NoError(ap.Add(tsf(priKey1, 3, 10)))
+	err = ap.Add(tsf(priKey1, 4, 10))
+	require.Equal(ErrSenderRateLimited, errors.Cause(err))
+
+	// the peer has gossiped more actions than the limit, including the rejected ones
+	require.NoError(ap.AddFromPeer(tsf(priKey3, 1, 10), "peer1"))
+	require.Error(ap.AddFromPeer(tsf(priKey3, 1, 10), "peer1"))
+	require.NoError(ap.AddFromPeer(tsf(priKey3, 2, 10), "peer1"))
+	err = ap.AddFromPeer(tsf(priKey4, 1, 10), "peer1")
+	require.Equal(ErrPeerRateLimited, errors.Cause(err))
+	require.NoError(ap.AddFromPeer(tsf(priKey4, 1, 10), "peer2"))
+
+	// the buckets which are full again are pruned
+	c.Add(time.Second)
+	ap.Reset()
+	require.Equal(0, len(ap.senderLimiter.buckets))
+	require.Equal(0, len(ap.peerLimiter.buckets))
+	require.NoError(ap.AddFromPeer(tsf(priKey3, 3, 10), "peer1"))
+}
+
 // Helper function to return the correct pending balance just in case of empty queue
 func (ap *actPool) getPendingBalance(addr string) (*big.Int, error) {
 	if queue, ok := ap.accountActs[addr]; ok {
@@ -1071,6 +1756,21 @@ func (ap *actPool) getPendingBalance(addr string) (*big.Int, error) {
 	return ap.bc.Balance(addr)
 }
 
+type testSubscriber struct {
+	accepted []action.SealedEnvelope
+	dropped  []action.SealedEnvelope
+	reasons  []error
+}
+
+func (s *testSubscriber) HandleAcceptedAction(act action.SealedEnvelope) {
+	s.accepted = append(s.accepted, act)
+}
+
+func (s *testSubscriber) HandleDroppedAction(act action.SealedEnvelope, reason error) {
+	s.dropped = append(s.dropped, act)
+	s.reasons = append(s.reasons, reason)
+}
+
 func getActPoolCfg() config.ActPool {
 	return config.ActPool{
 		MaxNumActsPerPool: maxNumActsPerPool,
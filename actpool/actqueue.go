@@ -52,8 +52,11 @@ func (h *noncePriorityQueue) Pop() interface{} {
 type ActQueue interface {
 	Overlaps(action.SealedEnvelope) bool
 	Put(action.SealedEnvelope) error
+	Replace(action.SealedEnvelope) (action.SealedEnvelope, error)
 	FilterNonce(uint64) []action.SealedEnvelope
 	UpdateQueue(uint64) []action.SealedEnvelope
+	CleanTimeout() []action.SealedEnvelope
+	FilterExpired(uint64) []action.SealedEnvelope
 	SetPendingNonce(uint64)
 	PendingNonce() uint64
 	SetPendingBalance(*big.Int)
@@ -62,6 +65,8 @@ type ActQueue interface {
 	Empty() bool
 	PendingActs() []action.SealedEnvelope
 	AllActs() []action.SealedEnvelope
+	RemoveTail() action.SealedEnvelope
+	SetTimeOut(nonce uint64, ttl time.Duration)
 }
 
 // actQueue is a queue of actions from an account
@@ -72,6 +77,8 @@ type actQueue struct {
 	items map[uint64]action.SealedEnvelope
 	// Priority Queue that stores all the nonces belonging to an account. Nonces are used as indices for action map
 	index noncePriorityQueue
+	// Map from the nonces taken by the bundles in queue, except the first ones, to the first ones
+	covered map[uint64]uint64
 	// Current pending nonce tracking previous actions that can be committed to the next block for the account
 	pendingNonce uint64
 	// Current pending balance for the account
@@ -92,6 +99,7 @@ func NewActQueue(ap *actPool, address string, ops ...ActQueueOption) ActQueue {
 		address:        address,
 		items:          make(map[uint64]action.SealedEnvelope),
 		index:          noncePriorityQueue{},
+		covered:        make(map[uint64]uint64),
 		pendingNonce:   uint64(1), // Taking coinbase Action into account, pendingNonce should start with 1
 		pendingBalance: big.NewInt(0),
 		clock:          clock.New(),
@@ -103,66 +111,132 @@ func NewActQueue(ap *actPool, address string, ops ...ActQueueOption) ActQueue {
 	return aq
 }
 
-// Overlap returns whether the current queue contains the given nonce
+// Overlap returns whether the current queue contains any nonce taken by the given action
 func (q *actQueue) Overlaps(act action.SealedEnvelope) bool {
-	_, exist := q.items[act.Nonce()]
-	return exist
+	for nonce := act.Nonce(); ; nonce++ {
+		if _, exist := q.items[nonce]; exist {
+			return true
+		}
+		if _, exist := q.covered[nonce]; exist {
+			return true
+		}
+		if nonce >= act.LastNonce() {
+			return false
+		}
+	}
 }
 
 // Put inserts a new action into the map, also updating the queue's nonce index
 func (q *actQueue) Put(act action.SealedEnvelope) error {
 	nonce := act.Nonce()
-	if _, exist := q.items[nonce]; exist {
+	if q.Overlaps(act) {
 		return errors.Wrapf(action.ErrNonce, "duplicate nonce")
 	}
 	heap.Push(&q.index, nonceWithTTL{nonce: nonce, deadline: q.clock.Now().Add(q.ttl)})
 	q.items[nonce] = act
+	for covered := nonce; covered < act.LastNonce(); {
+		covered++
+		q.covered[covered] = nonce
+	}
 	return nil
 }
 
+// Replace replaces the action with the same nonce in the map by the given one, and returns the replaced action
+func (q *actQueue) Replace(act action.SealedEnvelope) (action.SealedEnvelope, error) {
+	nonce := act.Nonce()
+	old, exist := q.items[nonce]
+	if !exist {
+		return action.SealedEnvelope{}, errors.Wrapf(action.ErrNonce, "nonce %d does not exist", nonce)
+	}
+	if old.LastNonce() != act.LastNonce() {
+		return action.SealedEnvelope{}, errors.Wrapf(action.ErrNonce, "nonces %d to %d are not taken by one action",
+			nonce, act.LastNonce())
+	}
+	// The replacement is kept in the queue as long as a newly put action
+	for i := range q.index {
+		if q.index[i].nonce == nonce {
+			q.index[i].deadline = q.clock.Now().Add(q.ttl)
+			break
+		}
+	}
+	q.items[nonce] = act
+	return old, nil
+}
+
+// SetTimeOut keeps the action with the given nonce in the queue for the given duration from now, instead of the default
+// time out of the queue
+func (q *actQueue) SetTimeOut(nonce uint64, ttl time.Duration) {
+	for i := range q.index {
+		if q.index[i].nonce == nonce {
+			q.index[i].deadline = q.clock.Now().Add(ttl)
+			return
+		}
+	}
+}
+
 // FilterNonce removes all actions from the map with a nonce lower than the given threshold
 func (q *actQueue) FilterNonce(threshold uint64) []action.SealedEnvelope {
 	var removed []action.SealedEnvelope
 	// Pop off priority queue and delete corresponding entries from map until the threshold is reached
 	for q.index.Len() > 0 && (q.index)[0].nonce < threshold {
 		nonce := heap.Pop(&q.index).(nonceWithTTL).nonce
-		removed = append(removed, q.items[nonce])
-		delete(q.items, nonce)
+		removed = append(removed, q.delete(nonce))
 	}
 	return removed
 }
 
-func (q *actQueue) cleanTimeout() []action.SealedEnvelope {
+// CleanTimeout removes all the actions which have timed out from the queue
+func (q *actQueue) CleanTimeout() []action.SealedEnvelope {
 	removedFromQueue := make([]action.SealedEnvelope, 0)
-	for i := 0; i < len(q.index); i++ {
-		if q.clock.Now().After(q.index[i].deadline) {
-			// remove
-			removedFromQueue = append(removedFromQueue, q.items[q.index[i].nonce])
-			delete(q.items, q.index[i].nonce)
-			q.index = append(q.index[:i], q.index[i+1:]...)
+	if q.ttl == 0 {
+		return removedFromQueue
+	}
+	for i := 0; i < len(q.index); {
+		if !q.clock.Now().After(q.index[i].deadline) {
+			i++
+			continue
+		}
+		// remove
+		removedFromQueue = append(removedFromQueue, q.delete(q.index[i].nonce))
+		q.index = append(q.index[:i], q.index[i+1:]...)
+	}
+	// Restore the heap order which the removal may break
+	heap.Init(&q.index)
+	return removedFromQueue
+}
+
+// FilterExpired removes all the actions which can no longer be included in the block of the given height
+func (q *actQueue) FilterExpired(height uint64) []action.SealedEnvelope {
+	removedFromQueue := make([]action.SealedEnvelope, 0)
+	for i := 0; i < len(q.index); {
+		if act := q.items[q.index[i].nonce]; !act.Expired(height) {
+			i++
+			continue
 		}
+		// remove
+		removedFromQueue = append(removedFromQueue, q.delete(q.index[i].nonce))
+		q.index = append(q.index[:i], q.index[i+1:]...)
 	}
+	// Restore the heap order which the removal may break
+	heap.Init(&q.index)
 	return removedFromQueue
 }
 
 // UpdateQueue updates the pending nonce and balance of the queue
 func (q *actQueue) UpdateQueue(nonce uint64) []action.SealedEnvelope {
 	removedFromQueue := make([]action.SealedEnvelope, 0)
-	// First remove all timed out actions
-	if q.ttl != 0 {
-		removedFromQueue = append(removedFromQueue, q.cleanTimeout()...)
-	}
 
-	// Now, starting from the current pending nonce, incrementally find the next pending nonce
+	// Starting from the current pending nonce, incrementally find the next pending nonce
 	// while updating pending balance if actions are payable
-	for ; ; nonce++ {
-		_, exist := q.items[nonce]
+	for {
+		act, exist := q.items[nonce]
 		if !exist {
 			break
 		}
-		if !q.enoughBalance(q.items[nonce], true) {
+		if !q.enoughBalance(act, true) {
 			break
 		}
+		nonce = act.LastNonce() + 1
 	}
 	q.pendingNonce = nonce
 
@@ -238,11 +312,13 @@ func (q *actQueue) PendingActs() []action.SealedEnvelope {
 		return nil
 	}
 	nonce := confirmedNonce + 1
-	for ; ; nonce++ {
-		if _, exist := q.items[nonce]; !exist {
+	for {
+		act, exist := q.items[nonce]
+		if !exist {
 			break
 		}
-		acts = append(acts, q.items[nonce])
+		acts = append(acts, act)
+		nonce = act.LastNonce() + 1
 	}
 	return acts
 }
@@ -260,18 +336,37 @@ func (q *actQueue) AllActs() []action.SealedEnvelope {
 	return acts
 }
 
+// RemoveTail removes the action with the highest nonce from queue, which keeps the nonces of the rest consecutive
+func (q *actQueue) RemoveTail() action.SealedEnvelope {
+	if q.Len() == 0 {
+		return action.SealedEnvelope{}
+	}
+	sort.Sort(q.index)
+	return q.removeActs(q.index.Len() - 1)[0]
+}
+
 // removeActs removes all the actions starting at idx from queue
 func (q *actQueue) removeActs(idx int) []action.SealedEnvelope {
 	removedFromQueue := make([]action.SealedEnvelope, 0)
 	for i := idx; i < q.index.Len(); i++ {
-		removedFromQueue = append(removedFromQueue, q.items[q.index[i].nonce])
-		delete(q.items, q.index[i].nonce)
+		removedFromQueue = append(removedFromQueue, q.delete(q.index[i].nonce))
 	}
 	q.index = q.index[:idx]
 	heap.Init(&q.index)
 	return removedFromQueue
 }
 
+// delete removes the action with the given nonce from the map, and returns it
+func (q *actQueue) delete(nonce uint64) action.SealedEnvelope {
+	act := q.items[nonce]
+	delete(q.items, nonce)
+	for covered := nonce; covered < act.LastNonce(); {
+		covered++
+		delete(q.covered, covered)
+	}
+	return act
+}
+
 // enoughBalance helps check whether queue's pending balance is sufficient for the given action
 func (q *actQueue) enoughBalance(act action.SealedEnvelope, updateBalance bool) bool {
 	cost, _ := act.Cost()
@@ -18,6 +18,7 @@ import (
 	"github.com/iotexproject/iotex-core/config"
 
 	"github.com/facebookgo/clock"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -79,6 +80,30 @@ func TestActQueuePut(t *testing.T) {
 	require.NotNil(err)
 }
 
+func TestActQueueReplace(t *testing.T) {
+	require := require.New(t)
+	c := clock.NewMock()
+	q := NewActQueue(nil, "", WithClock(c), WithTimeOut(time.Minute)).(*actQueue)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf1))
+	c.Add(30 * time.Second)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(1000), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	old, err := q.Replace(tsf2)
+	require.NoError(err)
+	require.Equal(tsf1, old)
+	require.Equal(tsf2, q.items[uint64(1)])
+	require.Equal(1, q.Len())
+	// the replacement is kept as long as a newly put action
+	require.Equal(c.Now().Add(time.Minute), q.index[0].deadline)
+	// nothing to replace
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(1000), nil, uint64(0), big.NewInt(1))
+	require.NoError(err)
+	_, err = q.Replace(tsf3)
+	require.Equal(action.ErrNonce, errors.Cause(err))
+}
+
 func TestActQueueFilterNonce(t *testing.T) {
 	require := require.New(t)
 	q := NewActQueue(nil, "").(*actQueue)
@@ -218,6 +243,22 @@ func TestActQueueRemoveActs(t *testing.T) {
 	require.Equal([]action.SealedEnvelope{tsf5, vote6}, removed)
 }
 
+func TestActQueueRemoveTail(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	require.Equal(action.SealedEnvelope{}, q.RemoveTail())
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	require.NoError(q.Put(tsf2))
+	require.NoError(q.Put(tsf1))
+	require.Equal(tsf2, q.RemoveTail())
+	require.Equal([]action.SealedEnvelope{tsf1}, q.AllActs())
+	require.Equal(tsf1, q.RemoveTail())
+	require.True(q.Empty())
+}
+
 func TestActQueueTimeOutAction(t *testing.T) {
 	c := clock.NewMock()
 	q := NewActQueue(nil, "", WithClock(c), WithTimeOut(3*time.Minute))
@@ -230,9 +271,88 @@ func TestActQueueTimeOutAction(t *testing.T) {
 	c.Add(2 * time.Minute)
 
 	require.NoError(t, q.Put(tsf2))
-	q.(*actQueue).cleanTimeout()
+	q.CleanTimeout()
 	assert.Equal(t, 2, q.Len())
 	c.Add(2 * time.Minute)
-	q.(*actQueue).cleanTimeout()
+	q.CleanTimeout()
 	assert.Equal(t, 1, q.Len())
+
+	// the actions timing out together are all removed
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, 4, big.NewInt(100), nil, uint64(0), big.NewInt(0))
+	require.NoError(t, err)
+	require.NoError(t, q.Put(tsf3))
+	c.Add(4 * time.Minute)
+	assert.Equal(t, []action.SealedEnvelope{tsf2, tsf3}, q.CleanTimeout())
+	assert.True(t, q.Empty())
+}
+
+func TestActQueueBundle(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	q.pendingBalance = big.NewInt(100000)
+	elps := make([]action.Envelope, 0, 3)
+	for nonce := uint64(2); nonce <= 4; nonce++ {
+		tsf, err := testutil.SignedTransfer(addr2, priKey1, nonce, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+		require.NoError(err)
+		elps = append(elps, tsf.Envelope)
+	}
+	bundle, err := testutil.SignedBundle(priKey1, elps)
+	require.NoError(err)
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf3, err := testutil.SignedTransfer(addr2, priKey1, 3, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+	tsf5, err := testutil.SignedTransfer(addr2, priKey1, 5, big.NewInt(1), nil, uint64(0), big.NewInt(0))
+	require.NoError(err)
+
+	require.NoError(q.Put(tsf3))
+	require.True(q.Overlaps(bundle))
+	require.Equal(action.ErrNonce, errors.Cause(q.Put(bundle)))
+	q.FilterNonce(4)
+	require.True(q.Empty())
+
+	// the bundle takes all its nonces
+	require.NoError(q.Put(bundle))
+	require.True(q.Overlaps(tsf3))
+	require.False(q.Overlaps(tsf5))
+	require.Equal(action.ErrNonce, errors.Cause(q.Put(tsf3)))
+	_, err = q.Replace(tsf3)
+	require.Equal(action.ErrNonce, errors.Cause(err))
+	require.NoError(q.Put(tsf1))
+	require.NoError(q.Put(tsf5))
+	require.Equal(0, len(q.UpdateQueue(1)))
+	require.Equal(uint64(6), q.pendingNonce)
+	require.Equal(3, q.Len())
+
+	// removing the bundle frees its nonces
+	q.FilterNonce(5)
+	require.Equal([]action.SealedEnvelope{tsf5}, q.AllActs())
+	require.Equal(0, len(q.covered))
+	require.NoError(q.Put(tsf3))
+}
+
+func TestActQueueFilterExpired(t *testing.T) {
+	require := require.New(t)
+	q := NewActQueue(nil, "").(*actQueue)
+	expiring := func(nonce, validUntilHeight uint64) action.SealedEnvelope {
+		tsf, err := action.NewTransfer(nonce, big.NewInt(1), addr2, nil, uint64(0), big.NewInt(0))
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetNonce(nonce).SetAction(tsf).SetValidUntilHeight(validUntilHeight).Build()
+		selp, err := action.Sign(elp, priKey1)
+		require.NoError(err)
+		return selp
+	}
+	tsf1 := expiring(1, 0)
+	tsf2 := expiring(2, 5)
+	tsf3 := expiring(3, 10)
+	tsf4 := expiring(4, 5)
+	for _, tsf := range []action.SealedEnvelope{tsf1, tsf2, tsf3, tsf4} {
+		require.NoError(q.Put(tsf))
+	}
+	require.Equal(0, len(q.FilterExpired(5)))
+	require.Equal([]action.SealedEnvelope{tsf2, tsf4}, q.FilterExpired(6))
+	require.Equal([]action.SealedEnvelope{tsf1, tsf3}, q.AllActs())
+	require.Equal(uint64(1), q.index[0].nonce)
+	require.Equal([]action.SealedEnvelope{tsf3}, q.FilterExpired(11))
 }
@@ -0,0 +1,209 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+)
+
+// journalHeaderSize is the size of the header of a journal record, i.e., the time when the action is accepted and the
+// length of the serialized action
+const journalHeaderSize = 12
+
+// maxJournalRecordSize bounds the length of a serialized action, so that a corrupted header cannot exhaust memory
+const maxJournalRecordSize = 32 * 1024 * 1024
+
+// journalRecord is an action in journal along with the time when the action is accepted by the pool
+type journalRecord struct {
+	act        action.SealedEnvelope
+	acceptedAt time.Time
+}
+
+// journal is an append-only file of the actions accepted by the pool, which are replayed when the node restarts
+type journal struct {
+	path       string
+	file       *os.File
+	acceptedAt map[hash.Hash256]time.Time
+}
+
+func newJournal(path string) *journal {
+	return &journal{
+		path:       path,
+		acceptedAt: make(map[hash.Hash256]time.Time),
+	}
+}
+
+// load reads all the records in journal and opens it for appending. A truncated or corrupted record, e.g., the one
+// being written when the node crashes, ends the journal
+func (j *journal) load() ([]journalRecord, error) {
+	if err := os.MkdirAll(filepath.Dir(j.path), 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create the directory of journal %s", j.path)
+	}
+	records := make([]journalRecord, 0)
+	file, err := os.Open(j.path)
+	switch {
+	case os.IsNotExist(err):
+	case err != nil:
+		return nil, errors.Wrapf(err, "failed to open journal %s", j.path)
+	default:
+		defer file.Close()
+		r := bufio.NewReader(file)
+		for {
+			record, err := readJournalRecord(r)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				log.L().Warn("Stop loading the corrupted journal.", zap.String("path", j.path), zap.Error(err))
+				break
+			}
+			records = append(records, record)
+		}
+	}
+	// Rewrite the journal, which drops the corrupted records if any
+	if err := j.rotate(records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+// insert appends an action accepted at the given time to journal
+func (j *journal) insert(act action.SealedEnvelope, acceptedAt time.Time) error {
+	if j.file == nil {
+		return errors.New("journal is not open")
+	}
+	if err := writeJournalRecord(j.file, journalRecord{act: act, acceptedAt: acceptedAt}); err != nil {
+		return errors.Wrapf(err, "failed to write journal %s", j.path)
+	}
+	j.acceptedAt[act.Hash()] = acceptedAt
+	return nil
+}
+
+// compact rewrites journal to the given actions, keeping the time when each of them is accepted
+func (j *journal) compact(acts []action.SealedEnvelope) error {
+	now := time.Now()
+	records := make([]journalRecord, 0, len(acts))
+	for _, act := range acts {
+		acceptedAt, ok := j.acceptedAt[act.Hash()]
+		if !ok {
+			acceptedAt = now
+		}
+		records = append(records, journalRecord{act: act, acceptedAt: acceptedAt})
+	}
+	return j.rotate(records)
+}
+
+// rotate writes the records into a new file, replaces journal with it atomically and reopens journal for appending
+func (j *journal) rotate(records []journalRecord) error {
+	tmpPath := j.path + ".new"
+	tmp, err := os.OpenFile(tmpPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create journal %s", tmpPath)
+	}
+	w := bufio.NewWriter(tmp)
+	acceptedAt := make(map[hash.Hash256]time.Time, len(records))
+	for _, record := range records {
+		if err := writeJournalRecord(w, record); err != nil {
+			tmp.Close()
+			return errors.Wrapf(err, "failed to write journal %s", tmpPath)
+		}
+		acceptedAt[record.act.Hash()] = record.acceptedAt
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "failed to write journal %s", tmpPath)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return errors.Wrapf(err, "failed to sync journal %s", tmpPath)
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrapf(err, "failed to close journal %s", tmpPath)
+	}
+	if j.file != nil {
+		if err := j.file.Close(); err != nil {
+			log.L().Warn("Failed to close journal.", zap.String("path", j.path), zap.Error(err))
+		}
+		j.file = nil
+	}
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return errors.Wrapf(err, "failed to replace journal %s", j.path)
+	}
+	file, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to open journal %s", j.path)
+	}
+	j.file = file
+	j.acceptedAt = acceptedAt
+	return nil
+}
+
+// close closes journal
+func (j *journal) close() error {
+	if j.file == nil {
+		return nil
+	}
+	err := j.file.Close()
+	j.file = nil
+	return err
+}
+
+func writeJournalRecord(w io.Writer, record journalRecord) error {
+	data, err := proto.Marshal(record.act.Proto())
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, journalHeaderSize, journalHeaderSize+len(data))
+	binary.BigEndian.PutUint64(buf[:8], uint64(record.acceptedAt.UnixNano()))
+	binary.BigEndian.PutUint32(buf[8:], uint32(len(data)))
+	_, err = w.Write(append(buf, data...))
+	return err
+}
+
+func readJournalRecord(r io.Reader) (journalRecord, error) {
+	header := make([]byte, journalHeaderSize)
+	if _, err := io.ReadFull(r, header); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return journalRecord{}, errors.Wrap(err, "truncated record header")
+		}
+		return journalRecord{}, err
+	}
+	size := binary.BigEndian.Uint32(header[8:])
+	if size > maxJournalRecordSize {
+		return journalRecord{}, errors.Errorf("invalid record size %d", size)
+	}
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return journalRecord{}, errors.Wrap(err, "truncated record")
+	}
+	pbAct := &iotextypes.Action{}
+	if err := proto.Unmarshal(data, pbAct); err != nil {
+		return journalRecord{}, errors.Wrap(err, "failed to unmarshal record")
+	}
+	var act action.SealedEnvelope
+	if err := act.LoadProto(pbAct); err != nil {
+		return journalRecord{}, errors.Wrap(err, "failed to load record")
+	}
+	return journalRecord{
+		act:        act,
+		acceptedAt: time.Unix(0, int64(binary.BigEndian.Uint64(header[:8]))),
+	}, nil
+}
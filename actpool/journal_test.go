@@ -0,0 +1,67 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestJournal(t *testing.T) {
+	require := require.New(t)
+	dir, err := ioutil.TempDir(os.TempDir(), "journal")
+	require.NoError(err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "actpool.journal")
+
+	tsf1, err := testutil.SignedTransfer(addr2, priKey1, 1, big.NewInt(100), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+	tsf2, err := testutil.SignedTransfer(addr2, priKey1, 2, big.NewInt(100), nil, uint64(100000), big.NewInt(0))
+	require.NoError(err)
+
+	j := newJournal(path)
+	records, err := j.load()
+	require.NoError(err)
+	require.Equal(0, len(records))
+	acceptedAt := time.Now().Add(-time.Minute)
+	require.NoError(j.insert(tsf1, acceptedAt))
+	require.NoError(j.insert(tsf2, time.Now()))
+	require.NoError(j.close())
+
+	// a truncated record is dropped
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND, 0600)
+	require.NoError(err)
+	_, err = file.Write([]byte{0, 1, 2})
+	require.NoError(err)
+	require.NoError(file.Close())
+
+	j = newJournal(path)
+	records, err = j.load()
+	require.NoError(err)
+	require.Equal(2, len(records))
+	require.Equal(tsf1.Hash(), records[0].act.Hash())
+	require.Equal(acceptedAt.UnixNano(), records[0].acceptedAt.UnixNano())
+	require.Equal(tsf2.Hash(), records[1].act.Hash())
+
+	// compaction keeps the time when the action is accepted
+	require.NoError(j.compact([]action.SealedEnvelope{tsf1}))
+	require.NoError(j.close())
+	records, err = newJournal(path).load()
+	require.NoError(err)
+	require.Equal(1, len(records))
+	require.Equal(tsf1.Hash(), records[0].act.Hash())
+	require.Equal(acceptedAt.UnixNano(), records[0].acceptedAt.UnixNano())
+}
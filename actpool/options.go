@@ -7,11 +7,30 @@
 package actpool
 
 import (
+	"context"
 	"time"
 
 	"github.com/facebookgo/clock"
+	"github.com/golang/protobuf/proto"
 )
 
+// BroadcastOutbound sends a broadcast message to the whole network
+type BroadcastOutbound func(ctx context.Context, chainID uint32, msg proto.Message) error
+
+// ActPoolOption is the option for actPool.
+type ActPoolOption interface {
+	SetActPoolOption(*actPool)
+}
+
+type broadcastOption struct{ broadcastHandler BroadcastOutbound }
+
+// WithBroadcastOutbound returns an option to broadcast the actions accepted by the pool outbound.
+func WithBroadcastOutbound(broadcastHandler BroadcastOutbound) interface{ ActPoolOption } {
+	return &broadcastOption{broadcastHandler}
+}
+
+func (o *broadcastOption) SetActPoolOption(ap *actPool) { ap.broadcastHandler = o.broadcastHandler }
+
 type clockOption struct{ c clock.Clock }
 
 // WithClock returns an option to overwrite clock.
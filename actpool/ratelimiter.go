@@ -0,0 +1,87 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package actpool
+
+import (
+	"time"
+
+	"github.com/facebookgo/clock"
+)
+
+// rateLimiter limits the number of events per second of each key with a token bucket, which holds up to one second
+// worth of tokens, so that a key is allowed to burst at the rate
+type rateLimiter struct {
+	rate    float64
+	clock   clock.Clock
+	buckets map[string]*tokenBucket
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// newRateLimiter returns a limiter allowing rate events per second of each key, or nil if rate is zero, i.e., the
+// limit is disabled
+func newRateLimiter(rate uint64) *rateLimiter {
+	if rate == 0 {
+		return nil
+	}
+	return &rateLimiter{
+		rate:    float64(rate),
+		clock:   clock.New(),
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// allow tells if an event of the key is allowed now, without taking a token
+func (rl *rateLimiter) allow(key string) bool {
+	if rl == nil {
+		return true
+	}
+	b, ok := rl.buckets[key]
+	if !ok {
+		return true
+	}
+	return rl.refill(b) >= 1
+}
+
+// take takes a token of the key for an event
+func (rl *rateLimiter) take(key string) {
+	if rl == nil {
+		return
+	}
+	b, ok := rl.buckets[key]
+	if !ok {
+		b = &tokenBucket{tokens: rl.rate, last: rl.clock.Now()}
+		rl.buckets[key] = b
+	}
+	rl.refill(b)
+	b.tokens--
+}
+
+// prune removes the buckets which are full again, as they are the same as the missing ones
+func (rl *rateLimiter) prune() {
+	if rl == nil {
+		return
+	}
+	for key, b := range rl.buckets {
+		if rl.refill(b) >= rl.rate {
+			delete(rl.buckets, key)
+		}
+	}
+}
+
+func (rl *rateLimiter) refill(b *tokenBucket) float64 {
+	now := rl.clock.Now()
+	b.tokens += now.Sub(b.last).Seconds() * rl.rate
+	if b.tokens > rl.rate {
+		b.tokens = rl.rate
+	}
+	b.last = now
+	return b.tokens
+}
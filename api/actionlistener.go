@@ -0,0 +1,138 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/hex"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/actpool"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/protogen/iotexapi"
+)
+
+const actionListenerBufferSize = 256
+
+// actionListener implements actpool.Subscriber and hands the events of the actions matching the filter over to a
+// stream. The pool is locked while notifying it, so the events are dropped instead of blocking once the buffer is full,
+// and the stream is closed then
+type actionListener struct {
+	senders    map[string]bool
+	recipients map[string]bool
+	contracts  map[string]bool
+	events     chan *iotexapi.StreamPendingActionsResponse
+	overflow   chan struct{}
+	once       sync.Once
+}
+
+func newActionListener(in *iotexapi.StreamPendingActionsRequest) (*actionListener, error) {
+	al := &actionListener{
+		events:   make(chan *iotexapi.StreamPendingActionsResponse, actionListenerBufferSize),
+		overflow: make(chan struct{}),
+	}
+	var err error
+	if al.senders, err = toAddressSet(in.SenderAddresses); err != nil {
+		return nil, err
+	}
+	if al.recipients, err = toAddressSet(in.RecipientAddresses); err != nil {
+		return nil, err
+	}
+	if al.contracts, err = toAddressSet(in.ContractAddresses); err != nil {
+		return nil, err
+	}
+	return al, nil
+}
+
+// HandleAcceptedAction implements interface actpool.Subscriber
+func (al *actionListener) HandleAcceptedAction(act action.SealedEnvelope) {
+	al.handle(act, iotexapi.PendingActionEvent_PendingActionAccepted, "")
+}
+
+// HandleDroppedAction implements interface actpool.Subscriber
+func (al *actionListener) HandleDroppedAction(act action.SealedEnvelope, reason error) {
+	var event iotexapi.PendingActionEvent
+	switch errors.Cause(reason) {
+	case actpool.ErrConfirmed:
+		event = iotexapi.PendingActionEvent_PendingActionConfirmed
+	case actpool.ErrReplaced:
+		event = iotexapi.PendingActionEvent_PendingActionReplaced
+	case actpool.ErrEvicted:
+		event = iotexapi.PendingActionEvent_PendingActionEvicted
+	case actpool.ErrTimedOut, action.ErrExpired:
+		event = iotexapi.PendingActionEvent_PendingActionExpired
+	default:
+		event = iotexapi.PendingActionEvent_PendingActionInvalidated
+	}
+	al.handle(act, event, reason.Error())
+}
+
+func (al *actionListener) handle(act action.SealedEnvelope, event iotexapi.PendingActionEvent, reason string) {
+	if !al.match(act) {
+		return
+	}
+	hash := act.Hash()
+	select {
+	case al.events <- &iotexapi.StreamPendingActionsResponse{
+		Event:      event,
+		Action:     act.Proto(),
+		ActionHash: hex.EncodeToString(hash[:]),
+		Reason:     reason,
+	}:
+	default:
+		al.once.Do(func() { close(al.overflow) })
+	}
+}
+
+// match checks the action against the filter. The recipients are the destinations of the action, as indexed by the
+// index builder, and the contracts are the ones called by the action or by the executions in the bundle
+func (al *actionListener) match(act action.SealedEnvelope) bool {
+	if len(al.senders) > 0 {
+		sender, err := address.FromBytes(act.SrcPubkey().Hash())
+		if err != nil || !al.senders[sender.String()] {
+			return false
+		}
+	}
+	if len(al.recipients) > 0 && !matchAny(al.recipients, act.Destinations()) {
+		return false
+	}
+	if len(al.contracts) > 0 {
+		var contracts []string
+		for _, selp := range action.Unbundle(act) {
+			if exec, ok := selp.Action().(*action.Execution); ok {
+				contracts = append(contracts, exec.Contract())
+			}
+		}
+		if !matchAny(al.contracts, contracts) {
+			return false
+		}
+	}
+	return true
+}
+
+// matchAny checks if any of the addresses is in the set
+func matchAny(set map[string]bool, addrs []string) bool {
+	for _, addr := range addrs {
+		if set[addr] {
+			return true
+		}
+	}
+	return false
+}
+
+func toAddressSet(addrs []string) (map[string]bool, error) {
+	set := make(map[string]bool, len(addrs))
+	for _, addr := range addrs {
+		if _, err := address.FromString(addr); err != nil {
+			return nil, errors.Wrapf(err, "invalid address %s", addr)
+		}
+		set[addr] = true
+	}
+	return set, nil
+}
@@ -11,8 +11,12 @@ import (
 	"encoding/hex"
 	"math/big"
 	"net"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/golang/protobuf/proto"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
 	"github.com/pkg/errors"
@@ -24,6 +28,7 @@ import (
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/execution/evm"
 	"github.com/iotexproject/iotex-core/action/protocol/poll"
 	"github.com/iotexproject/iotex-core/action/protocol/poll/pollpb"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
@@ -32,6 +37,9 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/block"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/dispatcher"
 	"github.com/iotexproject/iotex-core/gasstation"
 	"github.com/iotexproject/iotex-core/indexservice"
@@ -41,6 +49,8 @@ import (
 	"github.com/iotexproject/iotex-core/pkg/version"
 	"github.com/iotexproject/iotex-core/protogen/iotexapi"
 	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+	"github.com/iotexproject/iotex-core/state"
+	"github.com/iotexproject/iotex-core/state/factory"
 )
 
 var (
@@ -52,6 +62,14 @@ var (
 	ErrAction = errors.New("invalid action")
 )
 
+const (
+	// traceStepLimit caps the number of the opcode steps returned by a trace
+	traceStepLimit = 10000
+	// traceSizeLimit caps the size of the opcode steps returned by a trace, which keeps the response under the 4MB
+	// message size limit of gRPC
+	traceSizeLimit = 3 << 20
+)
+
 // BroadcastOutbound sends a broadcast message to the whole network
 type BroadcastOutbound func(ctx context.Context, chainID uint32, msg proto.Message) error
 
@@ -130,6 +148,9 @@ func NewServer(
 
 // GetAccount returns the metadata of an account
 func (api *Server) GetAccount(ctx context.Context, in *iotexapi.GetAccountRequest) (*iotexapi.GetAccountResponse, error) {
+	if in.Height != 0 && in.Height != api.bc.TipHeight() {
+		return api.getAccountAtHeight(in.Address, in.Height)
+	}
 	state, err := api.bc.StateByAddr(in.Address)
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
@@ -257,12 +278,25 @@ func (api *Server) GetServerMeta(ctx context.Context,
 func (api *Server) SendAction(ctx context.Context, in *iotexapi.SendActionRequest) (res *iotexapi.SendActionResponse, err error) {
 	log.L().Debug("receive send action request")
 
+	var selp action.SealedEnvelope
+	if err = selp.LoadProto(in.Action); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	// add to actpool as a local action, which is broadcast again until it is included
+	if err = api.ap.AddLocal(selp); err != nil {
+		// The action rejected by the pool is not broadcast, and the sender is told why
+		log.L().Debug("Failed to add SendAction request to actpool.", zap.Error(err))
+		switch errors.Cause(err) {
+		case actpool.ErrSenderRateLimited, action.ErrActPool:
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		default:
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
 	// broadcast to the network
 	if err = api.broadcastHandler(context.Background(), api.bc.ChainID(), in.Action); err != nil {
 		log.L().Warn("Failed to broadcast SendAction request.", zap.Error(err))
 	}
-	// send to actpool via dispatcher
-	api.dp.HandleBroadcast(context.Background(), api.bc.ChainID(), in.Action)
 
 	return &iotexapi.SendActionResponse{}, nil
 }
@@ -299,9 +333,17 @@ func (api *Server) ReadContract(ctx context.Context, in *iotexapi.ReadContractRe
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	res, err := api.bc.ExecuteContractRead(callerAddr, sc)
+	var res *action.Receipt
+	if in.Height == 0 {
+		res, err = api.bc.ExecuteContractRead(callerAddr, sc)
+	} else {
+		if err := api.checkStateHeight(in.Height); err != nil {
+			return nil, err
+		}
+		res, err = api.bc.ExecuteContractReadAtHeight(callerAddr, sc, in.Height)
+	}
 	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
+		return nil, historyStateError(err)
 	}
 	return &iotexapi.ReadContractResponse{Data: hex.EncodeToString(res.ReturnValue)}, nil
 }
@@ -394,6 +436,459 @@ func (api *Server) GetProductivity(
 	return &iotexapi.GetProductivityResponse{TotalBlks: numBlks, BlksPerDelegate: produce}, nil
 }
 
+// GetLogs gets the receipt logs which match the filter within a block range
+func (api *Server) GetLogs(ctx context.Context, in *iotexapi.GetLogsRequest) (*iotexapi.GetLogsResponse, error) {
+	start, end := in.FromBlock, in.ToBlock
+	tipHeight := api.bc.TipHeight()
+	if start == 0 {
+		start = 1
+	}
+	if end == 0 || end > tipHeight {
+		end = tipHeight
+	}
+	if start > end {
+		return nil, status.Error(codes.InvalidArgument, "start block is beyond the end block")
+	}
+	if api.cfg.RangeQueryLimit > 0 && end-start+1 > api.cfg.RangeQueryLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "block range exceeds the limit of %d", api.cfg.RangeQueryLimit)
+	}
+	filter := &blockchain.LogFilter{Addresses: in.GetFilter().GetAddress()}
+	for _, addr := range filter.Addresses {
+		if _, err := address.FromString(addr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	for _, topics := range in.GetFilter().GetTopics() {
+		hashes := make([]hash.Hash256, 0, len(topics.Topic))
+		for _, topic := range topics.Topic {
+			if len(topic) != len(hash.ZeroHash256) {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid topic %x", topic)
+			}
+			hashes = append(hashes, hash.BytesToHash256(topic))
+		}
+		filter.Topics = append(filter.Topics, hashes)
+	}
+	logs, err := api.bc.GetLogs(filter, start, end)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	res := &iotexapi.GetLogsResponse{}
+	for _, l := range logs {
+		res.Logs = append(res.Logs, l.ConvertToLogPb())
+	}
+	return res, nil
+}
+
+// GetActionProof returns the header of the block including an action, together with the Merkle paths of the action
+// and its receipt against the tx root and the receipt root
+func (api *Server) GetActionProof(
+	ctx context.Context,
+	in *iotexapi.GetActionProofRequest,
+) (*iotexapi.GetActionProofResponse, error) {
+	actHash, err := toHash256(in.ActionHash)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	blkHash, err := api.bc.GetBlockHashByActionHash(actHash)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	blk, err := api.bc.GetBlockByHash(blkHash)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	receipts, err := api.bc.GetReceiptsByHeight(blk.Height())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if len(receipts) != len(blk.Actions) {
+		return nil, status.Errorf(codes.Internal, "block %d has %d actions but %d receipts",
+			blk.Height(), len(blk.Actions), len(receipts))
+	}
+	index := -1
+	actHashes := make([]hash.Hash256, 0, len(blk.Actions))
+	for i, selp := range blk.Actions {
+		if h := selp.Hash(); h == actHash {
+			index = i
+		}
+		actHashes = append(actHashes, selp.Hash())
+	}
+	if index < 0 {
+		return nil, status.Errorf(codes.Internal, "action %x is not in block %x", actHash, blkHash)
+	}
+	receiptHashes := make([]hash.Hash256, 0, len(receipts))
+	for _, receipt := range receipts {
+		receiptHashes = append(receiptHashes, receipt.Hash())
+	}
+	actionProof, err := merkleProof(actHashes, index)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	receiptProof, err := merkleProof(receiptHashes, index)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.GetActionProofResponse{
+		BlockHeader:  blk.Header.BlockHeaderProto(),
+		Index:        uint32(index),
+		ActionProof:  actionProof,
+		Receipt:      receipts[index].ConvertToReceiptPb(),
+		ReceiptProof: receiptProof,
+	}, nil
+}
+
+// GetAccountProof returns the merkle proof of an account against the state root, together with the proofs of the
+// storage slots against the storage root if the account is a contract
+func (api *Server) GetAccountProof(
+	ctx context.Context,
+	in *iotexapi.GetAccountProofRequest,
+) (*iotexapi.GetAccountProofResponse, error) {
+	addr, err := address.FromString(in.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	for _, key := range in.StorageKeys {
+		if len(key) != len(hash.ZeroHash256) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid storage key %x", key)
+		}
+	}
+	var (
+		storageProofs []*iotexapi.StorageProof
+		proveStorage  func(db.KVStore, *state.Account) error
+	)
+	if len(in.StorageKeys) > 0 {
+		// the storage is proved against the storage root of the account under the same state root
+		proveStorage = func(dao db.KVStore, account *state.Account) error {
+			if len(account.CodeHash) == 0 {
+				return status.Error(codes.InvalidArgument, "storage proof is only available for contracts")
+			}
+			for _, key := range in.StorageKeys {
+				value, proof, err := evm.ProveStorage(dao, addrHash, account.Root, hash.BytesToHash256(key))
+				if err != nil {
+					if errors.Cause(err) == trie.ErrNotExist {
+						return status.Errorf(codes.NotFound, "storage key %x doesn't exist", key)
+					}
+					return err
+				}
+				storageProofs = append(storageProofs, &iotexapi.StorageProof{Key: key, Value: value, Proof: proof})
+			}
+			return nil
+		}
+	}
+	stateRoot, accountProof, err := api.bc.GetFactory().ProveState(addrHash, proveStorage)
+	switch errors.Cause(err) {
+	case nil:
+	case state.ErrStateNotExist:
+		return nil, status.Error(codes.NotFound, err.Error())
+	case factory.ErrNoTrie:
+		return nil, status.Error(codes.FailedPrecondition, "account proof is not available as the node uses trieless state db")
+	default:
+		// the error of proving the storage is already a status one
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// the proof is verified before responding, which also decodes the account state from the leaf
+	data, err := trie.VerifyProof(stateRoot[:], addrHash[:], accountProof)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	var account state.Account
+	if err := account.Deserialize(data); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &iotexapi.GetAccountProofResponse{
+		StateRoot:     stateRoot[:],
+		Account:       data,
+		AccountProof:  accountProof,
+		StorageRoot:   account.Root[:],
+		StorageProofs: storageProofs,
+	}, nil
+}
+
+// TraceAction traces an execution with the evm tracer, which is either a committed execution re-run on top of the
+// state of its parent block, or an unsigned execution run on top of the tip state
+func (api *Server) TraceAction(
+	ctx context.Context,
+	in *iotexapi.TraceActionRequest,
+) (*iotexapi.TraceActionResponse, error) {
+	limit := traceStepLimit
+	if in.Limit > 0 && in.Limit < traceStepLimit {
+		limit = int(in.Limit)
+	}
+	// one more step is recorded to tell if the steps are truncated
+	tracer := evm.NewTracer(&vm.LogConfig{
+		DisableMemory:  !in.EnableMemory,
+		DisableStack:   !in.EnableStack,
+		DisableStorage: true,
+		Limit:          limit + 1,
+	})
+	var receipt *action.Receipt
+	switch {
+	case in.GetExecution() != nil:
+		request := in.GetExecution()
+		if request.Execution == nil {
+			return nil, status.Error(codes.InvalidArgument, "execution is empty")
+		}
+		caller, err := address.FromString(request.CallerAddress)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		amount := big.NewInt(0)
+		if request.Execution.Amount != "" {
+			var ok bool
+			if amount, ok = new(big.Int).SetString(request.Execution.Amount, 10); !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid amount %s", request.Execution.Amount)
+			}
+		}
+		contract := request.Execution.Contract
+		if contract == "" {
+			contract = action.EmptyAddress
+		}
+		var nonce uint64
+		if account, err := api.bc.StateByAddr(request.CallerAddress); err == nil {
+			nonce = account.Nonce + 1
+		}
+		exec, err := action.NewExecution(contract, nonce, amount, request.GasLimit, big.NewInt(0), request.Execution.Data)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if receipt, err = api.bc.TraceContractRead(caller, exec, tracer); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case in.GetActionHash() != "":
+		actHash, err := toHash256(in.GetActionHash())
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if receipt, err = api.bc.TraceAction(actHash, tracer); err != nil {
+			switch errors.Cause(err) {
+			case db.ErrNotExist:
+				return nil, status.Error(codes.NotFound, err.Error())
+			case blockchain.ErrNotExecution:
+				return nil, status.Error(codes.InvalidArgument, err.Error())
+			default:
+				return nil, historyStateError(err)
+			}
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "neither action hash nor execution is given")
+	}
+	logs := tracer.StructLogs()
+	truncated := len(logs) > limit
+	if truncated {
+		logs = logs[:limit]
+	}
+	opcodes := make([]*iotexapi.OpcodeStep, 0, len(logs))
+	size := 0
+	for _, l := range logs {
+		step := &iotexapi.OpcodeStep{
+			Pc:      l.Pc,
+			Op:      l.OpName(),
+			Gas:     l.Gas,
+			GasCost: l.GasCost,
+			Depth:   uint32(l.Depth),
+			Memory:  l.Memory,
+			Error:   l.ErrorString(),
+		}
+		for _, item := range l.Stack {
+			step.Stack = append(step.Stack, item.Bytes())
+		}
+		if size += proto.Size(step); size > traceSizeLimit {
+			truncated = true
+			break
+		}
+		opcodes = append(opcodes, step)
+	}
+	return &iotexapi.TraceActionResponse{
+		Receipt:    receipt.ConvertToReceiptPb(),
+		Opcodes:    opcodes,
+		Call:       toCallFramePb(tracer.CallFrame()),
+		RevertData: tracer.RevertData(),
+		Truncated:  truncated,
+	}, nil
+}
+
+// GetActPoolStatus returns the numbers of pending and queued actions and of accounts in actpool
+func (api *Server) GetActPoolStatus(
+	ctx context.Context,
+	in *iotexapi.GetActPoolStatusRequest,
+) (*iotexapi.GetActPoolStatusResponse, error) {
+	poolStatus := api.ap.GetStatus()
+	return &iotexapi.GetActPoolStatusResponse{
+		Pending:  poolStatus.NumPending,
+		Queued:   poolStatus.NumQueued,
+		Capacity: poolStatus.Capacity,
+		Accounts: poolStatus.NumAccounts,
+	}, nil
+}
+
+// GetActPoolContent returns the actions in actpool grouped by sender, with the gaps of nonce blocking the queued ones
+func (api *Server) GetActPoolContent(
+	ctx context.Context,
+	in *iotexapi.GetActPoolContentRequest,
+) (*iotexapi.GetActPoolContentResponse, error) {
+	if in.Address != "" {
+		if _, err := address.FromString(in.Address); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	content := api.ap.GetContent()
+	addrs := actPoolSenders(content, in.Address)
+	res := &iotexapi.GetActPoolContentResponse{Accounts: make([]*iotexapi.ActPoolAccount, 0, len(addrs))}
+	for _, addr := range addrs {
+		acct := content[addr]
+		acctPb := &iotexapi.ActPoolAccount{
+			Address:      addr,
+			PendingNonce: acct.PendingNonce,
+		}
+		for _, selp := range acct.Pending {
+			acctPb.Pending = append(acctPb.Pending, selp.Proto())
+		}
+		for _, selp := range acct.Queued {
+			acctPb.Queued = append(acctPb.Queued, selp.Proto())
+		}
+		for _, gap := range acct.Gaps {
+			acctPb.Gaps = append(acctPb.Gaps, &iotexapi.NonceGap{Start: gap.Start, End: gap.End})
+		}
+		res.Accounts = append(res.Accounts, acctPb)
+	}
+	return res, nil
+}
+
+// GetActPoolActions returns the actions in actpool which match the filter, sorted by sender and nonce
+func (api *Server) GetActPoolActions(
+	ctx context.Context,
+	in *iotexapi.GetActPoolActionsRequest,
+) (*iotexapi.GetActPoolActionsResponse, error) {
+	var minGasPrice *big.Int
+	if in.MinGasPrice != "" {
+		var ok bool
+		if minGasPrice, ok = new(big.Int).SetString(in.MinGasPrice, 10); !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid gas price %s", in.MinGasPrice)
+		}
+	}
+	if in.SenderAddress != "" {
+		if _, err := address.FromString(in.SenderAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	count := in.Count
+	if count == 0 {
+		count = ^uint64(0)
+	}
+	content := api.ap.GetContent()
+	res := &iotexapi.GetActPoolActionsResponse{}
+	for _, addr := range actPoolSenders(content, in.SenderAddress) {
+		acct := content[addr]
+		acts := acct.Pending
+		if !in.PendingOnly {
+			acts = append(acts, acct.Queued...)
+		}
+		for _, selp := range acts {
+			if minGasPrice != nil && selp.GasPrice().Cmp(minGasPrice) < 0 {
+				continue
+			}
+			if in.ActionType != "" && !strings.EqualFold(in.ActionType, actionType(selp)) {
+				continue
+			}
+			res.Total++
+			if res.Total > in.Start && uint64(len(res.Actions)) < count {
+				res.Actions = append(res.Actions, selp.Proto())
+			}
+		}
+	}
+	return res, nil
+}
+
+// actPoolSenders returns the sorted senders in the actpool content, or only the given one if it is not empty
+func actPoolSenders(content map[string]actpool.AccountContent, sender string) []string {
+	addrs := make([]string, 0, len(content))
+	for addr := range content {
+		if sender == "" || sender == addr {
+			addrs = append(addrs, addr)
+		}
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// StreamPendingActions streams the actions accepted by and removed from actpool, which match the filter
+func (api *Server) StreamPendingActions(
+	in *iotexapi.StreamPendingActionsRequest,
+	stream iotexapi.APIService_StreamPendingActionsServer,
+) error {
+	listener, err := newActionListener(in)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := api.ap.AddSubscriber(listener); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer func() {
+		if err := api.ap.RemoveSubscriber(listener); err != nil {
+			log.L().Error("Failed to remove action listener.", zap.Error(err))
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case <-listener.overflow:
+			return status.Error(codes.ResourceExhausted, "the stream falls behind the pending actions")
+		case res := <-listener.events:
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// StreamBlocks streams the committed blocks to the client, catching up from the start height before following the tip
+func (api *Server) StreamBlocks(in *iotexapi.StreamBlocksRequest, stream iotexapi.APIService_StreamBlocksServer) error {
+	listener := newBlockListener()
+	if err := api.bc.AddSubscriber(listener); err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer func() {
+		listener.cancel()
+		if err := api.bc.RemoveSubscriber(listener); err != nil {
+			log.L().Error("Failed to remove block listener.", zap.Error(err))
+		}
+	}()
+
+	nextHeight := in.StartHeight
+	if nextHeight == 0 {
+		nextHeight = api.bc.TipHeight() + 1
+	}
+	// the listener is registered before catching up, so that no block is missed in between
+	if err := api.streamBlocksByHeight(stream, &nextHeight, api.bc.TipHeight()); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case blk := <-listener.pendingBlks:
+			// subscribers are notified concurrently, so blocks may arrive out of order or overlap with the
+			// catch-up: fill the gap from the chain and skip the blocks which have been sent already
+			if blk.Height() < nextHeight {
+				continue
+			}
+			if err := api.streamBlocksByHeight(stream, &nextHeight, blk.Height()-1); err != nil {
+				return err
+			}
+			if err := api.streamBlock(stream, blk); err != nil {
+				return err
+			}
+			nextHeight++
+		}
+	}
+}
+
 // Start starts the API server
 func (api *Server) Start() error {
 	portStr := ":" + strconv.Itoa(api.cfg.Port)
@@ -473,6 +968,55 @@ func (api *Server) getActions(start uint64, count uint64) (*iotexapi.GetActionsR
 	return &iotexapi.GetActionsResponse{Actions: res}, nil
 }
 
+// merkleProof returns the Merkle path of the leaf at the index
+func merkleProof(leaves []hash.Hash256, index int) ([][]byte, error) {
+	path, err := crypto.NewMerkleTree(leaves).Proof(index)
+	if err != nil {
+		return nil, err
+	}
+	proof := make([][]byte, 0, len(path))
+	for i := range path {
+		proof = append(proof, path[i][:])
+	}
+	return proof, nil
+}
+
+// getAccountAtHeight returns the confirmed account state at a given height, without the pending nonce
+func (api *Server) getAccountAtHeight(addr string, height uint64) (*iotexapi.GetAccountResponse, error) {
+	if err := api.checkStateHeight(height); err != nil {
+		return nil, err
+	}
+	state, err := api.bc.StateByAddrAtHeight(addr, height)
+	if err != nil {
+		return nil, historyStateError(err)
+	}
+	accountMeta := &iotextypes.AccountMeta{
+		Address: addr,
+		Balance: state.Balance.String(),
+		Nonce:   state.Nonce,
+	}
+	return &iotexapi.GetAccountResponse{AccountMeta: accountMeta}, nil
+}
+
+func (api *Server) checkStateHeight(height uint64) error {
+	if tipHeight := api.bc.TipHeight(); height > tipHeight {
+		return status.Errorf(codes.InvalidArgument, "height %d is higher than tip height %d", height, tipHeight)
+	}
+	return nil
+}
+
+// historyStateError tells the history state which is not kept by the node from other errors
+func historyStateError(err error) error {
+	switch errors.Cause(err) {
+	case factory.ErrNotArchiveMode:
+		return status.Error(codes.FailedPrecondition, "history state is not available as the node is not in archive mode")
+	case factory.ErrStatePruned:
+		return status.Error(codes.FailedPrecondition, "history state is not available as it has been pruned")
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
+
 // getAction returns action by action hash
 func (api *Server) getAction(actionHash string, checkPending bool) (*iotexapi.GetActionsResponse, error) {
 	actHash, err := toHash256(actionHash)
@@ -608,27 +1152,7 @@ func (api *Server) getBlockMetas(start uint64, number uint64) (*iotexapi.GetBloc
 		if err != nil {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
-		blockHeaderPb := blk.ConvertToBlockHeaderPb()
-
-		hash := blk.HashBlock()
-		txRoot := blk.TxRoot()
-		receiptRoot := blk.ReceiptRoot()
-		deltaStateDigest := blk.DeltaStateDigest()
-		transferAmount := getTranferAmountInBlock(blk)
-
-		blockMeta := &iotextypes.BlockMeta{
-			Hash:             hex.EncodeToString(hash[:]),
-			Height:           blk.Height(),
-			Timestamp:        blockHeaderPb.GetCore().GetTimestamp().GetSeconds(),
-			NumActions:       int64(len(blk.Actions)),
-			ProducerAddress:  blk.ProducerAddress(),
-			TransferAmount:   transferAmount.String(),
-			TxRoot:           hex.EncodeToString(txRoot[:]),
-			ReceiptRoot:      hex.EncodeToString(receiptRoot[:]),
-			DeltaStateDigest: hex.EncodeToString(deltaStateDigest[:]),
-		}
-
-		res = append(res, blockMeta)
+		res = append(res, toBlockMeta(blk))
 	}
 
 	return &iotexapi.GetBlockMetasResponse{BlkMetas: res}, nil
@@ -646,25 +1170,75 @@ func (api *Server) getBlockMeta(blkHash string) (*iotexapi.GetBlockMetasResponse
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
 
-	blkHeaderPb := blk.ConvertToBlockHeaderPb()
-	txRoot := blk.TxRoot()
-	receiptRoot := blk.ReceiptRoot()
-	deltaStateDigest := blk.DeltaStateDigest()
-	transferAmount := getTranferAmountInBlock(blk)
+	return &iotexapi.GetBlockMetasResponse{BlkMetas: []*iotextypes.BlockMeta{toBlockMeta(blk)}}, nil
+}
 
-	blockMeta := &iotextypes.BlockMeta{
-		Hash:             blkHash,
-		Height:           blk.Height(),
-		Timestamp:        blkHeaderPb.GetCore().GetTimestamp().GetSeconds(),
-		NumActions:       int64(len(blk.Actions)),
-		ProducerAddress:  blk.ProducerAddress(),
-		TransferAmount:   transferAmount.String(),
-		TxRoot:           hex.EncodeToString(txRoot[:]),
-		ReceiptRoot:      hex.EncodeToString(receiptRoot[:]),
-		DeltaStateDigest: hex.EncodeToString(deltaStateDigest[:]),
+// streamBlock sends a block together with its actions and receipts to the stream
+func (api *Server) streamBlock(stream iotexapi.APIService_StreamBlocksServer, blk *block.Block) error {
+	receipts := blk.Receipts
+	if receipts == nil {
+		// blocks read back from the chain don't carry their receipts
+		var err error
+		if receipts, err = api.bc.GetReceiptsByHeight(blk.Height()); err != nil {
+			return status.Error(codes.NotFound, err.Error())
+		}
+	}
+	blkInfo := &iotexapi.BlockInfo{BlkMeta: toBlockMeta(blk)}
+	for _, selp := range blk.Actions {
+		blkInfo.Actions = append(blkInfo.Actions, selp.Proto())
+	}
+	for _, receipt := range receipts {
+		blkInfo.Receipts = append(blkInfo.Receipts, receipt.ConvertToReceiptPb())
+	}
+	return stream.Send(&iotexapi.StreamBlocksResponse{Block: blkInfo})
+}
+
+// streamBlocksByHeight sends the committed blocks from the next height up to the given height to the stream
+func (api *Server) streamBlocksByHeight(
+	stream iotexapi.APIService_StreamBlocksServer,
+	nextHeight *uint64,
+	height uint64,
+) error {
+	for ; *nextHeight <= height; *nextHeight++ {
+		blk, err := api.bc.GetBlockByHeight(*nextHeight)
+		if err != nil {
+			return status.Error(codes.NotFound, err.Error())
+		}
+		if err := api.streamBlock(stream, blk); err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return &iotexapi.GetBlockMetasResponse{BlkMetas: []*iotextypes.BlockMeta{blockMeta}}, nil
+// actionType returns the type name of the action, e.g., Transfer or Execution
+func actionType(selp action.SealedEnvelope) string {
+	t := reflect.TypeOf(selp.Action())
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+func toCallFramePb(frame *evm.CallFrame) *iotexapi.CallFrame {
+	if frame == nil {
+		return nil
+	}
+	framePb := &iotexapi.CallFrame{
+		Type:    frame.Type,
+		From:    frame.From,
+		To:      frame.To,
+		Value:   frame.Value.String(),
+		Gas:     frame.Gas,
+		GasUsed: frame.GasUsed,
+		Input:   frame.Input,
+		Output:  frame.Output,
+		Error:   frame.Error,
+	}
+	for _, call := range frame.Calls {
+		framePb.Calls = append(framePb.Calls, toCallFramePb(call))
+	}
+	return framePb
 }
 
 func toHash256(hashString string) (hash.Hash256, error) {
@@ -692,6 +1266,28 @@ func getAction(bc blockchain.Blockchain, ap actpool.ActPool, actHash hash.Hash25
 	return selp.Proto(), nil
 }
 
+func toBlockMeta(blk *block.Block) *iotextypes.BlockMeta {
+	blkHeaderPb := blk.ConvertToBlockHeaderPb()
+	hash := blk.HashBlock()
+	txRoot := blk.TxRoot()
+	receiptRoot := blk.ReceiptRoot()
+	deltaStateDigest := blk.DeltaStateDigest()
+	transferAmount := getTranferAmountInBlock(blk)
+
+	return &iotextypes.BlockMeta{
+		Hash:             hex.EncodeToString(hash[:]),
+		Height:           blk.Height(),
+		Timestamp:        blkHeaderPb.GetCore().GetTimestamp().GetSeconds(),
+		NumActions:       int64(len(blk.Actions)),
+		ProducerAddress:  blk.ProducerAddress(),
+		TransferAmount:   transferAmount.String(),
+		TxRoot:           hex.EncodeToString(txRoot[:]),
+		ReceiptRoot:      hex.EncodeToString(receiptRoot[:]),
+		DeltaStateDigest: hex.EncodeToString(deltaStateDigest[:]),
+		LogsBloom:        hex.EncodeToString(blk.LogsBloom()),
+	}
+}
+
 func getTranferAmountInBlock(blk *block.Block) *big.Int {
 	totalAmount := big.NewInt(0)
 	for _, selp := range blk.Actions {
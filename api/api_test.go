@@ -22,6 +22,9 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol"
@@ -37,15 +40,19 @@ import (
 	"github.com/iotexproject/iotex-core/blockchain"
 	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/crypto"
+	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/gasstation"
+	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/unit"
 	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
 	"github.com/iotexproject/iotex-core/protogen/iotexapi"
 	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+	"github.com/iotexproject/iotex-core/state"
 	"github.com/iotexproject/iotex-core/state/factory"
 	"github.com/iotexproject/iotex-core/test/identityset"
+	"github.com/iotexproject/iotex-core/test/mock/mock_actpool"
 	"github.com/iotexproject/iotex-core/test/mock/mock_blockchain"
-	"github.com/iotexproject/iotex-core/test/mock/mock_dispatcher"
 	ta "github.com/iotexproject/iotex-core/test/testaddress"
 	"github.com/iotexproject/iotex-core/testutil"
 )
@@ -120,6 +127,52 @@ var (
 		},
 	}
 
+	getActionProofTests = []struct {
+		in     string
+		height uint64
+	}{
+		{hex.EncodeToString(transferHash1[:]), 1},
+		{hex.EncodeToString(voteHash1[:]), 2},
+		{hex.EncodeToString(executionHash2[:]), 2},
+		{hex.EncodeToString(executionHash3[:]), 4},
+	}
+
+	traceActionTests = []struct {
+		in     string
+		caller string
+	}{
+		{hex.EncodeToString(executionHash2[:]), ta.Addrinfo["charlie"].String()},
+		{hex.EncodeToString(executionHash3[:]), ta.Addrinfo["alfa"].String()},
+	}
+
+	getActPoolActionsTests = []struct {
+		sender      string
+		minGasPrice string
+		actionType  string
+		start       uint64
+		count       uint64
+		numActions  int
+		total       uint64
+	}{
+		{"", "", "", 0, 0, 4, 4},
+		{"", "", "", 1, 2, 2, 4},
+		{ta.Addrinfo["producer"].String(), "", "transfer", 0, 0, 2, 2},
+		{"", "10", "", 0, 0, 1, 1},
+		{"", "", "Execution", 0, 0, 1, 1},
+		{ta.Addrinfo["alfa"].String(), "", "", 0, 0, 0, 0},
+	}
+
+	getAccountAtHeightTests = []struct {
+		in      string
+		height  uint64
+		balance string
+		nonce   uint64
+	}{
+		{ta.Addrinfo["charlie"].String(), 1, "10", 0},
+		{ta.Addrinfo["charlie"].String(), 3, "5", 6},
+		{ta.Addrinfo["producer"].String(), 1, "9999999999999999999999999990", 1},
+	}
+
 	getActionsTests = []struct {
 		start      uint64
 		count      uint64
@@ -447,6 +500,33 @@ var (
 			numCommitteeBlockProducers: 1,
 		},
 	}
+
+	getLogsTests = []struct {
+		addresses []string
+		topics    []*iotexapi.Topics
+		fromBlock uint64
+		toBlock   uint64
+		success   bool
+		numLogs   int
+	}{
+		{nil, nil, 3, 4, true, 0},
+		{[]string{ta.Addrinfo["delta"].String()}, nil, 3, 0, true, 0},
+		{nil, []*iotexapi.Topics{{Topic: [][]byte{make([]byte, 32)}}}, 3, 4, true, 0},
+		{nil, nil, 1, 4, false, 0},
+		{nil, nil, 4, 3, false, 0},
+		{[]string{"invalid"}, nil, 3, 4, false, 0},
+		{nil, []*iotexapi.Topics{{Topic: [][]byte{{1}}}}, 3, 4, false, 0},
+	}
+
+	streamBlocksTests = []struct {
+		height      uint64
+		numActions  int
+		numReceipts int
+	}{
+		{2, 7, 7},
+		{3, 1, 1},
+		{4, 5, 5},
+	}
 )
 
 func TestServer_GetAccount(t *testing.T) {
@@ -472,6 +552,42 @@ func TestServer_GetAccount(t *testing.T) {
 	require.Error(err)
 }
 
+func TestServer_GetAccountAtHeight(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Chain.EnableArchiveMode = true
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+
+	// success
+	for _, test := range getAccountAtHeightTests {
+		request := &iotexapi.GetAccountRequest{Address: test.in, Height: test.height}
+		res, err := svr.GetAccount(context.Background(), request)
+		require.NoError(err)
+		accountMeta := res.AccountMeta
+		require.Equal(test.in, accountMeta.Address)
+		require.Equal(test.balance, accountMeta.Balance)
+		require.Equal(test.nonce, accountMeta.Nonce)
+	}
+	// failure
+	_, err = svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{
+		Address: ta.Addrinfo["charlie"].String(),
+		Height:  5,
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the history state is not available if the node is not in archive mode
+	cfg = newConfig()
+	svr, err = createServer(cfg, true)
+	require.NoError(err)
+	_, err = svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{
+		Address: ta.Addrinfo["charlie"].String(),
+		Height:  1,
+	})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
 func TestServer_GetActions(t *testing.T) {
 	require := require.New(t)
 	cfg := newConfig()
@@ -673,15 +789,15 @@ func TestServer_SendAction(t *testing.T) {
 	defer ctrl.Finish()
 
 	chain := mock_blockchain.NewMockBlockchain(ctrl)
-	mDp := mock_dispatcher.NewMockDispatcher(ctrl)
+	ap := mock_actpool.NewMockActPool(ctrl)
 	broadcastHandlerCount := 0
-	svr := Server{bc: chain, dp: mDp, broadcastHandler: func(_ context.Context, _ uint32, _ proto.Message) error {
+	svr := Server{bc: chain, ap: ap, broadcastHandler: func(_ context.Context, _ uint32, _ proto.Message) error {
 		broadcastHandlerCount++
 		return nil
 	}}
 
-	chain.EXPECT().ChainID().Return(uint32(1)).Times(4)
-	mDp.EXPECT().HandleBroadcast(gomock.Any(), gomock.Any(), gomock.Any()).Times(2)
+	chain.EXPECT().ChainID().Return(uint32(1)).Times(2)
+	ap.EXPECT().AddLocal(gomock.Any()).Return(nil).Times(2)
 
 	for i, test := range sendActionTests {
 		request := &iotexapi.SendActionRequest{Action: test.actionPb}
@@ -689,6 +805,25 @@ func TestServer_SendAction(t *testing.T) {
 		require.NoError(err)
 		require.Equal(i+1, broadcastHandlerCount)
 	}
+
+	// the action rejected by actpool is not broadcast, and the error is returned
+	ap.EXPECT().AddLocal(gomock.Any()).Return(errors.Wrap(action.ErrNonce, "nonce too large")).Times(1)
+	_, err := svr.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: testTransferPb})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	require.Contains(err.Error(), action.ErrNonce.Error())
+	ap.EXPECT().AddLocal(gomock.Any()).Return(errors.Wrap(actpool.ErrGasPriceTooLow, "gas price 0 is lower than 10")).Times(1)
+	_, err = svr.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: testTransferPb})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	ap.EXPECT().AddLocal(gomock.Any()).Return(errors.Wrap(actpool.ErrSenderRateLimited, "sender")).Times(1)
+	_, err = svr.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: testTransferPb})
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	ap.EXPECT().AddLocal(gomock.Any()).Return(errors.Wrap(action.ErrActPool, "insufficient space")).Times(1)
+	_, err = svr.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: testTransferPb})
+	require.Equal(codes.ResourceExhausted, status.Code(err))
+	require.Equal(len(sendActionTests), broadcastHandlerCount)
+
+	_, err = svr.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: &iotextypes.Action{}})
+	require.Equal(codes.InvalidArgument, status.Code(err))
 }
 
 func TestServer_GetReceiptByAction(t *testing.T) {
@@ -724,6 +859,14 @@ func TestServer_ReadContract(t *testing.T) {
 		res, err := svr.ReadContract(context.Background(), request)
 		require.NoError(err)
 		require.Equal(test.retValue, res.Data)
+
+		request.Height = svr.bc.TipHeight()
+		res, err = svr.ReadContract(context.Background(), request)
+		require.NoError(err)
+		require.Equal(test.retValue, res.Data)
+		request.Height = 1
+		_, err = svr.ReadContract(context.Background(), request)
+		require.Equal(codes.FailedPrecondition, status.Code(err))
 	}
 }
 
@@ -870,6 +1013,426 @@ func TestServer_ReadCommitteeBlockProducersByHeight(t *testing.T) {
 	}
 }
 
+func TestServer_GetLogs(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	svr.cfg.RangeQueryLimit = 2
+
+	for _, test := range getLogsTests {
+		request := &iotexapi.GetLogsRequest{
+			Filter: &iotexapi.LogsFilter{
+				Address: test.addresses,
+				Topics:  test.topics,
+			},
+			FromBlock: test.fromBlock,
+			ToBlock:   test.toBlock,
+		}
+		res, err := svr.GetLogs(context.Background(), request)
+		if !test.success {
+			require.Error(err)
+			continue
+		}
+		require.NoError(err)
+		require.Equal(test.numLogs, len(res.Logs))
+	}
+}
+
+func TestServer_GetAccountProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	addr := ta.Addrinfo["charlie"]
+	res, err := svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{Address: addr.String()})
+	require.NoError(err)
+	require.Equal(svr.bc.GetFactory().RootHash(), hash.BytesToHash256(res.StateRoot))
+	addrHash := hash.BytesToHash160(addr.Bytes())
+	data, err := trie.VerifyProof(res.StateRoot, addrHash[:], res.AccountProof)
+	require.NoError(err)
+	require.Equal(res.Account, data)
+	var account state.Account
+	require.NoError(account.Deserialize(data))
+	require.Equal("3", account.Balance.String())
+
+	// failure
+	_, err = svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{Address: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{
+		Address: ta.Addrinfo["echo"].String(),
+	})
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = svr.GetAccountProof(context.Background(), &iotexapi.GetAccountProofRequest{
+		Address:     addr.String(),
+		StorageKeys: [][]byte{hash.ZeroHash256[:]},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetActionProof(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	for _, test := range getActionProofTests {
+		res, err := svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{ActionHash: test.in})
+		require.NoError(err)
+		core := res.BlockHeader.Core
+		require.Equal(test.height, core.Height)
+		actHash, err := toHash256(test.in)
+		require.NoError(err)
+		// the order of the actions from different senders in a block is not deterministic
+		blk, err := svr.bc.GetBlockByHeight(test.height)
+		require.NoError(err)
+		require.Equal(actHash, blk.Actions[res.Index].Hash())
+		require.True(crypto.VerifyMerkleProof(
+			hash.BytesToHash256(core.TxRoot), actHash, int(res.Index), toHashes(res.ActionProof)))
+		data, err := proto.Marshal(res.Receipt)
+		require.NoError(err)
+		require.True(crypto.VerifyMerkleProof(
+			hash.BytesToHash256(core.ReceiptRoot), hash.Hash256b(data), int(res.Index), toHashes(res.ReceiptProof)))
+	}
+	// failure
+	_, err = svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{ActionHash: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.GetActionProof(context.Background(), &iotexapi.GetActionProofRequest{
+		ActionHash: hex.EncodeToString(hash.ZeroHash256[:]),
+	})
+	require.Equal(codes.NotFound, status.Code(err))
+}
+
+func TestServer_GetActPoolStatus(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+
+	res, err := svr.GetActPoolStatus(context.Background(), &iotexapi.GetActPoolStatusRequest{})
+	require.NoError(err)
+	require.Equal(uint64(4), res.Pending)
+	require.Equal(uint64(0), res.Queued)
+	require.Equal(cfg.ActPool.MaxNumActsPerPool, res.Capacity)
+	require.Equal(uint64(1), res.Accounts)
+}
+
+func TestServer_GetActPoolContent(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+
+	res, err := svr.GetActPoolContent(context.Background(), &iotexapi.GetActPoolContentRequest{})
+	require.NoError(err)
+	require.Equal(1, len(res.Accounts))
+	acct := res.Accounts[0]
+	require.Equal(ta.Addrinfo["producer"].String(), acct.Address)
+	require.Equal(uint64(6), acct.PendingNonce)
+	require.Equal(4, len(acct.Pending))
+	for i, selp := range acct.Pending {
+		require.Equal(uint64(i+2), selp.Core.Nonce)
+	}
+	require.Equal(0, len(acct.Queued))
+	require.Equal(0, len(acct.Gaps))
+
+	res, err = svr.GetActPoolContent(context.Background(), &iotexapi.GetActPoolContentRequest{
+		Address: ta.Addrinfo["alfa"].String(),
+	})
+	require.NoError(err)
+	require.Equal(0, len(res.Accounts))
+
+	_, err = svr.GetActPoolContent(context.Background(), &iotexapi.GetActPoolContentRequest{Address: "invalid"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_GetActPoolActions(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+
+	for _, test := range getActPoolActionsTests {
+		res, err := svr.GetActPoolActions(context.Background(), &iotexapi.GetActPoolActionsRequest{
+			SenderAddress: test.sender,
+			MinGasPrice:   test.minGasPrice,
+			ActionType:    test.actionType,
+			Start:         test.start,
+			Count:         test.count,
+		})
+		require.NoError(err)
+		require.Equal(test.numActions, len(res.Actions))
+		require.Equal(test.total, res.Total)
+	}
+
+	_, err = svr.GetActPoolActions(context.Background(), &iotexapi.GetActPoolActionsRequest{MinGasPrice: "abc"})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+}
+
+func TestServer_TraceAction(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Chain.EnableArchiveMode = true
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+
+	for _, test := range traceActionTests {
+		res, err := svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+			Lookup: &iotexapi.TraceActionRequest_ActionHash{ActionHash: test.in},
+		})
+		require.NoError(err)
+		receipt, err := svr.GetReceiptByAction(context.Background(), &iotexapi.GetReceiptByActionRequest{
+			ActionHash: test.in,
+		})
+		require.NoError(err)
+		require.Equal(receipt.Receipt.ActHash, res.Receipt.ActHash)
+		require.Equal(receipt.Receipt.GasConsumed, res.Receipt.GasConsumed)
+		require.Equal("CALL", res.Call.Type)
+		require.Equal(test.caller, res.Call.From)
+		require.Equal(ta.Addrinfo["delta"].String(), res.Call.To)
+		require.Empty(res.Call.Error)
+		// delta has no code
+		require.Equal(0, len(res.Opcodes))
+		require.Nil(res.RevertData)
+	}
+
+	// trace an unsigned execution on top of the tip state
+	res, err := svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		Lookup: &iotexapi.TraceActionRequest_Execution{Execution: &iotexapi.TraceExecutionRequest{
+			Execution: &iotextypes.Execution{
+				Amount:   "1",
+				Contract: ta.Addrinfo["delta"].String(),
+				Data:     []byte{1},
+			},
+			CallerAddress: ta.Addrinfo["charlie"].String(),
+			GasLimit:      testutil.TestGasLimit,
+		}},
+	})
+	require.NoError(err)
+	require.Equal(uint64(action.SuccessReceiptStatus), res.Receipt.Status)
+	require.Equal(ta.Addrinfo["charlie"].String(), res.Call.From)
+	require.Equal("1", res.Call.Value)
+	// the trace does not change the tip state
+	account, err := svr.GetAccount(context.Background(), &iotexapi.GetAccountRequest{
+		Address: ta.Addrinfo["charlie"].String(),
+	})
+	require.NoError(err)
+	require.Equal("3", account.AccountMeta.Balance)
+
+	// the opcode steps are truncated by the limit, and the stack and the memory are left out unless enabled
+	// the code returns 32 bytes of memory holding 1 + 1 in 8 steps
+	code := []byte{0x60, 0x01, 0x60, 0x01, 0x01, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+	traceCreation := func(limit uint32, enable bool) *iotexapi.TraceActionResponse {
+		res, err := svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+			Lookup: &iotexapi.TraceActionRequest_Execution{Execution: &iotexapi.TraceExecutionRequest{
+				Execution:     &iotextypes.Execution{Data: code},
+				CallerAddress: ta.Addrinfo["charlie"].String(),
+				GasLimit:      testutil.TestGasLimit,
+			}},
+			EnableStack:  enable,
+			EnableMemory: enable,
+			Limit:        limit,
+		})
+		require.NoError(err)
+		return res
+	}
+	res = traceCreation(0, false)
+	require.Equal(8, len(res.Opcodes))
+	require.False(res.Truncated)
+	for _, step := range res.Opcodes {
+		require.Empty(step.Stack)
+		require.Empty(step.Memory)
+	}
+	res = traceCreation(3, true)
+	require.Equal(3, len(res.Opcodes))
+	require.True(res.Truncated)
+	require.Equal("ADD", res.Opcodes[2].Op)
+	require.Equal(2, len(res.Opcodes[2].Stack))
+
+	// failure
+	_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		Lookup: &iotexapi.TraceActionRequest_ActionHash{ActionHash: hex.EncodeToString(transferHash1[:])},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+	_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		Lookup: &iotexapi.TraceActionRequest_ActionHash{ActionHash: hex.EncodeToString(executionHash1[:])},
+	})
+	require.Equal(codes.NotFound, status.Code(err))
+	_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		Lookup: &iotexapi.TraceActionRequest_Execution{Execution: &iotexapi.TraceExecutionRequest{
+			Execution:     &iotextypes.Execution{Contract: ta.Addrinfo["delta"].String()},
+			CallerAddress: "invalid",
+		}},
+	})
+	require.Equal(codes.InvalidArgument, status.Code(err))
+
+	// the state of the parent block is not available if the node is not in archive mode
+	svr, err = createServer(newConfig(), false)
+	require.NoError(err)
+	_, err = svr.TraceAction(context.Background(), &iotexapi.TraceActionRequest{
+		Lookup: &iotexapi.TraceActionRequest_ActionHash{ActionHash: hex.EncodeToString(executionHash3[:])},
+	})
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+}
+
+func TestServer_StreamBlocks(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testBlockStream{ctx: ctx, blks: make(chan *iotexapi.BlockInfo, 10)}
+	errChan := make(chan error)
+	go func() {
+		errChan <- svr.StreamBlocks(&iotexapi.StreamBlocksRequest{StartHeight: 2}, stream)
+	}()
+
+	// catch up on the history
+	for _, test := range streamBlocksTests {
+		blkInfo := <-stream.blks
+		require.Equal(test.height, blkInfo.BlkMeta.Height)
+		require.Equal(test.numActions, len(blkInfo.Actions))
+		require.Equal(test.numReceipts, len(blkInfo.Receipts))
+	}
+
+	// follow the tip
+	blk, err := svr.bc.MintNewBlock(nil, time.Now().Unix())
+	require.NoError(err)
+	require.NoError(svr.bc.ValidateBlock(blk))
+	require.NoError(svr.bc.CommitBlock(blk))
+	select {
+	case blkInfo := <-stream.blks:
+		require.Equal(uint64(5), blkInfo.BlkMeta.Height)
+		require.Equal(1, len(blkInfo.Receipts))
+	case <-time.After(5 * time.Second):
+		require.FailNow("timeout waiting for the new block")
+	}
+
+	cancel()
+	require.NoError(<-errChan)
+}
+
+func TestServer_StreamPendingActions(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ap := mock_actpool.NewMockActPool(ctrl)
+	svr := Server{ap: ap}
+	subChan := make(chan actpool.Subscriber, 1)
+	ap.EXPECT().AddSubscriber(gomock.Any()).DoAndReturn(func(s actpool.Subscriber) error {
+		subChan <- s
+		return nil
+	}).Times(1)
+	ap.EXPECT().RemoveSubscriber(gomock.Any()).Return(nil).Times(1)
+
+	_, err := newActionListener(&iotexapi.StreamPendingActionsRequest{SenderAddresses: []string{"invalid"}})
+	require.Error(err)
+	require.Equal(codes.InvalidArgument, status.Code(svr.StreamPendingActions(
+		&iotexapi.StreamPendingActionsRequest{SenderAddresses: []string{"invalid"}},
+		&testActionStream{ctx: context.Background()},
+	)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &testActionStream{ctx: ctx, events: make(chan *iotexapi.StreamPendingActionsResponse, 10)}
+	errChan := make(chan error)
+	go func() {
+		errChan <- svr.StreamPendingActions(&iotexapi.StreamPendingActionsRequest{
+			SenderAddresses:    []string{ta.Addrinfo["producer"].String()},
+			RecipientAddresses: []string{ta.Addrinfo["charlie"].String()},
+		}, stream)
+	}()
+	sub := <-subChan
+
+	// only the transfer from producer to charlie matches the filter
+	sub.HandleAcceptedAction(testTransfer1)
+	sub.HandleAcceptedAction(testVote1)
+	sub.HandleAcceptedAction(testTransfer)
+	sub.HandleAcceptedAction(testExecution1)
+	sub.HandleDroppedAction(testTransfer1, errors.Wrap(actpool.ErrEvicted, "evicted"))
+	sub.HandleDroppedAction(testTransfer1, actpool.ErrTimedOut)
+	sub.HandleDroppedAction(testTransfer1, action.ErrExpired)
+	sub.HandleDroppedAction(testTransfer1, action.ErrBalance)
+	for _, test := range []struct {
+		event  iotexapi.PendingActionEvent
+		reason bool
+	}{
+		{iotexapi.PendingActionEvent_PendingActionAccepted, false},
+		{iotexapi.PendingActionEvent_PendingActionEvicted, true},
+		{iotexapi.PendingActionEvent_PendingActionExpired, true},
+		{iotexapi.PendingActionEvent_PendingActionExpired, true},
+		{iotexapi.PendingActionEvent_PendingActionInvalidated, true},
+	} {
+		res := <-stream.events
+		require.Equal(test.event, res.Event)
+		require.Equal(test.reason, res.Reason != "")
+		h := testTransfer1.Hash()
+		require.Equal(hex.EncodeToString(h[:]), res.ActionHash)
+	}
+
+	// the stream is closed once it falls behind
+	for i := 0; i < actionListenerBufferSize+len(stream.events)+2; i++ {
+		sub.HandleAcceptedAction(testTransfer1)
+	}
+	go func() {
+		for range stream.events {
+		}
+	}()
+	require.Equal(codes.ResourceExhausted, status.Code(<-errChan))
+	cancel()
+}
+
+func TestActionListener_Match(t *testing.T) {
+	require := require.New(t)
+
+	charlie := ta.Addrinfo["charlie"].String()
+	delta := ta.Addrinfo["delta"].String()
+	exec, err := testutil.SignedExecution(delta, ta.Keyinfo["producer"].PriKey, 2, big.NewInt(1),
+		testutil.TestGasLimit, big.NewInt(testutil.TestGasPrice), []byte{1})
+	require.NoError(err)
+	bundle, err := testutil.SignedBundle(ta.Keyinfo["producer"].PriKey,
+		[]action.Envelope{testTransfer1.Envelope, exec.Envelope})
+	require.NoError(err)
+	multiTransfer, err := testutil.SignedMultiTransfer(ta.Keyinfo["producer"].PriKey, 3, []action.TransferEntry{
+		{Recipient: ta.Addrinfo["alfa"].String(), Amount: big.NewInt(1)},
+		{Recipient: charlie, Amount: big.NewInt(1)},
+	}, testutil.TestGasLimit, big.NewInt(testutil.TestGasPrice))
+	require.NoError(err)
+
+	recipients := &iotexapi.StreamPendingActionsRequest{RecipientAddresses: []string{charlie}}
+	contracts := &iotexapi.StreamPendingActionsRequest{ContractAddresses: []string{delta}}
+	for _, test := range []struct {
+		in    *iotexapi.StreamPendingActionsRequest
+		act   action.SealedEnvelope
+		match bool
+	}{
+		{recipients, testTransfer1, true},
+		{recipients, testExecution1, false},
+		{recipients, bundle, true},
+		{recipients, multiTransfer, true},
+		{contracts, testExecution1, true},
+		{contracts, testTransfer1, false},
+		{contracts, bundle, true},
+		{contracts, multiTransfer, false},
+	} {
+		listener, err := newActionListener(test.in)
+		require.NoError(err)
+		require.Equal(test.match, listener.match(test.act))
+	}
+}
+
 func addProducerToFactory(sf factory.Factory) error {
 	ws, err := sf.NewWorkingSet()
 	if err != nil {
@@ -1178,3 +1741,37 @@ func createServer(cfg config.Config, needActPool bool) (*Server, error) {
 
 	return svr, nil
 }
+
+type testBlockStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	blks chan *iotexapi.BlockInfo
+}
+
+func (s *testBlockStream) Context() context.Context { return s.ctx }
+
+func (s *testBlockStream) Send(res *iotexapi.StreamBlocksResponse) error {
+	s.blks <- res.Block
+	return nil
+}
+
+type testActionStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	events chan *iotexapi.StreamPendingActionsResponse
+}
+
+func (s *testActionStream) Context() context.Context { return s.ctx }
+
+func (s *testActionStream) Send(res *iotexapi.StreamPendingActionsResponse) error {
+	s.events <- res
+	return nil
+}
+
+func toHashes(proof [][]byte) []hash.Hash256 {
+	hashes := make([]hash.Hash256, 0, len(proof))
+	for _, h := range proof {
+		hashes = append(hashes, hash.BytesToHash256(h))
+	}
+	return hashes
+}
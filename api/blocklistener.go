@@ -0,0 +1,40 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"github.com/iotexproject/iotex-core/blockchain/block"
+)
+
+const blockListenerBufferSize = 64
+
+// blockListener implements blockchain.BlockCreationSubscriber and hands the committed blocks over to a stream
+type blockListener struct {
+	pendingBlks chan *block.Block
+	cancelChan  chan struct{}
+}
+
+func newBlockListener() *blockListener {
+	return &blockListener{
+		pendingBlks: make(chan *block.Block, blockListenerBufferSize),
+		cancelChan:  make(chan struct{}),
+	}
+}
+
+// HandleBlock implements interface BlockCreationSubscriber
+func (bl *blockListener) HandleBlock(blk *block.Block) error {
+	select {
+	case bl.pendingBlks <- blk:
+	case <-bl.cancelChan:
+	}
+	return nil
+}
+
+// cancel releases the blocks that are still waiting to be handed over
+func (bl *blockListener) cancel() {
+	close(bl.cancelChan)
+}
@@ -0,0 +1,507 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"math/big"
+	"net"
+	"net/http"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/protogen/iotexapi"
+	"github.com/iotexproject/iotex-core/protogen/iotextypes"
+)
+
+// Web3Server serves the API in Ethereum JSON-RPC, so that the web3 tools could talk to the node
+type Web3Server struct {
+	port       int
+	rpcServer  *rpc.Server
+	httpServer *http.Server
+	// serveErr keeps the error failing the server after it started, which is returned on stop
+	serveErr chan error
+}
+
+// NewWeb3Server creates a new web3 server on top of the API server
+func NewWeb3Server(cfg config.API, g genesis.Genesis, svr *Server) (*Web3Server, error) {
+	if svr == nil {
+		return nil, errors.New("web3 server requires an API server")
+	}
+	rpcServer := rpc.NewServer()
+	if err := rpcServer.RegisterName("eth", newWeb3Service(svr, g)); err != nil {
+		return nil, errors.Wrap(err, "failed to register eth service")
+	}
+	return &Web3Server{
+		port:       cfg.Web3Port,
+		rpcServer:  rpcServer,
+		httpServer: &http.Server{Handler: rpcServer},
+		serveErr:   make(chan error, 1),
+	}, nil
+}
+
+// Start starts the web3 server
+func (s *Web3Server) Start() error {
+	portStr := ":" + strconv.Itoa(s.port)
+	lis, err := net.Listen("tcp", portStr)
+	if err != nil {
+		log.L().Error("Web3 server failed to listen.", zap.Error(err))
+		return errors.Wrap(err, "web3 server failed to listen")
+	}
+	log.L().Info("Web3 server is listening.", zap.String("addr", lis.Addr().String()))
+
+	go func() {
+		if err := s.httpServer.Serve(lis); err != nil && err != http.ErrServerClosed {
+			log.L().Error("Web3 server failed to serve.", zap.Error(err))
+			s.serveErr <- err
+		}
+	}()
+	return nil
+}
+
+// Stop stops the web3 server
+func (s *Web3Server) Stop() error {
+	s.rpcServer.Stop()
+	if err := s.httpServer.Close(); err != nil {
+		return errors.Wrap(err, "failed to close web3 server")
+	}
+	log.L().Info("Web3 server stops.")
+	select {
+	case err := <-s.serveErr:
+		return errors.Wrap(err, "web3 server failed to serve")
+	default:
+		return nil
+	}
+}
+
+// Web3Service implements the eth namespace of Ethereum JSON-RPC by translating the calls onto the API server. The
+// io1 addresses are exposed as 0x addresses of the same bytes, and the action hashes are exposed as transaction hashes.
+type Web3Service struct {
+	svr           *Server
+	blockGasLimit uint64
+	callGasLimit  uint64
+}
+
+func newWeb3Service(svr *Server, g genesis.Genesis) *Web3Service {
+	return &Web3Service{
+		svr:           svr,
+		blockGasLimit: g.BlockGasLimit,
+		callGasLimit:  g.ActionGasLimit,
+	}
+}
+
+// ChainId serves eth_chainId
+func (s *Web3Service) ChainId() hexutil.Uint64 {
+	return hexutil.Uint64(s.svr.bc.ChainID())
+}
+
+// BlockNumber serves eth_blockNumber
+func (s *Web3Service) BlockNumber() hexutil.Uint64 {
+	return hexutil.Uint64(s.svr.bc.TipHeight())
+}
+
+// GasPrice serves eth_gasPrice
+func (s *Web3Service) GasPrice() (*hexutil.Big, error) {
+	gasPrice, err := s.svr.gs.SuggestGasPrice()
+	if err != nil {
+		return nil, err
+	}
+	return (*hexutil.Big)(new(big.Int).SetUint64(gasPrice)), nil
+}
+
+// GetBalance serves eth_getBalance
+func (s *Web3Service) GetBalance(ctx context.Context, addr common.Address, blockNr rpc.BlockNumber) (*hexutil.Big, error) {
+	accountMeta, err := s.getAccount(ctx, addr, blockNr)
+	if err != nil {
+		return nil, err
+	}
+	balance, ok := new(big.Int).SetString(accountMeta.Balance, 10)
+	if !ok {
+		return nil, errors.Errorf("invalid balance %s", accountMeta.Balance)
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+// GetTransactionCount serves eth_getTransactionCount. The nonces of actions start from 1, so the nonce of the last
+// action is the number of actions sent from the address.
+func (s *Web3Service) GetTransactionCount(
+	ctx context.Context,
+	addr common.Address,
+	blockNr rpc.BlockNumber,
+) (hexutil.Uint64, error) {
+	accountMeta, err := s.getAccount(ctx, addr, blockNr)
+	if err != nil {
+		return 0, err
+	}
+	if blockNr == rpc.PendingBlockNumber && accountMeta.PendingNonce > 0 {
+		return hexutil.Uint64(accountMeta.PendingNonce - 1), nil
+	}
+	return hexutil.Uint64(accountMeta.Nonce), nil
+}
+
+// Call serves eth_call
+func (s *Web3Service) Call(args Web3CallArgs, blockNr rpc.BlockNumber) (hexutil.Bytes, error) {
+	height, err := s.stateHeight(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	caller, sc, err := s.toExecution(args)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := s.svr.bc.ExecuteContractReadAtHeight(caller, sc, height)
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != action.SuccessReceiptStatus {
+		return nil, errors.New("execution reverted")
+	}
+	return receipt.ReturnValue, nil
+}
+
+// EstimateGas serves eth_estimateGas
+func (s *Web3Service) EstimateGas(args Web3CallArgs) (hexutil.Uint64, error) {
+	if args.To != nil && (args.Data == nil || len(*args.Data) == 0) {
+		to, err := fromWeb3Address(*args.To)
+		if err != nil {
+			return 0, err
+		}
+		if account, err := s.svr.bc.StateByAddr(to.String()); err != nil || len(account.CodeHash) == 0 {
+			// a plain transfer to an account without code only costs the intrinsic gas
+			tsf, err := action.NewTransfer(0, big.NewInt(0), to.String(), nil, 0, big.NewInt(0))
+			if err != nil {
+				return 0, err
+			}
+			gas, err := tsf.IntrinsicGas()
+			return hexutil.Uint64(gas), err
+		}
+	}
+	caller, sc, err := s.toExecution(args)
+	if err != nil {
+		return 0, err
+	}
+	gas, err := s.svr.gs.EstimateGasForExecution(caller, sc)
+	return hexutil.Uint64(gas), err
+}
+
+// SendRawTransaction serves eth_sendRawTransaction. The signed transaction is turned into the action it stands for,
+// which is sent the same way as through the SendAction API, and its hash is the hash of the action.
+func (s *Web3Service) SendRawTransaction(ctx context.Context, data hexutil.Bytes) (common.Hash, error) {
+	selp, err := action.DecodeEthTransaction(data, s.svr.bc.ChainID())
+	if err != nil {
+		return common.Hash{}, err
+	}
+	if err := action.Verify(selp); err != nil {
+		return common.Hash{}, err
+	}
+	if _, err := s.svr.SendAction(ctx, &iotexapi.SendActionRequest{Action: selp.Proto()}); err != nil {
+		return common.Hash{}, err
+	}
+	h := selp.Hash()
+	return common.BytesToHash(h[:]), nil
+}
+
+// GetTransactionReceipt serves eth_getTransactionReceipt, which returns null for an unknown or pending transaction
+func (s *Web3Service) GetTransactionReceipt(txHash common.Hash) (*Web3Receipt, error) {
+	actHash := hash.BytesToHash256(txHash.Bytes())
+	blkHash, err := s.svr.bc.GetBlockHashByActionHash(actHash)
+	if err != nil {
+		if errors.Cause(err) == db.ErrNotExist {
+			return nil, nil
+		}
+		return nil, err
+	}
+	blk, err := s.svr.bc.GetBlockByHash(blkHash)
+	if err != nil {
+		return nil, err
+	}
+	index := actionIndex(blk, actHash)
+	if index < 0 {
+		return nil, errors.Errorf("action %x is missing in block %x", actHash, blkHash)
+	}
+	tx, err := toWeb3Transaction(blk.Actions[index], blkHash, blk.Height(), index)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := s.svr.bc.GetReceiptByActionHash(actHash)
+	if err != nil {
+		return nil, err
+	}
+	// the gas used in the block up to the action is consumed by the receipts up to the action's one
+	receipts, err := s.svr.bc.GetReceiptsByHeight(blk.Height())
+	if err != nil {
+		return nil, err
+	}
+	var cumulativeGas uint64
+	for _, r := range receipts {
+		cumulativeGas += r.GasConsumed
+		if r.ActHash == actHash {
+			break
+		}
+	}
+
+	res := &Web3Receipt{
+		TransactionHash:   txHash,
+		TransactionIndex:  hexutil.Uint64(index),
+		BlockHash:         toWeb3Hash(blkHash),
+		BlockNumber:       hexutil.Uint64(blk.Height()),
+		From:              tx.From,
+		To:                tx.To,
+		CumulativeGasUsed: hexutil.Uint64(cumulativeGas),
+		GasUsed:           hexutil.Uint64(receipt.GasConsumed),
+		Logs:              []*Web3Log{},
+		LogsBloom:         toWeb3Bloom(block.CalculateLogsBloom([]*action.Receipt{receipt})),
+		Status:            hexutil.Uint64(receipt.Status),
+	}
+	if receipt.ContractAddress != action.EmptyAddress {
+		contract, err := toWeb3Address(receipt.ContractAddress)
+		if err != nil {
+			return nil, err
+		}
+		res.ContractAddress = &contract
+	}
+	for _, l := range receipt.Logs {
+		web3Log, err := toWeb3Log(l, blkHash, index)
+		if err != nil {
+			return nil, err
+		}
+		res.Logs = append(res.Logs, web3Log)
+	}
+	return res, nil
+}
+
+// GetBlockByNumber serves eth_getBlockByNumber, which returns null for a block beyond the tip
+func (s *Web3Service) GetBlockByNumber(blockNr rpc.BlockNumber, fullTx bool) (*Web3Block, error) {
+	height := s.toHeight(blockNr)
+	if height > s.svr.bc.TipHeight() {
+		return nil, nil
+	}
+	blk, err := s.svr.bc.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	receipts, err := s.svr.bc.GetReceiptsByHeight(height)
+	if err != nil && errors.Cause(err) != db.ErrNotExist {
+		return nil, err
+	}
+	var gasUsed uint64
+	for _, r := range receipts {
+		gasUsed += r.GasConsumed
+	}
+	miner, err := toWeb3Address(blk.ProducerAddress())
+	if err != nil {
+		return nil, err
+	}
+	blkBytes, err := blk.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	blkHash := blk.HashBlock()
+	txRoot := blk.TxRoot()
+	deltaStateDigest := blk.DeltaStateDigest()
+	receiptRoot := blk.ReceiptRoot()
+
+	web3Blk := &Web3Block{
+		Number:           hexutil.Uint64(height),
+		Hash:             toWeb3Hash(blkHash),
+		ParentHash:       toWeb3Hash(blk.PrevHash()),
+		Nonce:            make([]byte, 8),
+		LogsBloom:        toWeb3Bloom(blk.LogsBloom()),
+		TransactionsRoot: toWeb3Hash(txRoot),
+		StateRoot:        toWeb3Hash(deltaStateDigest),
+		ReceiptsRoot:     toWeb3Hash(receiptRoot),
+		Miner:            miner,
+		Difficulty:       (*hexutil.Big)(big.NewInt(0)),
+		TotalDifficulty:  (*hexutil.Big)(big.NewInt(0)),
+		ExtraData:        []byte{},
+		Size:             hexutil.Uint64(len(blkBytes)),
+		GasLimit:         hexutil.Uint64(s.blockGasLimit),
+		GasUsed:          hexutil.Uint64(gasUsed),
+		Timestamp:        hexutil.Uint64(blk.Timestamp()),
+		Transactions:     make([]interface{}, 0, len(blk.Actions)),
+		Uncles:           []common.Hash{},
+	}
+	for i, selp := range blk.Actions {
+		if !fullTx {
+			web3Blk.Transactions = append(web3Blk.Transactions, toWeb3Hash(selp.Hash()))
+			continue
+		}
+		tx, err := toWeb3Transaction(selp, blkHash, height, i)
+		if err != nil {
+			return nil, err
+		}
+		web3Blk.Transactions = append(web3Blk.Transactions, tx)
+	}
+	return web3Blk, nil
+}
+
+// GetLogs serves eth_getLogs
+func (s *Web3Service) GetLogs(ctx context.Context, query Web3FilterQuery) ([]*Web3Log, error) {
+	req := &iotexapi.GetLogsRequest{Filter: &iotexapi.LogsFilter{}}
+	if query.BlockHash != nil {
+		height, err := s.svr.bc.GetHeightByHash(hash.BytesToHash256(query.BlockHash.Bytes()))
+		if err != nil {
+			return nil, err
+		}
+		req.FromBlock, req.ToBlock = height, height
+	} else {
+		req.FromBlock, req.ToBlock = s.svr.bc.TipHeight(), s.svr.bc.TipHeight()
+		if query.FromBlock != nil {
+			req.FromBlock = s.toHeight(*query.FromBlock)
+		}
+		if query.ToBlock != nil {
+			req.ToBlock = s.toHeight(*query.ToBlock)
+		}
+		if req.FromBlock == 0 {
+			req.FromBlock = 1
+		}
+		if req.ToBlock == 0 {
+			return []*Web3Log{}, nil
+		}
+	}
+	for _, addr := range query.Addresses {
+		ioAddr, err := fromWeb3Address(addr)
+		if err != nil {
+			return nil, err
+		}
+		req.Filter.Address = append(req.Filter.Address, ioAddr.String())
+	}
+	for _, topics := range query.Topics {
+		topicsPb := &iotexapi.Topics{}
+		for _, topic := range topics {
+			topicsPb.Topic = append(topicsPb.Topic, topic.Bytes())
+		}
+		req.Filter.Topics = append(req.Filter.Topics, topicsPb)
+	}
+	res, err := s.svr.GetLogs(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]*Web3Log, 0, len(res.Logs))
+	blks := make(map[uint64]*block.Block)
+	for _, logPb := range res.Logs {
+		l := &action.Log{}
+		l.ConvertFromLogPb(logPb)
+		blk, ok := blks[l.BlockNumber]
+		if !ok {
+			if blk, err = s.svr.bc.GetBlockByHeight(l.BlockNumber); err != nil {
+				return nil, err
+			}
+			blks[l.BlockNumber] = blk
+		}
+		web3Log, err := toWeb3Log(l, blk.HashBlock(), actionIndex(blk, l.TxnHash))
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, web3Log)
+	}
+	return logs, nil
+}
+
+func (s *Web3Service) getAccount(
+	ctx context.Context,
+	addr common.Address,
+	blockNr rpc.BlockNumber,
+) (*iotextypes.AccountMeta, error) {
+	height, err := s.stateHeight(blockNr)
+	if err != nil {
+		return nil, err
+	}
+	ioAddr, err := fromWeb3Address(addr)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.svr.GetAccount(ctx, &iotexapi.GetAccountRequest{Address: ioAddr.String(), Height: height})
+	if err != nil {
+		return nil, err
+	}
+	return res.AccountMeta, nil
+}
+
+// stateHeight converts the block number into the height of the state, which cannot be higher than the tip
+func (s *Web3Service) stateHeight(blockNr rpc.BlockNumber) (uint64, error) {
+	height, tipHeight := s.toHeight(blockNr), s.svr.bc.TipHeight()
+	if height > tipHeight {
+		return 0, errors.Errorf("block number %d is higher than the latest block %d", height, tipHeight)
+	}
+	return height, nil
+}
+
+// toHeight converts the block number into a height, where both the latest and the pending block are at the tip
+func (s *Web3Service) toHeight(blockNr rpc.BlockNumber) uint64 {
+	if blockNr == rpc.LatestBlockNumber || blockNr == rpc.PendingBlockNumber {
+		return s.svr.bc.TipHeight()
+	}
+	return uint64(blockNr.Int64())
+}
+
+// toExecution converts the message call into an execution run by the caller
+func (s *Web3Service) toExecution(args Web3CallArgs) (address.Address, *action.Execution, error) {
+	var caller address.Address
+	var err error
+	if args.From != nil {
+		caller, err = fromWeb3Address(*args.From)
+	} else {
+		caller, err = fromWeb3Address(common.Address{})
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	contract := action.EmptyAddress
+	if args.To != nil {
+		to, err := fromWeb3Address(*args.To)
+		if err != nil {
+			return nil, nil, err
+		}
+		contract = to.String()
+	}
+	gasLimit := s.callGasLimit
+	if args.Gas != nil {
+		gasLimit = uint64(*args.Gas)
+	}
+	amount := big.NewInt(0)
+	if args.Value != nil {
+		amount = args.Value.ToInt()
+	}
+	gasPrice := big.NewInt(0)
+	if args.GasPrice != nil {
+		gasPrice = args.GasPrice.ToInt()
+	}
+	var data []byte
+	if args.Data != nil {
+		data = *args.Data
+	}
+	sc, err := action.NewExecution(contract, 0, amount, gasLimit, gasPrice, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return caller, sc, nil
+}
+
+// actionIndex returns the index of the action in the block, or -1 if the block does not have the action
+func actionIndex(blk *block.Block, actHash hash.Hash256) int {
+	for i, selp := range blk.Actions {
+		if selp.Hash() == actHash {
+			return i
+		}
+	}
+	return -1
+}
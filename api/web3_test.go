@@ -0,0 +1,187 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestWeb3Address(t *testing.T) {
+	require := require.New(t)
+
+	ioAddr := ta.Addrinfo["alfa"]
+	web3Addr, err := toWeb3Address(ioAddr.String())
+	require.NoError(err)
+	require.Equal(ioAddr.Bytes(), web3Addr.Bytes())
+	addr, err := fromWeb3Address(web3Addr)
+	require.NoError(err)
+	require.Equal(ioAddr.String(), addr.String())
+
+	_, err = toWeb3Address("invalid")
+	require.Error(err)
+}
+
+func TestWeb3Service(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+	cfg.Genesis.Web3Height = 0
+
+	svr, err := createServer(cfg, true)
+	require.NoError(err)
+	s := newWeb3Service(svr, cfg.Genesis)
+	ctx := context.Background()
+
+	require.Equal(hexutil.Uint64(cfg.Chain.ID), s.ChainId())
+	require.Equal(hexutil.Uint64(4), s.BlockNumber())
+
+	charlie := common.BytesToAddress(ta.Addrinfo["charlie"].Bytes())
+	balance, err := s.GetBalance(ctx, charlie, rpc.LatestBlockNumber)
+	require.NoError(err)
+	expectedBalance, err := svr.bc.Balance(ta.Addrinfo["charlie"].String())
+	require.NoError(err)
+	require.Equal(expectedBalance, balance.ToInt())
+	count, err := s.GetTransactionCount(ctx, charlie, rpc.LatestBlockNumber)
+	require.NoError(err)
+	require.Equal(hexutil.Uint64(8), count)
+	// the history state is not kept as the node is not in archive mode
+	_, err = s.GetTransactionCount(ctx, charlie, rpc.BlockNumber(1))
+	require.Equal(codes.FailedPrecondition, status.Code(err))
+	_, err = s.GetTransactionCount(ctx, charlie, rpc.BlockNumber(5))
+	require.Error(err)
+
+	// a plain transfer only costs the intrinsic gas
+	gas, err := s.EstimateGas(Web3CallArgs{To: &charlie})
+	require.NoError(err)
+	require.Equal(hexutil.Uint64(action.TransferBaseIntrinsicGas), gas)
+
+	blk, err := s.GetBlockByNumber(rpc.BlockNumber(2), false)
+	require.NoError(err)
+	require.Equal(hexutil.Uint64(2), blk.Number)
+	require.Equal(7, len(blk.Transactions))
+	blk, err = s.GetBlockByNumber(rpc.LatestBlockNumber, true)
+	require.NoError(err)
+	require.Equal(hexutil.Uint64(4), blk.Number)
+	require.Equal(5, len(blk.Transactions))
+	for i, tx := range blk.Transactions {
+		require.Equal(hexutil.Uint64(i), tx.(*Web3Transaction).TransactionIndex)
+		require.Equal(blk.Hash, tx.(*Web3Transaction).BlockHash)
+	}
+	blk, err = s.GetBlockByNumber(rpc.BlockNumber(5), false)
+	require.NoError(err)
+	require.Nil(blk)
+
+	receipt, err := s.GetTransactionReceipt(common.BytesToHash(transferHash1[:]))
+	require.NoError(err)
+	require.Equal(hexutil.Uint64(1), receipt.BlockNumber)
+	require.Equal(common.BytesToAddress(ta.Addrinfo["producer"].Bytes()), receipt.From)
+	require.Equal(charlie, *receipt.To)
+	require.Equal(receipt.GasUsed, receipt.CumulativeGasUsed)
+	receipt, err = s.GetTransactionReceipt(common.BytesToHash(executionHash3[:]))
+	require.NoError(err)
+	require.Equal(hexutil.Uint64(4), receipt.BlockNumber)
+	require.Equal(hexutil.Uint64(action.SuccessReceiptStatus), receipt.Status)
+	receipt, err = s.GetTransactionReceipt(common.Hash{})
+	require.NoError(err)
+	require.Nil(receipt)
+
+	fromBlock := rpc.BlockNumber(3)
+	logs, err := s.GetLogs(ctx, Web3FilterQuery{FromBlock: &fromBlock})
+	require.NoError(err)
+	require.Equal(0, len(logs))
+
+	// the transaction signed by a web3 wallet is sent as the action it stands for
+	svr.broadcastHandler = func(context.Context, uint32, proto.Message) error { return nil }
+	producer := ta.Addrinfo["producer"].String()
+	nonce, err := svr.ap.GetPendingNonce(producer)
+	require.NoError(err)
+	tx, err := types.SignTx(
+		types.NewTransaction(nonce, charlie, big.NewInt(1), action.TransferBaseIntrinsicGas, big.NewInt(testutil.TestGasPrice), nil),
+		types.NewEIP155Signer(big.NewInt(int64(cfg.Chain.ID))),
+		ta.Keyinfo["producer"].PriKey.EcdsaPrivateKey(),
+	)
+	require.NoError(err)
+	raw, err := rlp.EncodeToBytes(tx)
+	require.NoError(err)
+	txHash, err := s.SendRawTransaction(ctx, raw)
+	require.NoError(err)
+	require.Equal(tx.Hash(), txHash)
+	selp, err := svr.ap.GetActionByHash(hash.BytesToHash256(txHash.Bytes()))
+	require.NoError(err)
+	require.Equal(ta.Keyinfo["producer"].PubKey.Bytes(), selp.SrcPubkey().Bytes())
+	tsf, ok := selp.Action().(*action.Transfer)
+	require.True(ok)
+	require.Equal(ta.Addrinfo["charlie"].String(), tsf.Recipient())
+	// the transaction signed for another chain is rejected
+	tx, err = types.SignTx(
+		types.NewTransaction(nonce+1, charlie, big.NewInt(1), action.TransferBaseIntrinsicGas, big.NewInt(testutil.TestGasPrice), nil),
+		types.NewEIP155Signer(big.NewInt(int64(cfg.Chain.ID)+1)),
+		ta.Keyinfo["producer"].PriKey.EcdsaPrivateKey(),
+	)
+	require.NoError(err)
+	raw, err = rlp.EncodeToBytes(tx)
+	require.NoError(err)
+	_, err = s.SendRawTransaction(ctx, raw)
+	require.Equal(action.ErrEthTransaction, errors.Cause(err))
+}
+
+func TestWeb3Server(t *testing.T) {
+	require := require.New(t)
+	cfg := newConfig()
+
+	svr, err := createServer(cfg, false)
+	require.NoError(err)
+	web3Svr, err := NewWeb3Server(cfg.API, cfg.Genesis, svr)
+	require.NoError(err)
+
+	call := func(method string, params string) map[string]interface{} {
+		body := `{"jsonrpc":"2.0","id":1,"method":"` + method + `","params":` + params + `}`
+		req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		web3Svr.httpServer.Handler.ServeHTTP(rec, req)
+		require.Equal(http.StatusOK, rec.Code)
+		res := make(map[string]interface{})
+		require.NoError(json.Unmarshal(rec.Body.Bytes(), &res))
+		return res
+	}
+	require.Equal("0x4", call("eth_blockNumber", "[]")["result"])
+	require.Equal(hexutil.EncodeUint64(uint64(cfg.Chain.ID)), call("eth_chainId", "[]")["result"])
+	res := call("eth_getBlockByNumber", `["0x1", false]`)
+	blk, ok := res["result"].(map[string]interface{})
+	require.True(ok)
+	require.Equal("0x1", blk["number"])
+	txs, ok := blk["transactions"].([]interface{})
+	require.True(ok)
+	require.Equal(common.BytesToHash(transferHash1[:]).Hex(), txs[0])
+	require.NotNil(call("eth_getLogs", `[{"fromBlock":"0x1","address":"0x0","topics":[null]}]`)["error"])
+	res = call("eth_getLogs", `[{"fromBlock":"0x1","toBlock":"latest","topics":[["`+
+		common.BytesToHash(hash.ZeroHash256[:]).Hex()+`"]]}]`)
+	require.Equal([]interface{}{}, res["result"])
+	require.NotNil(call("eth_sendRawTransaction", `["0xf86b"]`)["error"])
+}
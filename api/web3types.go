@@ -0,0 +1,233 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package api
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+)
+
+// Web3CallArgs is the message call of eth_call and eth_estimateGas
+type Web3CallArgs struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     *hexutil.Bytes  `json:"data"`
+}
+
+// Web3FilterQuery is the filter of eth_getLogs
+type Web3FilterQuery struct {
+	BlockHash *common.Hash
+	FromBlock *rpc.BlockNumber
+	ToBlock   *rpc.BlockNumber
+	Addresses []common.Address
+	Topics    [][]common.Hash
+}
+
+// UnmarshalJSON accepts a single value or a list for the address and each topic position, as eth_getLogs does
+func (q *Web3FilterQuery) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		BlockHash *common.Hash      `json:"blockHash"`
+		FromBlock *rpc.BlockNumber  `json:"fromBlock"`
+		ToBlock   *rpc.BlockNumber  `json:"toBlock"`
+		Address   json.RawMessage   `json:"address"`
+		Topics    []json.RawMessage `json:"topics"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if raw.BlockHash != nil && (raw.FromBlock != nil || raw.ToBlock != nil) {
+		return errors.New("cannot specify both block hash and block range")
+	}
+	q.BlockHash, q.FromBlock, q.ToBlock = raw.BlockHash, raw.FromBlock, raw.ToBlock
+	if len(raw.Address) > 0 && string(raw.Address) != "null" {
+		if err := unmarshalOneOrMany(raw.Address, &q.Addresses); err != nil {
+			return errors.Wrap(err, "invalid address")
+		}
+	}
+	q.Topics = make([][]common.Hash, len(raw.Topics))
+	for i, topics := range raw.Topics {
+		if len(topics) == 0 || string(topics) == "null" {
+			continue
+		}
+		if err := unmarshalOneOrMany(topics, &q.Topics[i]); err != nil {
+			return errors.Wrapf(err, "invalid topic at position %d", i)
+		}
+	}
+	return nil
+}
+
+// Web3Transaction is the transaction object of eth_getBlockByNumber
+type Web3Transaction struct {
+	Hash             common.Hash     `json:"hash"`
+	Nonce            hexutil.Uint64  `json:"nonce"`
+	BlockHash        common.Hash     `json:"blockHash"`
+	BlockNumber      hexutil.Uint64  `json:"blockNumber"`
+	TransactionIndex hexutil.Uint64  `json:"transactionIndex"`
+	From             common.Address  `json:"from"`
+	To               *common.Address `json:"to"`
+	Value            *hexutil.Big    `json:"value"`
+	Gas              hexutil.Uint64  `json:"gas"`
+	GasPrice         *hexutil.Big    `json:"gasPrice"`
+	Input            hexutil.Bytes   `json:"input"`
+}
+
+// Web3Block is the block object of eth_getBlockByNumber
+type Web3Block struct {
+	Number           hexutil.Uint64 `json:"number"`
+	Hash             common.Hash    `json:"hash"`
+	ParentHash       common.Hash    `json:"parentHash"`
+	Nonce            hexutil.Bytes  `json:"nonce"`
+	Sha3Uncles       common.Hash    `json:"sha3Uncles"`
+	LogsBloom        hexutil.Bytes  `json:"logsBloom"`
+	TransactionsRoot common.Hash    `json:"transactionsRoot"`
+	StateRoot        common.Hash    `json:"stateRoot"`
+	ReceiptsRoot     common.Hash    `json:"receiptsRoot"`
+	Miner            common.Address `json:"miner"`
+	Difficulty       *hexutil.Big   `json:"difficulty"`
+	TotalDifficulty  *hexutil.Big   `json:"totalDifficulty"`
+	ExtraData        hexutil.Bytes  `json:"extraData"`
+	Size             hexutil.Uint64 `json:"size"`
+	GasLimit         hexutil.Uint64 `json:"gasLimit"`
+	GasUsed          hexutil.Uint64 `json:"gasUsed"`
+	Timestamp        hexutil.Uint64 `json:"timestamp"`
+	Transactions     []interface{}  `json:"transactions"`
+	Uncles           []common.Hash  `json:"uncles"`
+}
+
+// Web3Receipt is the receipt object of eth_getTransactionReceipt
+type Web3Receipt struct {
+	TransactionHash   common.Hash     `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64  `json:"transactionIndex"`
+	BlockHash         common.Hash     `json:"blockHash"`
+	BlockNumber       hexutil.Uint64  `json:"blockNumber"`
+	From              common.Address  `json:"from"`
+	To                *common.Address `json:"to"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	GasUsed           hexutil.Uint64  `json:"gasUsed"`
+	ContractAddress   *common.Address `json:"contractAddress"`
+	Logs              []*Web3Log      `json:"logs"`
+	LogsBloom         hexutil.Bytes   `json:"logsBloom"`
+	Status            hexutil.Uint64  `json:"status"`
+}
+
+// Web3Log is the log object of eth_getLogs and eth_getTransactionReceipt
+type Web3Log struct {
+	Address          common.Address `json:"address"`
+	Topics           []common.Hash  `json:"topics"`
+	Data             hexutil.Bytes  `json:"data"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	BlockHash        common.Hash    `json:"blockHash"`
+	LogIndex         hexutil.Uint64 `json:"logIndex"`
+	Removed          bool           `json:"removed"`
+}
+
+func unmarshalOneOrMany(data json.RawMessage, v interface{}) error {
+	if len(data) > 0 && data[0] != '[' {
+		data = append(append([]byte{'['}, data...), ']')
+	}
+	return json.Unmarshal(data, v)
+}
+
+// toWeb3Address converts an io1 address into a 0x address
+func toWeb3Address(ioAddr string) (common.Address, error) {
+	addr, err := address.FromString(ioAddr)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(addr.Bytes()), nil
+}
+
+// fromWeb3Address converts a 0x address into an io1 address
+func fromWeb3Address(addr common.Address) (address.Address, error) {
+	return address.FromBytes(addr.Bytes())
+}
+
+// toWeb3Hash converts the hash of a block or a sealed envelope into the hash of a block or a transaction
+func toWeb3Hash(h hash.Hash256) common.Hash {
+	return common.BytesToHash(h[:])
+}
+
+// toWeb3Bloom pads the empty logs bloom of the blocks without any log
+func toWeb3Bloom(bloom []byte) hexutil.Bytes {
+	if len(bloom) == 0 {
+		return make([]byte, block.BloomByteLength)
+	}
+	return bloom
+}
+
+func toWeb3Transaction(selp action.SealedEnvelope, blkHash hash.Hash256, height uint64, index int) (*Web3Transaction, error) {
+	from, err := address.FromBytes(selp.SrcPubkey().Hash())
+	if err != nil {
+		return nil, err
+	}
+	tx := &Web3Transaction{
+		Hash:             toWeb3Hash(selp.Hash()),
+		Nonce:            hexutil.Uint64(selp.Nonce()),
+		BlockHash:        toWeb3Hash(blkHash),
+		BlockNumber:      hexutil.Uint64(height),
+		TransactionIndex: hexutil.Uint64(index),
+		From:             common.BytesToAddress(from.Bytes()),
+		Value:            (*hexutil.Big)(big.NewInt(0)),
+		Gas:              hexutil.Uint64(selp.GasLimit()),
+		GasPrice:         (*hexutil.Big)(selp.GasPrice()),
+	}
+	var to string
+	switch act := selp.Action().(type) {
+	case *action.Transfer:
+		to = act.Recipient()
+		tx.Value = (*hexutil.Big)(act.Amount())
+		tx.Input = act.Payload()
+	case *action.Execution:
+		to = act.Contract()
+		tx.Value = (*hexutil.Big)(act.Amount())
+		tx.Input = act.Data()
+	}
+	if to != action.EmptyAddress {
+		toAddr, err := toWeb3Address(to)
+		if err != nil {
+			return nil, err
+		}
+		tx.To = &toAddr
+	}
+	return tx, nil
+}
+
+func toWeb3Log(l *action.Log, blkHash hash.Hash256, txIndex int) (*Web3Log, error) {
+	addr, err := toWeb3Address(l.Address)
+	if err != nil {
+		return nil, err
+	}
+	topics := make([]common.Hash, 0, len(l.Topics))
+	for _, topic := range l.Topics {
+		topics = append(topics, toWeb3Hash(topic))
+	}
+	return &Web3Log{
+		Address:          addr,
+		Topics:           topics,
+		Data:             l.Data,
+		BlockNumber:      hexutil.Uint64(l.BlockNumber),
+		TransactionHash:  toWeb3Hash(l.TxnHash),
+		TransactionIndex: hexutil.Uint64(txIndex),
+		BlockHash:        toWeb3Hash(blkHash),
+		LogIndex:         hexutil.Uint64(l.Index),
+	}, nil
+}
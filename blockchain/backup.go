@@ -0,0 +1,207 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+const (
+	// BackupManifestFile is the name of the manifest file in a backup directory
+	BackupManifestFile = "manifest.json"
+	// BackupChainDBFile is the name of the chain db in a backup directory
+	BackupChainDBFile = "chain.db"
+	// BackupTrieDBFile is the name of the trie db in a backup directory
+	BackupTrieDBFile = "trie.db"
+	// BackupPollDBFile is the name of the poll db in a backup directory
+	BackupPollDBFile = "poll.db"
+)
+
+// BackupManifest describes a backup of the databases of a node taken at a committed height, which a restore verifies
+// the backup against
+type BackupManifest struct {
+	ChainID   uint32 `json:"chainID"`
+	Height    uint64 `json:"height"`
+	BlockHash string `json:"blockHash"`
+	StateRoot string `json:"stateRoot"`
+	Timestamp int64  `json:"timestamp"`
+	ChainDB   string `json:"chainDB"`
+	TrieDB    string `json:"trieDB"`
+	PollDB    string `json:"pollDB,omitempty"`
+}
+
+// Backup writes a consistent backup of the chain db and the trie db at the tip height into dir, while the chain keeps
+// running. The commits are paused only while the snapshots of the dbs are taken, or for the dbs on BoltDB, while the
+// commits growing the files wait for the copies. With the index written asynchronously, the indices of the latest
+// blocks may be missing in the backup
+func (bc *blockchain) Backup(dir string) (*BackupManifest, error) {
+	if bc.sf == nil {
+		return nil, errors.New("state factory is nil")
+	}
+
+	bc.mu.Lock()
+	manifest := &BackupManifest{
+		ChainID:   bc.config.Chain.ID,
+		Height:    bc.tipHeight,
+		BlockHash: hex.EncodeToString(bc.tipHash[:]),
+		Timestamp: bc.clk.Now().Unix(),
+		ChainDB:   BackupChainDBFile,
+		TrieDB:    BackupTrieDBFile,
+	}
+	stateRoot := bc.sf.RootHash()
+	manifest.StateRoot = hex.EncodeToString(stateRoot[:])
+	chainSnapshot, err := db.TakeSnapshot(bc.dao.kvstore)
+	if err != nil {
+		bc.mu.Unlock()
+		return nil, errors.Wrap(err, "failed to take snapshot of chain db")
+	}
+	trieSnapshot, err := bc.sf.TakeSnapshot()
+	bc.mu.Unlock()
+	defer chainSnapshot.Release()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to take snapshot of trie db")
+	}
+	defer trieSnapshot.Release()
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup directory %s", dir)
+	}
+
+	log.L().Info("Backing up chain db and trie db.", zap.Uint64("height", manifest.Height), zap.String("dir", dir))
+	if err := chainSnapshot.Backup(filepath.Join(dir, BackupChainDBFile)); err != nil {
+		return nil, errors.Wrap(err, "failed to back up chain db")
+	}
+	if err := trieSnapshot.Backup(filepath.Join(dir, BackupTrieDBFile)); err != nil {
+		return nil, errors.Wrap(err, "failed to back up trie db")
+	}
+	return manifest, nil
+}
+
+// WriteBackupManifest writes the manifest into the backup directory
+func WriteBackupManifest(dir string, manifest *BackupManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal backup manifest")
+	}
+	return ioutil.WriteFile(filepath.Join(dir, BackupManifestFile), data, 0600)
+}
+
+// ReadBackupManifest reads the manifest from the backup directory
+func ReadBackupManifest(dir string) (*BackupManifest, error) {
+	data, err := ioutil.ReadFile(filepath.Join(dir, BackupManifestFile))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read backup manifest")
+	}
+	manifest := &BackupManifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal backup manifest")
+	}
+	return manifest, nil
+}
+
+// VerifyBackup verifies the dbs in the backup directory against its manifest, where cfg is the config of the node to
+// restore the backup to. The dbs are only read, so that a backup failing the verification is left as it is
+func VerifyBackup(ctx context.Context, cfg config.Config, dir string) (*BackupManifest, error) {
+	manifest, err := ReadBackupManifest(dir)
+	if err != nil {
+		return nil, err
+	}
+	if manifest.ChainID != cfg.Chain.ID {
+		return nil, errors.Errorf("backup of chain %d cannot be restored to chain %d", manifest.ChainID, cfg.Chain.ID)
+	}
+
+	cfg.DB.DbPath = filepath.Join(dir, manifest.ChainDB)
+	chainDB, err := openBackupDB(ctx, cfg.DB)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open chain db of backup")
+	}
+	defer closeBackupDB(ctx, chainDB)
+	// the dao is not started, which would write the schema and the initial records into a fresh db
+	dao := newBlockDAO(chainDB, false, cfg.Chain.CompressBlock)
+	height, err := dao.getBlockchainHeight()
+	if err != nil {
+		return nil, err
+	}
+	if height != manifest.Height {
+		return nil, errors.Errorf("chain db is at height %d, manifest height is %d", height, manifest.Height)
+	}
+	blkHash, err := dao.getBlockHash(height)
+	if err != nil {
+		return nil, err
+	}
+	if hex.EncodeToString(blkHash[:]) != manifest.BlockHash {
+		return nil, errors.Errorf("block hash %x mismatches manifest block hash %s", blkHash, manifest.BlockHash)
+	}
+
+	cfg.DB.DbPath = filepath.Join(dir, manifest.TrieDB)
+	trieDB, err := openBackupDB(ctx, cfg.DB)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to open trie db of backup")
+	}
+	defer closeBackupDB(ctx, trieDB)
+	value, err := trieDB.Get(factory.AccountKVNameSpace, []byte(factory.CurrentHeightKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get height of trie db")
+	}
+	if height = byteutil.BytesToUint64(value); height != manifest.Height {
+		return nil, errors.Errorf("trie db is at height %d, manifest height is %d", height, manifest.Height)
+	}
+	// the state db without the trie has no state root
+	stateRoot := hash.ZeroHash256
+	if !cfg.Chain.EnableTrielessStateDB {
+		if value, err = trieDB.Get(factory.AccountKVNameSpace, []byte(factory.AccountTrieRootKey)); err != nil {
+			return nil, errors.Wrap(err, "failed to get state root of trie db")
+		}
+		stateRoot = hash.BytesToHash256(value)
+	}
+	if hex.EncodeToString(stateRoot[:]) != manifest.StateRoot {
+		return nil, errors.Errorf("state root %x mismatches manifest state root %s", stateRoot, manifest.StateRoot)
+	}
+
+	if manifest.PollDB != "" {
+		pollCfg := cfg.Chain.GravityChainDB
+		pollCfg.DbPath = filepath.Join(dir, manifest.PollDB)
+		pollDB, err := openBackupDB(ctx, pollCfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to open poll db of backup")
+		}
+		closeBackupDB(ctx, pollDB)
+	}
+	return manifest, nil
+}
+
+// openBackupDB opens the db of a backup, which must exist, as opening a missing db creates an empty one
+func openBackupDB(ctx context.Context, cfg config.DB) (db.KVStore, error) {
+	if _, err := os.Stat(cfg.DbPath); err != nil {
+		return nil, errors.Wrapf(err, "failed to find db %s", cfg.DbPath)
+	}
+	kvStore := db.NewOnDiskDB(cfg)
+	if err := kvStore.Start(ctx); err != nil {
+		return nil, err
+	}
+	return kvStore, nil
+}
+
+func closeBackupDB(ctx context.Context, kvStore db.KVStore) {
+	if err := kvStore.Stop(ctx); err != nil {
+		log.L().Error("Failed to close db of backup.", zap.Error(err))
+	}
+}
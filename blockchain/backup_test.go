@@ -0,0 +1,105 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
+	"github.com/iotexproject/iotex-core/action/protocol/vote"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+func TestBackup(t *testing.T) {
+	require := require.New(t)
+
+	testDir, err := ioutil.TempDir(os.TempDir(), "backup")
+	require.NoError(err)
+	defer func() {
+		require.NoError(os.RemoveAll(testDir))
+	}()
+
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.Chain.EnableTrielessStateDB = false
+	cfg.Chain.TrieDBPath = filepath.Join(testDir, "trie.db")
+	cfg.Chain.ChainDBPath = filepath.Join(testDir, "chain.db")
+
+	sf, err := factory.NewFactory(cfg, factory.DefaultTrieOption())
+	require.NoError(err)
+	registry := protocol.Registry{}
+	acc := account.NewProtocol()
+	require.NoError(registry.Register(account.ProtocolID, acc))
+	rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+	require.NoError(registry.Register(rolldpos.ProtocolID, rp))
+	bc := NewBlockchain(
+		cfg,
+		PrecreatedStateFactoryOption(sf),
+		BoltDBDaoOption(),
+		RegistryOption(&registry),
+	)
+	v := vote.NewProtocol(bc)
+	require.NoError(registry.Register(vote.ProtocolID, v))
+	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+	bc.Validator().AddActionValidators(acc, v)
+	sf.AddActionHandlers(acc, v)
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	require.NoError(addCreatorToFactory(sf))
+	require.NoError(addTestingTsfBlocks(bc))
+
+	backupDir := filepath.Join(testDir, "backup")
+	manifest, err := bc.Backup(backupDir)
+	require.NoError(err)
+	require.Equal(bc.TipHeight(), manifest.Height)
+	tipHash := bc.TipHash()
+	require.Equal(hex.EncodeToString(tipHash[:]), manifest.BlockHash)
+	stateRoot := sf.RootHash()
+	require.Equal(hex.EncodeToString(stateRoot[:]), manifest.StateRoot)
+	require.NoError(WriteBackupManifest(backupDir, manifest))
+
+	verified, err := VerifyBackup(ctx, cfg, backupDir)
+	require.NoError(err)
+	require.Equal(manifest, verified)
+
+	// the missing poll db fails the verification without creating it
+	manifest.PollDB = BackupPollDBFile
+	require.NoError(WriteBackupManifest(backupDir, manifest))
+	_, err = VerifyBackup(ctx, cfg, backupDir)
+	require.Error(err)
+	_, err = os.Stat(filepath.Join(backupDir, BackupPollDBFile))
+	require.True(os.IsNotExist(err))
+	manifest.PollDB = ""
+
+	// the backup mismatching the manifest fails the verification
+	manifest.Height--
+	require.NoError(WriteBackupManifest(backupDir, manifest))
+	_, err = VerifyBackup(ctx, cfg, backupDir)
+	require.Error(err)
+	manifest.Height++
+	manifest.StateRoot = hex.EncodeToString(make([]byte, 32))
+	require.NoError(WriteBackupManifest(backupDir, manifest))
+	_, err = VerifyBackup(ctx, cfg, backupDir)
+	require.Error(err)
+
+	// never overwrite a backup
+	_, err = bc.Backup(backupDir)
+	require.Error(err)
+}
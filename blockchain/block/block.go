@@ -70,6 +70,7 @@ func (b *Block) ConvertFromBlockHeaderPb(pbBlock *iotextypes.Block) error {
 	copy(b.Header.txRoot[:], pbBlock.GetHeader().GetCore().GetTxRoot())
 	copy(b.Header.deltaStateDigest[:], pbBlock.GetHeader().GetCore().GetDeltaStateDigest())
 	copy(b.Header.receiptRoot[:], pbBlock.GetHeader().GetCore().GetReceiptRoot())
+	b.Header.logsBloom = pbBlock.GetHeader().GetCore().GetLogsBloom()
 	b.Header.blockSig = pbBlock.GetHeader().GetSignature()
 
 	pubKey, err := keypair.BytesToPublicKey(pbBlock.GetHeader().GetProducerPubkey())
@@ -157,6 +158,14 @@ func (b *Block) VerifyReceiptRoot(root hash.Hash256) error {
 	return nil
 }
 
+// VerifyLogsBloom verifies the logs bloom in header
+func (b *Block) VerifyLogsBloom(bloom []byte) error {
+	if !bytes.Equal(b.Header.logsBloom, bloom) {
+		return errors.New("logs bloom does not match")
+	}
+	return nil
+}
+
 // ProducerAddress returns the address of producer
 func (b *Block) ProducerAddress() string {
 	addr, _ := address.FromBytes(b.Header.pubkey.Hash())
@@ -0,0 +1,71 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package block
+
+import (
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+)
+
+const (
+	// BloomByteLength is the byte length of the logs bloom in a block header
+	BloomByteLength = 256
+	bloomBitLength  = 8 * BloomByteLength
+	// number of bits set for each item added into the bloom
+	bloomHashes = 3
+)
+
+// CalculateLogsBloom calculates the bloom filter over the addresses and topics of the logs in the receipts. It returns
+// nil if there is no log, so that the blocks without any log keep an empty bloom.
+func CalculateLogsBloom(receipts []*action.Receipt) []byte {
+	var bloom []byte
+	for _, r := range receipts {
+		for _, l := range r.Logs {
+			if bloom == nil {
+				bloom = make([]byte, BloomByteLength)
+			}
+			if addr, err := address.FromString(l.Address); err == nil {
+				bloomAdd(bloom, addr.Bytes())
+			}
+			for _, topic := range l.Topics {
+				bloomAdd(bloom, topic[:])
+			}
+		}
+	}
+	return bloom
+}
+
+// bloomAdd sets the bits of the data in the bloom
+func bloomAdd(bloom []byte, data []byte) {
+	for _, bit := range bloomBits(data) {
+		bloom[BloomByteLength-1-bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// bloomTest checks if the data may have been added into the bloom
+func bloomTest(bloom []byte, data []byte) bool {
+	if len(bloom) != BloomByteLength {
+		return false
+	}
+	for _, bit := range bloomBits(data) {
+		if bloom[BloomByteLength-1-bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// bloomBits derives the bit positions of the data from its hash
+func bloomBits(data []byte) [bloomHashes]uint {
+	var bits [bloomHashes]uint
+	h := hash.Hash256b(data)
+	for i := 0; i < bloomHashes; i++ {
+		bits[i] = (uint(h[2*i])<<8 | uint(h[2*i+1])) % bloomBitLength
+	}
+	return bits
+}
@@ -0,0 +1,56 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package block
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	ta "github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestLogsBloom(t *testing.T) {
+	require := require.New(t)
+
+	topic1 := hash.Hash256b([]byte("topic1"))
+	topic2 := hash.Hash256b([]byte("topic2"))
+
+	// no log leaves the bloom empty
+	require.Nil(CalculateLogsBloom(nil))
+	require.Nil(CalculateLogsBloom([]*action.Receipt{{}}))
+
+	receipts := []*action.Receipt{
+		{Logs: []*action.Log{{Address: ta.Addrinfo["alfa"].String(), Topics: []hash.Hash256{topic1}}}},
+	}
+	blk, err := NewTestingBuilder().
+		SetHeight(1).
+		SetTimeStamp(testutil.TimestampNow()).
+		SetReceipts(receipts).
+		SignAndBuild(ta.Keyinfo["producer"].PubKey, ta.Keyinfo["producer"].PriKey)
+	require.NoError(err)
+	require.Equal(BloomByteLength, len(blk.LogsBloom()))
+	require.True(blk.MayContainLogAddress(ta.Addrinfo["alfa"]))
+	require.False(blk.MayContainLogAddress(ta.Addrinfo["bravo"]))
+	require.True(blk.MayContainLogTopic(topic1))
+	require.False(blk.MayContainLogTopic(topic2))
+	require.NoError(blk.VerifyLogsBloom(CalculateLogsBloom(receipts)))
+	require.Error(blk.VerifyLogsBloom(nil))
+
+	// the bloom survives the header round trip
+	header := blk.ConvertToBlockHeaderPb()
+	require.Equal(blk.LogsBloom(), header.GetCore().GetLogsBloom())
+	serialized, err := blk.Serialize()
+	require.NoError(err)
+	var blk2 Block
+	require.NoError(blk2.Deserialize(serialized))
+	require.Equal(blk.LogsBloom(), blk2.LogsBloom())
+	require.Equal(blk.HashBlock(), blk2.HashBlock())
+}
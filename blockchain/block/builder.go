@@ -66,6 +66,12 @@ func (b *Builder) SetReceiptRoot(h hash.Hash256) *Builder {
 	return b
 }
 
+// SetLogsBloom sets the bloom filter of the logs emitted by running actions included in this building block.
+func (b *Builder) SetLogsBloom(bloom []byte) *Builder {
+	b.blk.Header.logsBloom = bloom
+	return b
+}
+
 // SignAndBuild signs and then builds a block.
 func (b *Builder) SignAndBuild(signerPrvKey keypair.PrivateKey) (Block, error) {
 	if !bytes.Equal(b.blk.Header.pubkey.Bytes(), signerPrvKey.PublicKey().Bytes()) {
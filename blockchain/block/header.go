@@ -9,6 +9,7 @@ package block
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -27,6 +28,7 @@ type Header struct {
 	txRoot           hash.Hash256      // merkle root of all transactions
 	deltaStateDigest hash.Hash256      // digest of state change by this block
 	receiptRoot      hash.Hash256      // root of receipt trie
+	logsBloom        []byte            // bloom filter of the log addresses and topics
 	blockSig         []byte            // block signature
 	pubkey           keypair.PublicKey // block producer's public key
 }
@@ -55,6 +57,19 @@ func (h Header) PublicKey() keypair.PublicKey { return h.pubkey }
 // ReceiptRoot returns the receipt root after apply this block
 func (h Header) ReceiptRoot() hash.Hash256 { return h.receiptRoot }
 
+// LogsBloom returns the bloom filter of the log addresses and topics, which is empty if the block has no log
+func (h Header) LogsBloom() []byte { return h.logsBloom }
+
+// MayContainLogAddress checks if the block may have logs emitted by the address
+func (h Header) MayContainLogAddress(addr address.Address) bool {
+	return bloomTest(h.logsBloom, addr.Bytes())
+}
+
+// MayContainLogTopic checks if the block may have logs with the topic
+func (h Header) MayContainLogTopic(topic hash.Hash256) bool {
+	return bloomTest(h.logsBloom, topic[:])
+}
+
 // BlockHeaderProto returns BlockHeader proto.
 func (h Header) BlockHeaderProto() *iotextypes.BlockHeader {
 	return &iotextypes.BlockHeader{
@@ -76,6 +91,7 @@ func (h Header) BlockHeaderCoreProto() *iotextypes.BlockHeaderCore {
 		TxRoot:           h.txRoot[:],
 		DeltaStateDigest: h.deltaStateDigest[:],
 		ReceiptRoot:      h.receiptRoot[:],
+		LogsBloom:        h.logsBloom,
 	}
 }
 
@@ -71,6 +71,7 @@ func (b *TestingBuilder) SetReceipts(receipts []*action.Receipt) *TestingBuilder
 // SignAndBuild signs and then builds a block.
 func (b *TestingBuilder) SignAndBuild(signerPubKey keypair.PublicKey, signerPrvKey keypair.PrivateKey) (Block, error) {
 	b.blk.Header.txRoot = b.blk.CalculateTxRoot()
+	b.blk.Header.logsBloom = CalculateLogsBloom(b.blk.Receipts)
 	b.blk.Header.pubkey = signerPubKey
 	h := b.blk.Header.HashHeaderCore()
 	sig, err := signerPrvKey.Sign(h[:])
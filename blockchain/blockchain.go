@@ -13,7 +13,9 @@ import (
 	"strconv"
 	"sync"
 	"sync/atomic"
+	"time"
 
+	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/facebookgo/clock"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
@@ -31,6 +33,7 @@ import (
 	"github.com/iotexproject/iotex-core/actpool/actioniterator"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/crypto"
 	"github.com/iotexproject/iotex-core/db"
@@ -53,6 +56,8 @@ var (
 		[]string{"type"},
 	)
 	errDelegatesNotExist = errors.New("delegates cannot be found")
+	// ErrNotExecution indicates that the action to trace is not an execution
+	ErrNotExecution = errors.New("action is not an execution")
 )
 
 func init() {
@@ -84,6 +89,10 @@ type Blockchain interface {
 	GetTotalActions() (uint64, error)
 	// GetReceiptByActionHash returns the receipt by action hash
 	GetReceiptByActionHash(h hash.Hash256) (*action.Receipt, error)
+	// GetReceiptsByHeight returns the receipts of the block at a height
+	GetReceiptsByHeight(height uint64) ([]*action.Receipt, error)
+	// GetLogs returns the receipt logs which pass the filter in the blocks of [start, end]
+	GetLogs(filter *LogFilter, start uint64, end uint64) ([]*action.Log, error)
 	// GetActionsFromAddress returns actions from address
 	GetActionsFromAddress(address string) ([]hash.Hash256, error)
 	// GetActionsToAddress returns actions to address
@@ -96,6 +105,8 @@ type Blockchain interface {
 	GetFactory() factory.Factory
 	// GetChainID returns the chain ID
 	ChainID() uint32
+	// Genesis returns the genesis config of the chain
+	Genesis() genesis.Genesis
 	// ChainAddress returns chain address on parent chain, the root chain return empty.
 	ChainAddress() string
 	// TipHash returns tip block's hash
@@ -104,8 +115,12 @@ type Blockchain interface {
 	TipHeight() uint64
 	// StateByAddr returns account of a given address
 	StateByAddr(address string) (*state.Account, error)
+	// StateByAddrAtHeight returns account of a given address at a given height
+	StateByAddrAtHeight(address string, height uint64) (*state.Account, error)
 	// RecoverChainAndState recovers the chain to target height and refresh state db if necessary
 	RecoverChainAndState(targetHeight uint64) error
+	// Backup writes a consistent backup of the chain db and the trie db at the tip height into dir
+	Backup(dir string) (*BackupManifest, error)
 
 	// For block operations
 	// MintNewBlock creates a new block with given actions
@@ -113,6 +128,7 @@ type Blockchain interface {
 	MintNewBlock(
 		actionMap map[string][]action.SealedEnvelope,
 		timestamp int64,
+		opts ...MintOption,
 	) (*block.Block, error)
 	// CommitBlock validates and appends a block to the chain
 	CommitBlock(blk *block.Block) error
@@ -129,6 +145,12 @@ type Blockchain interface {
 	// ExecuteContractRead runs a read-only smart contract operation, this is done off the network since it does not
 	// cause any state change
 	ExecuteContractRead(caller address.Address, ex *action.Execution) (*action.Receipt, error)
+	// ExecuteContractReadAtHeight runs a read-only smart contract operation on top of the state at a given height
+	ExecuteContractReadAtHeight(caller address.Address, ex *action.Execution, height uint64) (*action.Receipt, error)
+	// TraceAction re-runs a committed execution on top of the state of its parent block with the tracer attached
+	TraceAction(actHash hash.Hash256, tracer vm.Tracer) (*action.Receipt, error)
+	// TraceContractRead runs an execution on top of the tip state with the tracer attached, without any state change
+	TraceContractRead(caller address.Address, ex *action.Execution, tracer vm.Tracer) (*action.Receipt, error)
 
 	// AddSubscriber make you listen to every single produced block
 	AddSubscriber(BlockCreationSubscriber) error
@@ -159,6 +181,32 @@ type blockchain struct {
 // Option sets blockchain construction parameter
 type Option func(*blockchain, config.Config) error
 
+// MintOption sets the parameters of minting a new block
+type MintOption func(*mintConfig)
+
+type mintConfig struct {
+	budget                time.Duration
+	budgeted              bool
+	revertedBundleHandler func(action.SealedEnvelope)
+}
+
+// WithMintTimeBudget sets the time since minting starts after which no more actions are picked from the pool into the
+// new block. No action is picked if the budget is not positive
+func WithMintTimeBudget(budget time.Duration) MintOption {
+	return func(cfg *mintConfig) {
+		cfg.budget = budget
+		cfg.budgeted = true
+	}
+}
+
+// WithRevertedBundleHandler sets the handler of the bundles reverted in minting, which are left out of the new block. The
+// handler is called once the chain is unlocked, so that it can read the chain
+func WithRevertedBundleHandler(handler func(action.SealedEnvelope)) MintOption {
+	return func(cfg *mintConfig) {
+		cfg.revertedBundleHandler = handler
+	}
+}
+
 // DefaultStateFactoryOption sets blockchain's sf from config
 func DefaultStateFactoryOption() Option {
 	return func(bc *blockchain, cfg config.Config) (err error) {
@@ -211,7 +259,7 @@ func BoltDBDaoOption() Option {
 		cfg.DB.DbPath = cfg.Chain.ChainDBPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
 		_, gateway := cfg.Plugins[config.GatewayPlugin]
 		bc.dao = newBlockDAO(
-			db.NewOnDiskDB(cfg.DB),
+			db.NewInstrumentedKVStore("chain", db.NewOnDiskDB(cfg.DB)),
 			gateway && !cfg.Chain.EnableAsyncIndexWrite,
 			cfg.Chain.CompressBlock,
 		)
@@ -276,7 +324,11 @@ func NewBlockchain(cfg config.Config, opts ...Option) Blockchain {
 	if err != nil {
 		log.L().Panic("Failed to get block producer address.", zap.Error(err))
 	}
-	chain.validator = &validator{sf: chain.sf, validatorAddr: cfg.ProducerAddress().String()}
+	chain.validator = &validator{
+		sf:            chain.sf,
+		validatorAddr: cfg.ProducerAddress().String(),
+		genesis:       cfg.Genesis.Blockchain,
+	}
 
 	if chain.dao != nil {
 		chain.lifecycle.Add(chain.dao)
@@ -291,6 +343,10 @@ func (bc *blockchain) ChainID() uint32 {
 	return atomic.LoadUint32(&bc.config.Chain.ID)
 }
 
+func (bc *blockchain) Genesis() genesis.Genesis {
+	return bc.config.Genesis
+}
+
 func (bc *blockchain) ChainAddress() string {
 	return bc.config.Chain.Address
 }
@@ -374,6 +430,19 @@ func (bc *blockchain) GetReceiptByActionHash(h hash.Hash256) (*action.Receipt, e
 	return bc.dao.getReceiptByActionHash(h)
 }
 
+// GetReceiptsByHeight returns the receipts of the block at a height
+func (bc *blockchain) GetReceiptsByHeight(height uint64) ([]*action.Receipt, error) {
+	return bc.dao.getReceipts(height)
+}
+
+// GetLogs returns the receipt logs which pass the filter in the blocks of [start, end]
+func (bc *blockchain) GetLogs(filter *LogFilter, start uint64, end uint64) ([]*action.Log, error) {
+	if start == 0 || start > end {
+		return nil, errors.Errorf("invalid block range [%d, %d]", start, end)
+	}
+	return getLogs(bc.dao, filter, start, end)
+}
+
 // GetActionsFromAddress returns actions from address
 func (bc *blockchain) GetActionsFromAddress(addrStr string) ([]hash.Hash256, error) {
 	addr, err := address.FromString(addrStr)
@@ -449,7 +518,22 @@ func (bc *blockchain) ValidateBlock(blk *block.Block) error {
 func (bc *blockchain) MintNewBlock(
 	actionMap map[string][]action.SealedEnvelope,
 	timestamp int64,
+	opts ...MintOption,
 ) (*block.Block, error) {
+	mintCfg := mintConfig{}
+	for _, opt := range opts {
+		opt(&mintCfg)
+	}
+	var reverted []action.SealedEnvelope
+	defer func() {
+		if mintCfg.revertedBundleHandler == nil {
+			return
+		}
+		for _, selp := range reverted {
+			mintCfg.revertedBundleHandler(selp)
+		}
+	}()
+
 	bc.mu.RLock()
 	defer bc.mu.RUnlock()
 	mintNewBlockTimer := bc.timerFactory.NewTimer("MintNewBlock")
@@ -462,23 +546,40 @@ func (bc *blockchain) MintNewBlock(
 		return nil, errors.Wrap(err, "Failed to obtain working set from state factory")
 	}
 
+	var deadline time.Time
+	if mintCfg.budgeted {
+		deadline = bc.clk.Now().Add(mintCfg.budget)
+	}
 	gasLimitForContext := bc.config.Genesis.BlockGasLimit
 	ctx := protocol.WithRunActionsCtx(context.Background(),
 		protocol.RunActionsCtx{
-			BlockHeight:    newblockHeight,
-			BlockTimeStamp: timestamp,
-			Producer:       bc.config.ProducerAddress(),
-			GasLimit:       gasLimitForContext,
-			ActionGasLimit: bc.config.Genesis.ActionGasLimit,
-			Registry:       bc.registry,
+			BlockHeight:          newblockHeight,
+			BlockTimeStamp:       timestamp,
+			Producer:             bc.config.ProducerAddress(),
+			GasLimit:             gasLimitForContext,
+			ActionGasLimit:       bc.config.Genesis.ActionGasLimit,
+			EVMErrorStatusHeight: bc.config.Genesis.EVMErrorStatusHeight,
+			Web3Height:           bc.config.Genesis.Web3Height,
+			BundleHeight:         bc.config.Genesis.BundleHeight,
+			MultiTransferHeight:  bc.config.Genesis.MultiTransferHeight,
+			Registry:             bc.registry,
 		})
-	_, rc, actions, err := bc.pickAndRunActions(ctx, actionMap, ws)
+	_, rc, actions, err := bc.pickAndRunActions(ctx, actionMap, ws, deadline, func(selp action.SealedEnvelope) {
+		reverted = append(reverted, selp)
+	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "Failed to update state changes in new block %d", newblockHeight)
 	}
 
+	var gasConsumed uint64
+	for _, receipt := range rc {
+		gasConsumed += receipt.GasConsumed
+	}
 	blockMtc.WithLabelValues("numActions").Set(float64(len(actions)))
-	blockMtc.WithLabelValues("gasConsumed").Set(float64(bc.config.Genesis.BlockGasLimit - gasLimitForContext))
+	blockMtc.WithLabelValues("gasConsumed").Set(float64(gasConsumed))
+	if gasLimitForContext > 0 {
+		blockMtc.WithLabelValues("fillRatio").Set(float64(gasConsumed) / float64(gasLimitForContext))
+	}
 
 	sk := bc.config.ProducerPrivateKey()
 	ra := block.NewRunnableActionsBuilder().
@@ -498,6 +599,7 @@ func (bc *blockchain) MintNewBlock(
 		SetDeltaStateDigest(ws.Digest()).
 		SetReceipts(rc).
 		SetReceiptRoot(calculateReceiptRoot(rc)).
+		SetLogsBloom(bc.logsBloom(newblockHeight, rc)).
 		SignAndBuild(sk)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to create block")
@@ -530,6 +632,18 @@ func (bc *blockchain) StateByAddr(address string) (*state.Account, error) {
 	return nil, errors.New("state factory is nil")
 }
 
+// StateByAddrAtHeight returns the account of an address at a given height
+func (bc *blockchain) StateByAddrAtHeight(address string, height uint64) (*state.Account, error) {
+	if bc.sf == nil {
+		return nil, errors.New("state factory is nil")
+	}
+	s, err := bc.sf.AccountStateAtHeight(address, height)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get account %s at height %d", address, height)
+	}
+	return s, nil
+}
+
 // SetValidator sets the current validator object
 func (bc *blockchain) SetValidator(val Validator) {
 	bc.mu.Lock()
@@ -576,14 +690,112 @@ func (bc *blockchain) RemoveSubscriber(s BlockCreationSubscriber) error {
 // ExecuteContractRead runs a read-only smart contract operation, this is done off the network since it does not
 // cause any state change
 func (bc *blockchain) ExecuteContractRead(caller address.Address, ex *action.Execution) (*action.Receipt, error) {
-	// use latest block as carrier to run the offline execution
+	return bc.ExecuteContractReadAtHeight(caller, ex, bc.TipHeight())
+}
+
+// ExecuteContractReadAtHeight runs a read-only smart contract operation on top of the state at a given height
+func (bc *blockchain) ExecuteContractReadAtHeight(
+	caller address.Address,
+	ex *action.Execution,
+	height uint64,
+) (*action.Receipt, error) {
+	return bc.executeContractRead(context.Background(), caller, ex, height)
+}
+
+// TraceAction re-runs a committed execution on top of the state of its parent block with the tracer attached
+func (bc *blockchain) TraceAction(actHash hash.Hash256, tracer vm.Tracer) (*action.Receipt, error) {
+	blkHash, err := bc.GetBlockHashByActionHash(actHash)
+	if err != nil {
+		return nil, err
+	}
+	blk, err := bc.GetBlockByHash(blkHash)
+	if err != nil {
+		return nil, err
+	}
+	index := -1
+	for i, selp := range blk.Actions {
+		if selp.Hash() == actHash {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		return nil, errors.Wrapf(db.ErrNotExist, "block %x does not have action %x", blkHash, actHash)
+	}
+	selp := blk.Actions[index]
+	exec, ok := selp.Action().(*action.Execution)
+	if !ok {
+		return nil, errors.Wrapf(ErrNotExecution, "action %x", actHash)
+	}
+	ws, err := bc.sf.NewWorkingSetAtHeight(blk.Height() - 1)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain working set of the parent block from state factory")
+	}
+	producer, err := address.FromString(blk.ProducerAddress())
+	if err != nil {
+		return nil, err
+	}
+	raCtx := protocol.RunActionsCtx{
+		BlockHeight:          blk.Height(),
+		BlockTimeStamp:       blk.Timestamp(),
+		Producer:             producer,
+		GasLimit:             bc.config.Genesis.BlockGasLimit,
+		ActionGasLimit:       bc.config.Genesis.ActionGasLimit,
+		EVMErrorStatusHeight: bc.config.Genesis.EVMErrorStatusHeight,
+		Web3Height:           bc.config.Genesis.Web3Height,
+		BundleHeight:         bc.config.Genesis.BundleHeight,
+		MultiTransferHeight:  bc.config.Genesis.MultiTransferHeight,
+		Registry:             bc.registry,
+	}
+	// replay the actions before the execution in the block
+	for _, prev := range blk.Actions[:index] {
+		receipt, err := ws.RunAction(raCtx, prev)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to replay action %x", prev.Hash())
+		}
+		if receipt != nil {
+			raCtx.GasLimit -= receipt.GasConsumed
+		}
+	}
+	caller, err := address.FromBytes(selp.SrcPubkey().Hash())
+	if err != nil {
+		return nil, err
+	}
+	intrinsicGas, err := selp.IntrinsicGas()
+	if err != nil {
+		return nil, err
+	}
+	raCtx.Caller = caller
+	raCtx.ActionHash = actHash
+	raCtx.GasPrice = selp.GasPrice()
+	raCtx.IntrinsicGas = intrinsicGas
+	raCtx.Nonce = selp.Nonce()
+	ctx := evm.WithTracer(protocol.WithRunActionsCtx(context.Background(), raCtx), tracer)
+	return evm.ExecuteContract(ctx, ws, exec, bc)
+}
+
+// TraceContractRead runs an execution on top of the tip state with the tracer attached, without any state change
+func (bc *blockchain) TraceContractRead(
+	caller address.Address,
+	ex *action.Execution,
+	tracer vm.Tracer,
+) (*action.Receipt, error) {
+	return bc.executeContractRead(evm.WithTracer(context.Background(), tracer), caller, ex, bc.TipHeight())
+}
+
+func (bc *blockchain) executeContractRead(
+	ctx context.Context,
+	caller address.Address,
+	ex *action.Execution,
+	height uint64,
+) (*action.Receipt, error) {
+	// use the block at the height as carrier to run the offline execution
 	// the block itself is not used
-	h := bc.TipHeight()
-	blk, err := bc.GetBlockByHeight(h)
+	blk, err := bc.GetBlockByHeight(height)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to get block in ExecuteContractRead")
 	}
-	ws, err := bc.sf.NewWorkingSet()
+	ws, err := bc.sf.NewWorkingSetAtHeight(height)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to obtain working set from state factory")
 	}
@@ -592,15 +804,19 @@ func (bc *blockchain) ExecuteContractRead(caller address.Address, ex *action.Exe
 		return nil, err
 	}
 	gasLimit := bc.config.Genesis.BlockGasLimit
-	ctx := protocol.WithRunActionsCtx(context.Background(), protocol.RunActionsCtx{
-		BlockHeight:    blk.Height(),
-		BlockTimeStamp: blk.Timestamp(),
-		Producer:       producer,
-		Caller:         caller,
-		GasLimit:       gasLimit,
-		ActionGasLimit: bc.config.Genesis.ActionGasLimit,
-		GasPrice:       big.NewInt(0),
-		IntrinsicGas:   0,
+	ctx = protocol.WithRunActionsCtx(ctx, protocol.RunActionsCtx{
+		BlockHeight:          blk.Height(),
+		BlockTimeStamp:       blk.Timestamp(),
+		Producer:             producer,
+		Caller:               caller,
+		GasLimit:             gasLimit,
+		ActionGasLimit:       bc.config.Genesis.ActionGasLimit,
+		EVMErrorStatusHeight: bc.config.Genesis.EVMErrorStatusHeight,
+		Web3Height:           bc.config.Genesis.Web3Height,
+		BundleHeight:         bc.config.Genesis.BundleHeight,
+		MultiTransferHeight:  bc.config.Genesis.MultiTransferHeight,
+		GasPrice:             big.NewInt(0),
+		IntrinsicGas:         0,
 	})
 	return evm.ExecuteContract(
 		ctx,
@@ -808,6 +1024,10 @@ func (bc *blockchain) validateBlock(blk *block.Block) error {
 		return errors.Wrap(err, "Failed to verify receipt root")
 	}
 
+	if err = blk.VerifyLogsBloom(bc.logsBloom(blk.Height(), receipts)); err != nil {
+		return errors.Wrap(err, "Failed to verify logs bloom")
+	}
+
 	blk.Receipts = receipts
 
 	// attach working set to be committed to state factory
@@ -815,6 +1035,14 @@ func (bc *blockchain) validateBlock(blk *block.Block) error {
 	return nil
 }
 
+// logsBloom returns the logs bloom of the block at the height, which is empty for the blocks before the bloom height
+func (bc *blockchain) logsBloom(height uint64, receipts []*action.Receipt) []byte {
+	if height < bc.config.Genesis.LogsBloomHeight {
+		return nil
+	}
+	return block.CalculateLogsBloom(receipts)
+}
+
 // commitBlock commits a block to the chain
 func (bc *blockchain) commitBlock(blk *block.Block) error {
 	// Check if it is already exists, and return earlier
@@ -880,19 +1108,28 @@ func (bc *blockchain) runActions(
 
 	ctx := protocol.WithRunActionsCtx(context.Background(),
 		protocol.RunActionsCtx{
-			BlockHeight:    acts.BlockHeight(),
-			BlockTimeStamp: acts.BlockTimeStamp(),
-			Producer:       producer,
-			GasLimit:       gasLimit,
-			ActionGasLimit: bc.config.Genesis.ActionGasLimit,
-			Registry:       bc.registry,
+			BlockHeight:          acts.BlockHeight(),
+			BlockTimeStamp:       acts.BlockTimeStamp(),
+			Producer:             producer,
+			GasLimit:             gasLimit,
+			ActionGasLimit:       bc.config.Genesis.ActionGasLimit,
+			EVMErrorStatusHeight: bc.config.Genesis.EVMErrorStatusHeight,
+			Web3Height:           bc.config.Genesis.Web3Height,
+			BundleHeight:         bc.config.Genesis.BundleHeight,
+			MultiTransferHeight:  bc.config.Genesis.MultiTransferHeight,
+			Registry:             bc.registry,
 		})
 
 	return ws.RunActions(ctx, acts.BlockHeight(), acts.Actions())
 }
 
+// pickAndRunActions picks the actions in the order of gas price and runs them until the block is full or the deadline
+// is passed, if it is set. An account is skipped once its next action does not fit in the remaining gas, or is a
+// reverted bundle, which is passed to the given function, as the subsequent ones cannot be included either due to the
+// nonce order, while the other accounts keep filling the block
 func (bc *blockchain) pickAndRunActions(ctx context.Context, actionMap map[string][]action.SealedEnvelope,
-	ws factory.WorkingSet) (hash.Hash256, []*action.Receipt, []action.SealedEnvelope, error) {
+	ws factory.WorkingSet, deadline time.Time, reverted func(action.SealedEnvelope),
+) (hash.Hash256, []*action.Receipt, []action.SealedEnvelope, error) {
 	if bc.sf == nil {
 		return hash.ZeroHash256, nil, nil, errors.New("statefactory cannot be nil")
 	}
@@ -903,17 +1140,36 @@ func (bc *blockchain) pickAndRunActions(ctx context.Context, actionMap map[strin
 	// initial action iterator
 	actionIterator := actioniterator.NewActionIterator(actionMap)
 	for {
+		if !deadline.IsZero() && !bc.clk.Now().Before(deadline) {
+			log.L().Info("Stop picking actions as the deadline of minting is passed.",
+				zap.Uint64("height", raCtx.BlockHeight),
+				zap.Int("numActions", len(executedActions)))
+			break
+		}
 		nextAction, ok := actionIterator.Next()
 		if !ok {
 			break
 		}
+		if raCtx.BlockHeight >= bc.config.Genesis.ActionExpiryHeight && nextAction.Expired(raCtx.BlockHeight) {
+			// the action cannot be included any more, and neither can the following ones of the same user
+			actionIterator.PopAccount()
+			continue
+		}
 
 		receipt, err := ws.RunAction(raCtx, nextAction)
 		if err != nil {
-			if errors.Cause(err) == action.ErrHitGasLimit {
-				// hit block gas limit, we should not process actions belong to this user anymore since we
-				// need monotonically increasing nounce. But we can continue processing other actions
-				// that belong other users
+			switch errors.Cause(err) {
+			case action.ErrBundleReverted:
+				// the bundle is left out, and so are the later actions of the user due to the nonce order
+				h := nextAction.Hash()
+				log.L().Debug("Bundle is reverted.", log.Hex("hash", h[:]), zap.Error(err))
+				reverted(nextAction)
+				actionIterator.PopAccount()
+				continue
+			case action.ErrHitGasLimit:
+				// hit block gas limit, we should not process actions belong to this user anymore since we need
+				// monotonically increasing nounce. But we can continue processing other actions that belong other
+				// users
 				actionIterator.PopAccount()
 				continue
 			}
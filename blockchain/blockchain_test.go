@@ -14,6 +14,7 @@ import (
 	"os"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -391,6 +392,94 @@ func TestBlockchain_MintNewBlock_PopAccount(t *testing.T) {
 	require.True(t, whetherInclude)
 }
 
+func TestBlockchain_MintNewBlock_TimeBudget(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Default
+	registry := protocol.Registry{}
+	acc := account.NewProtocol()
+	require.NoError(t, registry.Register(account.ProtocolID, acc))
+	bc := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption(), RegistryOption(&registry))
+	rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+	require.NoError(t, registry.Register(rolldpos.ProtocolID, rp))
+	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+	v := vote.NewProtocol(bc)
+	require.NoError(t, registry.Register(vote.ProtocolID, v))
+	bc.Validator().AddActionValidators(acc, v)
+	bc.GetFactory().AddActionHandlers(acc, v)
+	require.NoError(t, bc.Start(ctx))
+	defer require.NoError(t, bc.Stop(ctx))
+
+	addTestingTsfBlocks(bc)
+	addr0 := ta.Addrinfo["producer"].String()
+	priKey0 := ta.Keyinfo["producer"].PriKey
+	addr1 := ta.Addrinfo["alfa"].String()
+	tsf, err := testutil.SignedTransfer(addr1, priKey0, 7, big.NewInt(2), []byte{}, 100000,
+		big.NewInt(testutil.TestGasPrice))
+	require.NoError(t, err)
+
+	// no action is picked from the pool once the budget is used up, while the block is still minted
+	blk, err := bc.MintNewBlock(map[string][]action.SealedEnvelope{addr0: {tsf}}, 0, WithMintTimeBudget(0))
+	require.NoError(t, err)
+	for _, selp := range blk.Actions {
+		require.NotEqual(t, tsf.Hash(), selp.Hash())
+	}
+
+	blk, err = bc.MintNewBlock(map[string][]action.SealedEnvelope{addr0: {tsf}}, 0, WithMintTimeBudget(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, tsf.Hash(), blk.Actions[0].Hash())
+}
+
+func TestBlockchain_MintNewBlock_RevertedBundle(t *testing.T) {
+	ctx := context.Background()
+	cfg := config.Default
+	cfg.Genesis.BundleHeight = 0
+	registry := protocol.Registry{}
+	acc := account.NewProtocol()
+	require.NoError(t, registry.Register(account.ProtocolID, acc))
+	bc := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption(), RegistryOption(&registry))
+	rp := rolldpos.NewProtocol(cfg.Genesis.NumCandidateDelegates, cfg.Genesis.NumDelegates, cfg.Genesis.NumSubEpochs)
+	require.NoError(t, registry.Register(rolldpos.ProtocolID, rp))
+	bc.Validator().AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+	v := vote.NewProtocol(bc)
+	require.NoError(t, registry.Register(vote.ProtocolID, v))
+	bc.Validator().AddActionValidators(acc, v)
+	bc.GetFactory().AddActionHandlers(acc, v)
+	require.NoError(t, bc.Start(ctx))
+	defer require.NoError(t, bc.Stop(ctx))
+
+	addTestingTsfBlocks(bc)
+	addr0 := ta.Addrinfo["producer"].String()
+	priKey0 := ta.Keyinfo["producer"].PriKey
+	addr1 := ta.Addrinfo["alfa"].String()
+	tsf1, err := testutil.SignedTransfer(addr1, priKey0, 7, big.NewInt(2), []byte{}, 100000,
+		big.NewInt(testutil.TestGasPrice))
+	require.NoError(t, err)
+	unpayable, err := testutil.SignedTransfer(addr1, priKey0, 8, unit.ConvertIotxToRau(1000000000000), []byte{}, 100000,
+		big.NewInt(testutil.TestGasPrice))
+	require.NoError(t, err)
+	bundle, err := testutil.SignedBundle(priKey0, []action.Envelope{tsf1.Envelope, unpayable.Envelope})
+	require.NoError(t, err)
+	tsf3, err := testutil.SignedTransfer(addr1, priKey0, 9, big.NewInt(2), []byte{}, 100000,
+		big.NewInt(testutil.TestGasPrice))
+	require.NoError(t, err)
+
+	// the reverted bundle is left out along with the later actions of the sender, and is passed to the handler
+	var reverted []action.SealedEnvelope
+	blk, err := bc.MintNewBlock(
+		map[string][]action.SealedEnvelope{addr0: {bundle, tsf3}},
+		0,
+		WithRevertedBundleHandler(func(selp action.SealedEnvelope) {
+			reverted = append(reverted, selp)
+		}),
+	)
+	require.NoError(t, err)
+	for _, selp := range blk.Actions {
+		require.NotEqual(t, bundle.Hash(), selp.Hash())
+		require.NotEqual(t, tsf3.Hash(), selp.Hash())
+	}
+	require.Equal(t, []action.SealedEnvelope{bundle}, reverted)
+}
+
 type MockSubscriber struct {
 	counter int
 	mu      sync.RWMutex
@@ -15,6 +15,7 @@ import (
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
 	"github.com/iotexproject/iotex-core/pkg/compress"
 	"github.com/iotexproject/iotex-core/pkg/enc"
@@ -32,6 +33,8 @@ const (
 	blockActionReceiptMappingNS      = "a2r"
 	blockAddressActionMappingNS      = "a2a"
 	blockAddressActionCountMappingNS = "a2c"
+	blockLogHeightMappingNS          = "l2h"
+	blockLogCountMappingNS           = "l2c"
 	receiptsNS                       = "rpt"
 
 	hashOffset = 12
@@ -46,6 +49,23 @@ var (
 	actionToPrefix   = []byte("to.")
 )
 
+// chainSchema is the schema of chain.db, where the migrations upgrading it are registered in order
+var chainSchema = db.NewSchema(
+	"chain",
+	logIndexMigration,
+	db.KeyLayoutMigration(
+		blockNS,
+		blockHashHeightMappingNS,
+		blockActionBlockMappingNS,
+		blockActionReceiptMappingNS,
+		blockAddressActionMappingNS,
+		blockAddressActionCountMappingNS,
+		blockLogHeightMappingNS,
+		blockLogCountMappingNS,
+		receiptsNS,
+	),
+)
+
 var _ lifecycle.StartStopper = (*blockDAO)(nil)
 
 type blockDAO struct {
@@ -84,9 +104,15 @@ func (dao *blockDAO) Start(ctx context.Context) error {
 		return errors.Wrap(err, "failed to start child services")
 	}
 
+	// check the schema, where the DB without top height is a fresh one
+	_, err = dao.kvstore.Get(blockNS, topHeightKey)
+	fresh := err != nil && errors.Cause(err) == db.ErrNotExist
+	if err := chainSchema.Open(dao.kvstore, fresh); err != nil {
+		return errors.Wrap(err, "failed to open the schema of chain db")
+	}
+
 	// set init height value
-	if _, err = dao.kvstore.Get(blockNS, topHeightKey); err != nil &&
-		errors.Cause(err) == db.ErrNotExist {
+	if fresh {
 		if err := dao.kvstore.Put(blockNS, topHeightKey, make([]byte, 8)); err != nil {
 			return errors.Wrap(err, "failed to write initial value for top height")
 		}
@@ -103,6 +129,21 @@ func (dao *blockDAO) Start(ctx context.Context) error {
 	return nil
 }
 
+// MigrateChainDB migrates the chain db at cfg.Chain.ChainDBPath to the latest schema version
+func MigrateChainDB(ctx context.Context, cfg config.Config, dryRun bool) (err error) {
+	cfg.DB.DbPath = cfg.Chain.ChainDBPath
+	kvstore := db.NewOnDiskDB(cfg.DB)
+	if err := kvstore.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to open chain db")
+	}
+	defer func() {
+		if stopErr := kvstore.Stop(ctx); err == nil {
+			err = stopErr
+		}
+	}()
+	return chainSchema.Migrate(kvstore, dryRun)
+}
+
 // Stop stops block DAO.
 func (dao *blockDAO) Stop(ctx context.Context) error { return dao.lifecycle.OnStop(ctx) }
 
@@ -191,25 +232,39 @@ func (dao *blockDAO) getReceiptByActionHash(h hash.Hash256) (*action.Receipt, er
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get receipt index for action %x", h)
 	}
-	receiptsBytes, err := dao.kvstore.Get(receiptsNS, heightBytes)
+	receipts, err := dao.getReceipts(enc.MachineEndian.Uint64(heightBytes))
 	if err != nil {
-		height := enc.MachineEndian.Uint64(heightBytes)
-		return nil, errors.Wrapf(err, "failed to get receipts of block %d", height)
-	}
-	receipts := iotextypes.Receipts{}
-	if err := proto.Unmarshal(receiptsBytes, &receipts); err != nil {
 		return nil, err
 	}
-	for _, receipt := range receipts.Receipts {
-		r := action.Receipt{}
-		r.ConvertFromReceiptPb(receipt)
+	for _, r := range receipts {
 		if r.ActHash == h {
-			return &r, nil
+			return r, nil
 		}
 	}
 	return nil, errors.Errorf("receipt of action %x isn't found", h)
 }
 
+// getReceipts returns the receipts of the block at a height
+func (dao *blockDAO) getReceipts(blkHeight uint64) ([]*action.Receipt, error) {
+	var heightBytes [8]byte
+	enc.MachineEndian.PutUint64(heightBytes[:], blkHeight)
+	receiptsBytes, err := dao.kvstore.Get(receiptsNS, heightBytes[:])
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get receipts of block %d", blkHeight)
+	}
+	receiptsPb := iotextypes.Receipts{}
+	if err := proto.Unmarshal(receiptsBytes, &receiptsPb); err != nil {
+		return nil, err
+	}
+	receipts := make([]*action.Receipt, 0, len(receiptsPb.Receipts))
+	for _, receiptPb := range receiptsPb.Receipts {
+		r := &action.Receipt{}
+		r.ConvertFromReceiptPb(receiptPb)
+		receipts = append(receipts, r)
+	}
+	return receipts, nil
+}
+
 // putBlock puts a block
 func (dao *blockDAO) putBlock(blk *block.Block) error {
 	batch := db.NewBatch()
@@ -351,6 +406,15 @@ func (dao *blockDAO) deleteTipBlock() error {
 		return err
 	}
 
+	// the receipts are not stored with the block, so read them back to roll back the log indices
+	receipts, err := dao.getReceipts(blk.Height())
+	if err != nil && errors.Cause(err) != db.ErrNotExist {
+		return err
+	}
+	if err = deleteLogs(dao.kvstore, receipts, batch); err != nil {
+		return err
+	}
+
 	return dao.kvstore.Commit(batch)
 }
 
@@ -370,7 +434,7 @@ func deleteActions(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error
 	for _, selp := range blk.Actions {
 		callerAddrBytes := hash.BytesToHash160(selp.SrcPubkey().Hash())
 		senderCount[callerAddrBytes]++
-		if dst, ok := selp.Destination(); ok && dst != "" {
+		for _, dst := range selp.Destinations() {
 			dstAddr, err := address.FromString(dst)
 			if err != nil {
 				return err
@@ -423,27 +487,26 @@ func deleteActions(dao *blockDAO, blk *block.Block, batch db.KVStoreBatch) error
 		batch.Delete(blockAddressActionMappingNS, senderKey, "failed to delete action hash %x for sender %x",
 			actHash, callerAddrBytes)
 
-		dst, ok := selp.Destination()
-		if !ok || dst == "" {
-			continue
-		}
-		dstAddr, err := address.FromString(dst)
-		if err != nil {
-			return err
-		}
-		dstAddrBytes := hash.BytesToHash160(dstAddr.Bytes())
-		if delta, ok := recipientDelta[dstAddrBytes]; ok {
-			recipientCount[dstAddrBytes] += delta
-			recipientDelta[dstAddrBytes]++
-		} else {
-			recipientDelta[dstAddrBytes] = 1
-		}
+		// Delete new action to every recipient
+		for _, dst := range selp.Destinations() {
+			dstAddr, err := address.FromString(dst)
+			if err != nil {
+				return err
+			}
+			dstAddrBytes := hash.BytesToHash160(dstAddr.Bytes())
+			if delta, ok := recipientDelta[dstAddrBytes]; ok {
+				recipientCount[dstAddrBytes] += delta
+				recipientDelta[dstAddrBytes]++
+			} else {
+				recipientDelta[dstAddrBytes] = 1
+			}
 
-		// Delete new action to recipient
-		recipientKey := append(actionToPrefix, dstAddrBytes[:]...)
-		recipientKey = append(recipientKey, byteutil.Uint64ToBytes(recipientCount[dstAddrBytes])...)
-		batch.Delete(blockAddressActionMappingNS, recipientKey, "failed to delete action hash %x for recipient %x",
-			actHash, dstAddrBytes)
+			// Delete new action to recipient
+			recipientKey := append(actionToPrefix, dstAddrBytes[:]...)
+			recipientKey = append(recipientKey, byteutil.Uint64ToBytes(recipientCount[dstAddrBytes])...)
+			batch.Delete(blockAddressActionMappingNS, recipientKey, "failed to delete action hash %x for recipient %x",
+				actHash, dstAddrBytes)
+		}
 	}
 
 	return nil
@@ -370,6 +370,71 @@ func TestBlockDAO(t *testing.T) {
 	})
 }
 
+func TestBlockDao_indexMultiTransfer(t *testing.T) {
+	require := require.New(t)
+
+	bravo := testaddress.Addrinfo["bravo"]
+	charlie := testaddress.Addrinfo["charlie"]
+	mt, err := testutil.SignedMultiTransfer(testaddress.Keyinfo["alfa"].PriKey, 1, []action.TransferEntry{
+		{Recipient: bravo.String(), Amount: big.NewInt(1)},
+		{Recipient: charlie.String(), Amount: big.NewInt(2)},
+		{Recipient: bravo.String(), Amount: big.NewInt(3)},
+	}, testutil.TestGasLimit, big.NewInt(0))
+	require.NoError(err)
+	tsf, err := testutil.SignedTransfer(bravo.String(), testaddress.Keyinfo["charlie"].PriKey, 1, big.NewInt(1), nil, testutil.TestGasLimit, big.NewInt(0))
+	require.NoError(err)
+	blk, err := block.NewTestingBuilder().
+		SetHeight(1).
+		SetPrevBlockHash(hash.ZeroHash256).
+		SetTimeStamp(testutil.TimestampNow()).
+		AddActions(mt, tsf).
+		SignAndBuild(testaddress.Keyinfo["producer"].PubKey, testaddress.Keyinfo["producer"].PriKey)
+	require.NoError(err)
+
+	ctx := context.Background()
+	dao := newBlockDAO(db.NewMemKVStore(), true, false)
+	require.NoError(dao.Start(ctx))
+	defer func() {
+		require.NoError(dao.Stop(ctx))
+	}()
+	require.NoError(dao.putBlock(&blk))
+
+	// every recipient is indexed once
+	recipientActions, err := getActionsByRecipientAddress(dao.kvstore, hash.BytesToHash160(bravo.Bytes()))
+	require.NoError(err)
+	require.Equal([]hash.Hash256{mt.Hash(), tsf.Hash()}, recipientActions)
+	recipientActions, err = getActionsByRecipientAddress(dao.kvstore, hash.BytesToHash160(charlie.Bytes()))
+	require.NoError(err)
+	require.Equal([]hash.Hash256{mt.Hash()}, recipientActions)
+
+	require.NoError(dao.deleteTipBlock())
+	recipientActionCount, err := getActionCountByRecipientAddress(dao.kvstore, hash.BytesToHash160(bravo.Bytes()))
+	require.NoError(err)
+	require.Equal(uint64(0), recipientActionCount)
+	recipientActionCount, err = getActionCountByRecipientAddress(dao.kvstore, hash.BytesToHash160(charlie.Bytes()))
+	require.NoError(err)
+	require.Equal(uint64(0), recipientActionCount)
+}
+
+func TestBlockDAO_Schema(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kvstore := db.NewMemKVStore()
+	dao := newBlockDAO(kvstore, false, false)
+	require.NoError(dao.Start(ctx))
+	version, err := chainSchema.Version(kvstore)
+	require.NoError(err)
+	require.Equal(chainSchema.Latest(), version)
+	require.NoError(dao.Stop(ctx))
+
+	// refuse to open a db of another schema
+	kvstore = db.NewMemKVStore()
+	require.NoError(db.NewSchema("trie").Open(kvstore, true))
+	dao = newBlockDAO(kvstore, false, false)
+	require.Equal(db.ErrUnknownSchema, errors.Cause(dao.Start(ctx)))
+}
+
 func TestBlockDao_putReceipts(t *testing.T) {
 	blkDao := newBlockDAO(db.NewMemKVStore(), true, false)
 	receipts := []*action.Receipt{
@@ -396,4 +461,12 @@ func TestBlockDao_putReceipts(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, receipt.ActHash, r.ActHash)
 	}
+	rs, err := blkDao.getReceipts(1)
+	require.NoError(t, err)
+	require.Equal(t, len(receipts), len(rs))
+	for i, receipt := range receipts {
+		assert.Equal(t, receipt.ReturnValue, rs[i].ReturnValue)
+	}
+	_, err = blkDao.getReceipts(2)
+	require.Error(t, err)
 }
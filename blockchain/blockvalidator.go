@@ -18,6 +18,7 @@ import (
 	"github.com/iotexproject/iotex-core/action/protocol"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/blockchain/genesis"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
 	"github.com/iotexproject/iotex-core/pkg/log"
@@ -36,6 +37,7 @@ type Validator interface {
 type validator struct {
 	sf                       factory.Factory
 	validatorAddr            string
+	genesis                  genesis.Blockchain
 	actionEnvelopeValidators []protocol.ActionEnvelopeValidator
 	actionValidators         []protocol.ActionValidator
 }
@@ -156,13 +158,28 @@ func (v *validator) validateActions(
 		if err != nil {
 			return err
 		}
-		appendActionIndex(accountNonceMap, caller.String(), selp.Nonce())
+		if height >= v.genesis.ActionExpiryHeight && selp.Expired(height) {
+			return errors.Wrapf(
+				action.ErrExpired,
+				"action %x valid until height %d is in block %d",
+				selp.Hash(),
+				selp.ValidUntilHeight(),
+				height,
+			)
+		}
+		// A bundle takes the nonces of all the actions in it
+		for _, act := range action.Unbundle(selp) {
+			appendActionIndex(accountNonceMap, caller.String(), act.Nonce())
+		}
 		ctx := protocol.WithValidateActionsCtx(
 			context.Background(),
 			protocol.ValidateActionsCtx{
-				BlockHeight:  height,
-				ProducerAddr: producerAddr.String(),
-				Caller:       caller,
+				BlockHeight:         height,
+				ProducerAddr:        producerAddr.String(),
+				Caller:              caller,
+				Web3Height:          v.genesis.Web3Height,
+				BundleHeight:        v.genesis.BundleHeight,
+				MultiTransferHeight: v.genesis.MultiTransferHeight,
 			},
 		)
 
@@ -178,14 +195,16 @@ func (v *validator) validateActions(
 		}
 
 		for _, validator := range v.actionValidators {
-			wg.Add(1)
-			go func(validator protocol.ActionValidator, act action.Action) {
-				defer wg.Done()
-				if err := validator.Validate(ctx, act); err != nil {
-					errChan <- err
-					return
-				}
-			}(validator, selp.Action())
+			for _, act := range action.Unbundle(selp) {
+				wg.Add(1)
+				go func(validator protocol.ActionValidator, act action.Action) {
+					defer wg.Done()
+					if err := validator.Validate(ctx, act); err != nil {
+						errChan <- err
+						return
+					}
+				}(validator, act.Action())
+			}
 		}
 	}
 	wg.Wait()
@@ -238,6 +257,14 @@ func verifySigAndRoot(blk *block.Block) error {
 			hashActual,
 			hashExpect)
 	}
+
+	if bloomLen := len(blk.LogsBloom()); bloomLen != 0 && bloomLen != block.BloomByteLength {
+		return errors.Wrapf(
+			ErrInvalidBlock,
+			"wrong logs bloom length %d, expecting %d",
+			bloomLen,
+			block.BloomByteLength)
+	}
 	return nil
 }
 
@@ -316,6 +316,53 @@ func TestWrongAddress(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "error when validating contract's address"))
 }
 
+func TestExpiredAction(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+	cfg := config.Default
+	bc := NewBlockchain(cfg, InMemStateFactoryOption(), InMemDaoOption())
+	bc.GetFactory().AddActionHandlers(account.NewProtocol())
+	require.NoError(bc.Start(ctx))
+	defer func() {
+		require.NoError(bc.Stop(ctx))
+	}()
+	val := &validator{sf: bc.GetFactory(), validatorAddr: ""}
+	val.AddActionEnvelopeValidators(protocol.NewGenericValidator(bc, genesis.Default.ActionGasLimit))
+	val.AddActionValidators(account.NewProtocol())
+
+	blockOfActionValidUntil := func(validUntilHeight uint64) block.Block {
+		tsf, err := action.NewTransfer(1, big.NewInt(1), ta.Addrinfo["alfa"].String(), []byte{}, uint64(100000), big.NewInt(10))
+		require.NoError(err)
+		bd := &action.EnvelopeBuilder{}
+		elp := bd.SetAction(tsf).SetGasLimit(100000).
+			SetGasPrice(big.NewInt(10)).
+			SetNonce(1).
+			SetValidUntilHeight(validUntilHeight).Build()
+		selp, err := action.Sign(elp, ta.Keyinfo["producer"].PriKey)
+		require.NoError(err)
+		blk, err := block.NewTestingBuilder().
+			SetHeight(3).
+			SetPrevBlockHash(hash.ZeroHash256).
+			SetTimeStamp(testutil.TimestampNow()).
+			AddActions(selp).
+			SignAndBuild(ta.Keyinfo["producer"].PubKey, ta.Keyinfo["producer"].PriKey)
+		require.NoError(err)
+		return blk
+	}
+
+	for _, validUntilHeight := range []uint64{0, 3, 4} {
+		blk := blockOfActionValidUntil(validUntilHeight)
+		require.NoError(val.validateActionsOnly(blk.Actions, blk.PublicKey(), blk.Height()))
+	}
+	blk := blockOfActionValidUntil(2)
+	err := val.validateActionsOnly(blk.Actions, blk.PublicKey(), blk.Height())
+	require.Equal(action.ErrExpired, errors.Cause(err))
+
+	// the action does not expire below the activation height
+	val.genesis.ActionExpiryHeight = blk.Height() + 1
+	require.NoError(val.validateActionsOnly(blk.Actions, blk.PublicKey(), blk.Height()))
+}
+
 func TestCoinbaseTransferValidation(t *testing.T) {
 	t.Skip("It is skipped because testnet_actions.yaml doesn't match the chain ID")
 	ctx := context.Background()
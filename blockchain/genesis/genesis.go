@@ -8,6 +8,7 @@ package genesis
 
 import (
 	"flag"
+	"math"
 	"math/big"
 	"sort"
 	"time"
@@ -47,6 +48,12 @@ func initDefaultConfig() {
 			NumDelegates:          24,
 			NumCandidateDelegates: 36,
 			TimeBasedRotation:     false,
+			LogsBloomHeight:       math.MaxUint64,
+			EVMErrorStatusHeight:  math.MaxUint64,
+			Web3Height:            math.MaxUint64,
+			BundleHeight:          math.MaxUint64,
+			MultiTransferHeight:   math.MaxUint64,
+			ActionExpiryHeight:    math.MaxUint64,
 		},
 		Account: Account{
 			InitBalanceMap: make(map[string]string),
@@ -103,6 +110,26 @@ type (
 		NumCandidateDelegates uint64 `yaml:"numCandidateDelegates"`
 		// TimeBasedRotation is the flag to enable rotating delegates' time slots on a block height
 		TimeBasedRotation bool `yaml:"timeBasedRotation"`
+		// LogsBloomHeight is the height since which the block header carries the bloom of the logs emitted in the
+		// block. It is never reached by default, and the network sets it to the height of the upgrade
+		LogsBloomHeight uint64 `yaml:"logsBloomHeight"`
+		// EVMErrorStatusHeight is the height since which an execution hitting an error in the evm, like being reverted,
+		// gets the failure receipt status instead of the success one. It is never reached by default, and the network
+		// sets it to the height of the upgrade
+		EVMErrorStatusHeight uint64 `yaml:"evmErrorStatusHeight"`
+		// Web3Height is the height since which the chain serves web3 wallets: an action can be signed as an Ethereum
+		// transaction, and every receipt carries the hash of its action, so that it can be looked up by the hash. It is
+		// never reached by default, and the network sets it to the height of the upgrade
+		Web3Height uint64 `yaml:"web3Height"`
+		// BundleHeight is the height since which a bundle of actions from the same sender can be put into a block. It
+		// is never reached by default, and the network sets it to the height of the upgrade
+		BundleHeight uint64 `yaml:"bundleHeight"`
+		// MultiTransferHeight is the height since which a multi-transfer can be put into a block. It is never reached by
+		// default, and the network sets it to the height of the upgrade
+		MultiTransferHeight uint64 `yaml:"multiTransferHeight"`
+		// ActionExpiryHeight is the height since which an action can no longer be put into a block above its valid until
+		// height. It is never reached by default, and the network sets it to the height of the upgrade
+		ActionExpiryHeight uint64 `yaml:"actionExpiryHeight"`
 	}
 	// Account contains the configs for account protocol
 	Account struct {
@@ -129,6 +129,9 @@ func indexBlock(store db.KVStore, blk *block.Block, batch db.KVStoreBatch) error
 		batch.Put(blockActionBlockMappingNS, actHash[hashOffset:], hash[:], "failed to put action hash %x", actHash)
 	}
 
+	if err := putLogs(store, blk, batch); err != nil {
+		return err
+	}
 	return putActions(store, blk, batch)
 }
 
@@ -164,39 +167,38 @@ func putActions(store db.KVStore, blk *block.Block, batch db.KVStoreBatch) error
 			byteutil.Uint64ToBytes(senderActionCount+1),
 			"failed to bump action count %x for sender %x", actHash, callerAddrBytes)
 
-		dst, ok := selp.Destination()
-		if !ok || dst == "" {
-			continue
-		}
-		dstAddr, err := address.FromString(dst)
-		if err != nil {
-			return err
-		}
-		dstAddrBytes := hash.BytesToHash160(dstAddr.Bytes())
+		// put new action to every recipient
+		for _, dst := range selp.Destinations() {
+			dstAddr, err := address.FromString(dst)
+			if err != nil {
+				return err
+			}
+			dstAddrBytes := hash.BytesToHash160(dstAddr.Bytes())
 
-		// get action count for recipient
-		recipientActionCount, err := getActionCountByRecipientAddress(store, dstAddrBytes)
-		if err != nil {
-			return errors.Wrapf(err, "for recipient %x", dstAddrBytes)
-		}
-		if delta, ok := recipientDelta[dstAddrBytes]; ok {
-			recipientActionCount += delta
-			recipientDelta[dstAddrBytes]++
-		} else {
-			recipientDelta[dstAddrBytes] = 1
-		}
+			// get action count for recipient
+			recipientActionCount, err := getActionCountByRecipientAddress(store, dstAddrBytes)
+			if err != nil {
+				return errors.Wrapf(err, "for recipient %x", dstAddrBytes)
+			}
+			if delta, ok := recipientDelta[dstAddrBytes]; ok {
+				recipientActionCount += delta
+				recipientDelta[dstAddrBytes]++
+			} else {
+				recipientDelta[dstAddrBytes] = 1
+			}
 
-		// put new action to recipient
-		recipientKey := append(actionToPrefix, dstAddrBytes[:]...)
-		recipientKey = append(recipientKey, byteutil.Uint64ToBytes(recipientActionCount)...)
-		batch.Put(blockAddressActionMappingNS, recipientKey, actHash[:],
-			"failed to put action hash %x for recipient %x", actHash, dstAddrBytes)
-
-		// update recipient action count
-		recipientActionCountKey := append(actionToPrefix, dstAddrBytes[:]...)
-		batch.Put(blockAddressActionCountMappingNS, recipientActionCountKey,
-			byteutil.Uint64ToBytes(recipientActionCount+1), "failed to bump action count %x for recipient %x",
-			actHash, dstAddrBytes)
+			// put new action to recipient
+			recipientKey := append(actionToPrefix, dstAddrBytes[:]...)
+			recipientKey = append(recipientKey, byteutil.Uint64ToBytes(recipientActionCount)...)
+			batch.Put(blockAddressActionMappingNS, recipientKey, actHash[:],
+				"failed to put action hash %x for recipient %x", actHash, dstAddrBytes)
+
+			// update recipient action count
+			recipientActionCountKey := append(actionToPrefix, dstAddrBytes[:]...)
+			batch.Put(blockAddressActionCountMappingNS, recipientActionCountKey,
+				byteutil.Uint64ToBytes(recipientActionCount+1), "failed to bump action count %x for recipient %x",
+				actHash, dstAddrBytes)
+		}
 	}
 	return nil
 }
@@ -0,0 +1,449 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/address"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/enc"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// logIndexCheckpointInterval is the number of blocks between the checkpoints of the migration indexing the logs
+const logIndexCheckpointInterval = 1000
+
+var (
+	logAddressPrefix = []byte("la.")
+	logTopicPrefix   = []byte("lt.")
+
+	// logIndexMigration indexes the logs of the blocks written before the log index was added
+	logIndexMigration = db.Migration{Description: "index logs", Run: migrateLogIndex}
+)
+
+// LogFilter selects the receipt logs by their emitting address and topics, in the same way as eth_getLogs
+type LogFilter struct {
+	// Addresses lists the accepted emitting addresses, an empty list accepts any address
+	Addresses []string
+	// Topics lists the accepted topics for each position, an empty list at a position accepts any topic
+	Topics [][]hash.Hash256
+}
+
+// Match checks if a log passes the filter
+func (f *LogFilter) Match(log *action.Log) bool {
+	if len(f.Addresses) > 0 {
+		found := false
+		for _, addr := range f.Addresses {
+			if addr == log.Address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if len(f.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range topics {
+			if topic == log.Topics[i] {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// MayMatch checks against the logs bloom in the block header if the block may contain logs passing the filter, so
+// that the block can be skipped without reading its receipts
+func (f *LogFilter) MayMatch(header *block.Header) bool {
+	if len(header.LogsBloom()) == 0 {
+		return false
+	}
+	if len(f.Addresses) > 0 {
+		found := false
+		for _, addrStr := range f.Addresses {
+			addr, err := address.FromString(addrStr)
+			if err != nil {
+				continue
+			}
+			if header.MayContainLogAddress(addr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, topics := range f.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range topics {
+			if header.MayContainLogTopic(topic) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// isEmpty checks if the filter accepts any log
+func (f *LogFilter) isEmpty() bool {
+	if len(f.Addresses) > 0 {
+		return false
+	}
+	for _, topics := range f.Topics {
+		if len(topics) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// putLogs indexes the block height by the addresses and the topics of the logs emitted in the block
+func putLogs(store db.KVStore, blk *block.Block, batch db.KVStoreBatch) error {
+	keys, err := logIndexKeys(blk.Receipts)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		count, err := getLogIndexCount(store, key)
+		if err != nil {
+			return errors.Wrapf(err, "for log key %x", key)
+		}
+		putLogIndex(batch, key, count, blk.Height())
+	}
+	return nil
+}
+
+// putLogIndex appends the height to the indexed heights of a log key
+func putLogIndex(batch db.KVStoreBatch, key []byte, count uint64, height uint64) {
+	batch.Put(blockLogHeightMappingNS, logIndexKey(key, count), byteutil.Uint64ToBytes(height),
+		"failed to put height %d for log key %x", height, key)
+	batch.Put(blockLogCountMappingNS, key, byteutil.Uint64ToBytes(count+1),
+		"failed to bump log count for log key %x", key)
+}
+
+// deleteLogs rolls back the log indices of a block
+func deleteLogs(store db.KVStore, receipts []*action.Receipt, batch db.KVStoreBatch) error {
+	keys, err := logIndexKeys(receipts)
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		count, err := getLogIndexCount(store, key)
+		if err != nil {
+			return errors.Wrapf(err, "for log key %x", key)
+		}
+		if count == 0 {
+			continue
+		}
+		batch.Delete(blockLogHeightMappingNS, logIndexKey(key, count-1), "failed to delete height for log key %x", key)
+		batch.Put(blockLogCountMappingNS, key, byteutil.Uint64ToBytes(count-1),
+			"failed to update log count for log key %x", key)
+	}
+	return nil
+}
+
+// getLogs returns the logs which pass the filter in the blocks of [start, end]
+func getLogs(dao *blockDAO, filter *LogFilter, start uint64, end uint64) ([]*action.Log, error) {
+	heights, err := getLogHeights(dao.kvstore, filter, start, end)
+	if err != nil {
+		return nil, err
+	}
+	var logs []*action.Log
+	for _, height := range heights {
+		receipts, err := dao.getReceipts(height)
+		if err != nil {
+			if errors.Cause(err) == db.ErrNotExist {
+				continue
+			}
+			return nil, err
+		}
+		for _, r := range receipts {
+			for _, l := range r.Logs {
+				if filter.Match(l) {
+					logs = append(logs, l)
+				}
+			}
+		}
+	}
+	return logs, nil
+}
+
+// getLogHeights returns the heights in [start, end] of the blocks which may contain logs passing the filter
+func getLogHeights(store db.KVStore, filter *LogFilter, start uint64, end uint64) ([]uint64, error) {
+	if filter.isEmpty() {
+		heights := make([]uint64, 0, end-start+1)
+		for h := start; h <= end; h++ {
+			heights = append(heights, h)
+		}
+		return heights, nil
+	}
+	var candidates map[uint64]bool
+	// a block is a candidate if it matches one of the keys in each group
+	intersect := func(keys [][]byte) error {
+		matched := make(map[uint64]bool)
+		for _, key := range keys {
+			heights, err := getLogHeightsByKey(store, key, start, end)
+			if err != nil {
+				return err
+			}
+			for _, h := range heights {
+				if candidates == nil || candidates[h] {
+					matched[h] = true
+				}
+			}
+		}
+		candidates = matched
+		return nil
+	}
+	if len(filter.Addresses) > 0 {
+		keys := make([][]byte, 0, len(filter.Addresses))
+		for _, addrStr := range filter.Addresses {
+			addr, err := address.FromString(addrStr)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, logAddressKey(addr))
+		}
+		if err := intersect(keys); err != nil {
+			return nil, err
+		}
+	}
+	for _, topics := range filter.Topics {
+		if len(topics) == 0 {
+			continue
+		}
+		keys := make([][]byte, 0, len(topics))
+		for _, topic := range topics {
+			keys = append(keys, logTopicKey(topic))
+		}
+		if err := intersect(keys); err != nil {
+			return nil, err
+		}
+	}
+	heights := make([]uint64, 0, len(candidates))
+	for h := range candidates {
+		heights = append(heights, h)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+	return heights, nil
+}
+
+// getLogHeightsByKey returns the indexed heights in [start, end] of a log key
+func getLogHeightsByKey(store db.KVStore, key []byte, start uint64, end uint64) ([]uint64, error) {
+	count, err := getLogIndexCount(store, key)
+	if err != nil {
+		return nil, err
+	}
+	getHeight := func(i uint64) (uint64, error) { return getLogHeight(store, key, i) }
+	// heights are indexed in ascending order, so look for the first one not below start
+	var searchErr error
+	i := uint64(sort.Search(int(count), func(i int) bool {
+		h, err := getHeight(uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return h >= start
+	}))
+	if searchErr != nil {
+		return nil, searchErr
+	}
+	var heights []uint64
+	for ; i < count; i++ {
+		h, err := getHeight(i)
+		if err != nil {
+			return nil, err
+		}
+		if h > end {
+			break
+		}
+		heights = append(heights, h)
+	}
+	return heights, nil
+}
+
+// getLogHeight returns the i-th indexed height of a log key
+func getLogHeight(store db.KVStore, key []byte, i uint64) (uint64, error) {
+	value, err := store.Get(blockLogHeightMappingNS, logIndexKey(key, i))
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to get height for index %d", i)
+	}
+	if len(value) == 0 {
+		return 0, errors.Wrapf(db.ErrNotExist, "height for index %d missing", i)
+	}
+	return enc.MachineEndian.Uint64(value), nil
+}
+
+// getLogIndexCount returns the number of heights indexed for a log key
+func getLogIndexCount(store db.KVStore, key []byte) (uint64, error) {
+	value, err := store.Get(blockLogCountMappingNS, key)
+	if errors.Cause(err) == db.ErrNotExist {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get count of log heights")
+	}
+	if len(value) == 0 {
+		return 0, errors.New("count of log heights is broken")
+	}
+	return enc.MachineEndian.Uint64(value), nil
+}
+
+// migrateLogIndex builds the log index of a chain db which keeps the action index, as the log index is kept along
+// with it. The index is cleared before it is built from the first block, and a block is skipped for a log key which
+// indexes it already, so that a run resumed from the last checkpoint does not index a block twice
+func migrateLogIndex(kv db.KVStore, progress []byte, checkpoint func(progress []byte) error) error {
+	value, err := kv.Get(blockNS, totalActionsKey)
+	if errors.Cause(err) == db.ErrNotExist {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to get total actions")
+	}
+	if len(value) != 8 || enc.MachineEndian.Uint64(value) == 0 {
+		// no action is indexed, neither are the logs
+		return nil
+	}
+	if value, err = kv.Get(blockNS, topHeightKey); err != nil {
+		return errors.Wrap(err, "failed to get top height")
+	}
+	tipHeight := enc.MachineEndian.Uint64(value)
+
+	height := uint64(1)
+	if len(progress) == 8 {
+		height = byteutil.BytesToUint64(progress)
+	} else if err := clearLogIndex(kv); err != nil {
+		return err
+	}
+	dao := &blockDAO{kvstore: kv}
+	for ; height <= tipHeight; height++ {
+		if height%logIndexCheckpointInterval == 0 {
+			if err := checkpoint(byteutil.Uint64ToBytes(height)); err != nil {
+				return err
+			}
+		}
+		receipts, err := dao.getReceipts(height)
+		if errors.Cause(err) == db.ErrNotExist {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		keys, err := logIndexKeys(receipts)
+		if err != nil {
+			return err
+		}
+		batch := db.NewBatch()
+		for _, key := range keys {
+			count, err := getLogIndexCount(kv, key)
+			if err != nil {
+				return errors.Wrapf(err, "for log key %x", key)
+			}
+			if count > 0 {
+				last, err := getLogHeight(kv, key, count-1)
+				if err != nil {
+					return err
+				}
+				if last >= height {
+					continue
+				}
+			}
+			putLogIndex(batch, key, count, height)
+		}
+		if err := kv.Commit(batch); err != nil {
+			return errors.Wrapf(err, "failed to index logs of block %d", height)
+		}
+	}
+	return nil
+}
+
+// clearLogIndex deletes the log index
+func clearLogIndex(kv db.KVStore) error {
+	for _, ns := range []string{blockLogHeightMappingNS, blockLogCountMappingNS} {
+		batch := db.NewBatch()
+		if err := kv.Iterate(ns, nil, func(key, _ []byte) bool {
+			batch.Delete(ns, key, "failed to delete log index %x", key)
+			return true
+		}); err != nil {
+			return errors.Wrap(err, "failed to read log index")
+		}
+		if err := kv.Commit(batch); err != nil {
+			return errors.Wrap(err, "failed to clear log index")
+		}
+	}
+	return nil
+}
+
+// logIndexKeys returns the deduplicated log keys of the receipts
+func logIndexKeys(receipts []*action.Receipt) ([][]byte, error) {
+	var keys [][]byte
+	seen := make(map[string]bool)
+	add := func(key []byte) {
+		if !seen[string(key)] {
+			seen[string(key)] = true
+			keys = append(keys, key)
+		}
+	}
+	for _, r := range receipts {
+		for _, l := range r.Logs {
+			addr, err := address.FromString(l.Address)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid log address %s", l.Address)
+			}
+			add(logAddressKey(addr))
+			for _, topic := range l.Topics {
+				add(logTopicKey(topic))
+			}
+		}
+	}
+	return keys, nil
+}
+
+func logAddressKey(addr address.Address) []byte {
+	addrBytes := hash.BytesToHash160(addr.Bytes())
+	key := make([]byte, 0, len(logAddressPrefix)+len(addrBytes))
+	key = append(key, logAddressPrefix...)
+	return append(key, addrBytes[:]...)
+}
+
+func logTopicKey(topic hash.Hash256) []byte {
+	key := make([]byte, 0, len(logTopicPrefix)+len(topic))
+	key = append(key, logTopicPrefix...)
+	return append(key, topic[:]...)
+}
+
+func logIndexKey(key []byte, index uint64) []byte {
+	indexKey := make([]byte, 0, len(key)+8)
+	indexKey = append(indexKey, key...)
+	return append(indexKey, byteutil.Uint64ToBytes(index)...)
+}
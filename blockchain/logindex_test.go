@@ -0,0 +1,117 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/blockchain/block"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/hash"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestLogIndex(t *testing.T) {
+	require := require.New(t)
+
+	alfa := testaddress.Addrinfo["alfa"].String()
+	bravo := testaddress.Addrinfo["bravo"].String()
+	topic1 := hash.Hash256b([]byte("topic1"))
+	topic2 := hash.Hash256b([]byte("topic2"))
+	topic3 := hash.Hash256b([]byte("topic3"))
+
+	newBlock := func(height uint64, logs ...*action.Log) *block.Block {
+		blk, err := block.NewTestingBuilder().
+			SetHeight(height).
+			SetTimeStamp(testutil.TimestampNow()).
+			SetReceipts([]*action.Receipt{{ActHash: hash.Hash256b([]byte{byte(height)}), Logs: logs}}).
+			SignAndBuild(testaddress.Keyinfo["producer"].PubKey, testaddress.Keyinfo["producer"].PriKey)
+		require.NoError(err)
+		for _, l := range logs {
+			l.BlockNumber = height
+		}
+		return &blk
+	}
+	blks := []*block.Block{
+		newBlock(1, &action.Log{Address: alfa, Topics: []hash.Hash256{topic1, topic2}}),
+		newBlock(2),
+		newBlock(3, &action.Log{Address: bravo, Topics: []hash.Hash256{topic1}}),
+		newBlock(4,
+			&action.Log{Address: alfa, Topics: []hash.Hash256{topic2}},
+			&action.Log{Address: bravo, Topics: []hash.Hash256{topic1, topic3}},
+		),
+	}
+
+	dao := newBlockDAO(db.NewMemKVStore(), true, false)
+	require.NoError(dao.Start(context.Background()))
+	defer func() {
+		require.NoError(dao.Stop(context.Background()))
+	}()
+	for _, blk := range blks {
+		require.NoError(dao.putBlock(blk))
+		require.NoError(dao.putReceipts(blk.Height(), blk.Receipts))
+	}
+
+	tests := []struct {
+		filter  *LogFilter
+		start   uint64
+		end     uint64
+		heights []uint64
+	}{
+		{&LogFilter{}, 1, 4, []uint64{1, 3, 4, 4}},
+		{&LogFilter{Addresses: []string{alfa}}, 1, 4, []uint64{1, 4}},
+		{&LogFilter{Addresses: []string{alfa}}, 2, 4, []uint64{4}},
+		{&LogFilter{Addresses: []string{alfa, bravo}}, 1, 3, []uint64{1, 3}},
+		{&LogFilter{Topics: [][]hash.Hash256{{topic1}}}, 1, 4, []uint64{1, 3, 4}},
+		{&LogFilter{Topics: [][]hash.Hash256{{}, {topic2, topic3}}}, 1, 4, []uint64{1, 4}},
+		{&LogFilter{Addresses: []string{bravo}, Topics: [][]hash.Hash256{{topic2}}}, 1, 4, nil},
+		{&LogFilter{Topics: [][]hash.Hash256{{topic3}}}, 1, 3, nil},
+	}
+	checkLogs := func() {
+		for _, test := range tests {
+			logs, err := getLogs(dao, test.filter, test.start, test.end)
+			require.NoError(err)
+			var heights []uint64
+			for _, l := range logs {
+				heights = append(heights, l.BlockNumber)
+			}
+			require.Equal(test.heights, heights)
+		}
+	}
+	checkLogs()
+	for _, test := range tests {
+		// the header bloom never rules out a block having matched logs
+		for _, h := range test.heights {
+			require.True(test.filter.MayMatch(&blks[h-1].Header))
+		}
+	}
+
+	// the migration builds the same index from the receipts, and a resumed run indexes no block twice
+	require.NoError(dao.kvstore.Put(blockNS, totalActionsKey, byteutil.Uint64ToBytes(1)))
+	noCheckpoint := func([]byte) error { return nil }
+	require.NoError(migrateLogIndex(dao.kvstore, nil, noCheckpoint))
+	checkLogs()
+	require.NoError(migrateLogIndex(dao.kvstore, byteutil.Uint64ToBytes(2), noCheckpoint))
+	checkLogs()
+	require.False((&LogFilter{}).MayMatch(&blks[1].Header))
+	require.False((&LogFilter{Topics: [][]hash.Hash256{{topic3}}}).MayMatch(&blks[0].Header))
+
+	// roll back the tip block
+	require.NoError(dao.deleteTipBlock())
+	logs, err := getLogs(dao, &LogFilter{Addresses: []string{alfa}}, 1, 4)
+	require.NoError(err)
+	require.Equal(1, len(logs))
+	heights, err := getLogHeightsByKey(dao.kvstore, logTopicKey(topic3), 1, 4)
+	require.NoError(err)
+	require.Equal(0, len(heights))
+}
@@ -9,6 +9,7 @@ package chainservice
 import (
 	"context"
 	"os"
+	"path/filepath"
 
 	"github.com/golang/protobuf/proto"
 	peerstore "github.com/libp2p/go-libp2p-peerstore"
@@ -46,9 +47,11 @@ type ChainService struct {
 	consensus         consensus.Consensus
 	chain             blockchain.Blockchain
 	electionCommittee committee.Committee
+	gravityChainDB    db.KVStore
 	rDPoSProtocol     *rolldpos.Protocol
 	explorer          *explorer.Server
 	api               *api.Server
+	web3              *api.Web3Server
 	indexBuilder      *blockchain.IndexBuilder
 	indexservice      *indexservice.Server
 	registry          *protocol.Registry
@@ -109,6 +112,7 @@ func New(
 	registry := protocol.Registry{}
 	chainOpts = append(chainOpts, blockchain.RegistryOption(&registry))
 	var electionCommittee committee.Committee
+	var gravityChainDB db.KVStore
 	if cfg.Genesis.EnableGravityChainVoting {
 		committeeConfig := cfg.Chain.Committee
 		committeeConfig.BeaconChainStartHeight = cfg.Genesis.GravityChainStartHeight
@@ -120,7 +124,7 @@ func New(
 		committeeConfig.StakingContractAddress = cfg.Genesis.StakingContractAddress
 		committeeConfig.SelfStakingThreshold = cfg.Genesis.SelfStakingThreshold
 
-		kvstore := db.NewOnDiskDB(cfg.Chain.GravityChainDB)
+		kvstore := db.NewInstrumentedKVStore("gravitychain", db.NewOnDiskDB(cfg.Chain.GravityChainDB))
 		if committeeConfig.BeaconChainStartHeight != 0 {
 			if electionCommittee, err = committee.NewCommitteeWithKVStoreWithNamespace(
 				kvstore,
@@ -128,6 +132,7 @@ func New(
 			); err != nil {
 				return nil, err
 			}
+			gravityChainDB = kvstore
 		}
 	}
 	// create Blockchain
@@ -154,7 +159,14 @@ func New(
 	}
 
 	// Create ActPool
-	actPool, err := actpool.NewActPool(chain, cfg.ActPool)
+	actPool, err := actpool.NewActPool(
+		chain,
+		cfg.ActPool,
+		actpool.WithBroadcastOutbound(func(ctx context.Context, chainID uint32, msg proto.Message) error {
+			ctx = p2p.WitContext(ctx, p2p.Context{ChainID: chainID})
+			return p2pAgent.BroadcastOutbound(ctx, msg)
+		}),
+	)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create actpool")
 	}
@@ -238,6 +250,16 @@ func New(
 		}
 	}
 
+	var web3Svr *api.Web3Server
+	if _, ok := cfg.Plugins[config.Web3Plugin]; ok {
+		if apiSvr == nil {
+			return nil, errors.New("web3 plugin requires gateway plugin")
+		}
+		if web3Svr, err = api.NewWeb3Server(cfg.API, cfg.Genesis, apiSvr); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ChainService{
 		actpool:           actPool,
 		chain:             chain,
@@ -245,10 +267,12 @@ func New(
 		consensus:         consensus,
 		rDPoSProtocol:     rDPoSProtocol,
 		electionCommittee: electionCommittee,
+		gravityChainDB:    gravityChainDB,
 		indexservice:      idx,
 		indexBuilder:      indexBuilder,
 		explorer:          exp,
 		api:               apiSvr,
+		web3:              web3Svr,
 		registry:          &registry,
 	}, nil
 }
@@ -268,6 +292,9 @@ func (cs *ChainService) Start(ctx context.Context) error {
 	if err := cs.chain.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting blockchain")
 	}
+	if err := cs.actpool.Start(ctx); err != nil {
+		return errors.Wrap(err, "error when starting actpool")
+	}
 	if err := cs.consensus.Start(ctx); err != nil {
 		return errors.Wrap(err, "error when starting consensus")
 	}
@@ -284,6 +311,11 @@ func (cs *ChainService) Start(ctx context.Context) error {
 			return errors.Wrap(err, "err when starting API server")
 		}
 	}
+	if cs.web3 != nil {
+		if err := cs.web3.Start(); err != nil {
+			return errors.Wrap(err, "err when starting web3 server")
+		}
+	}
 	if cs.indexBuilder != nil {
 		if err := cs.indexBuilder.Start(ctx); err != nil {
 			return errors.Wrap(err, "error when starting index builder")
@@ -304,6 +336,11 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 			return errors.Wrap(err, "error when stopping explorer")
 		}
 	}
+	if cs.web3 != nil {
+		if err := cs.web3.Stop(); err != nil {
+			return errors.Wrap(err, "error when stopping web3 server")
+		}
+	}
 	if cs.api != nil {
 		if err := cs.api.Stop(); err != nil {
 			return errors.Wrap(err, "error when stopping API server")
@@ -320,18 +357,61 @@ func (cs *ChainService) Stop(ctx context.Context) error {
 	if err := cs.blocksync.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blocksync")
 	}
+	if err := cs.actpool.Stop(ctx); err != nil {
+		return errors.Wrap(err, "error when stopping actpool")
+	}
 	if err := cs.chain.Stop(ctx); err != nil {
 		return errors.Wrap(err, "error when stopping blockchain")
 	}
 	return nil
 }
 
+// Backup writes a consistent backup of the chain db, the trie db and the poll db with a manifest into dir, which
+// must not exist yet, while the chain service keeps running
+func (cs *ChainService) Backup(dir string) (*blockchain.BackupManifest, error) {
+	if _, err := os.Stat(dir); err == nil {
+		return nil, errors.Errorf("backup directory %s already exists", dir)
+	}
+	// the poll db is written by the committee independently of the blocks, so its snapshot needn't pause the commits.
+	// It is backed up first and released, so that a poll db unable to be backed up fails the backup before anything
+	// is written, and the commits of a poll db on BoltDB wait at most for its own copy
+	var pollDB string
+	if cs.gravityChainDB != nil {
+		pollSnapshot, err := db.TakeSnapshot(cs.gravityChainDB)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to take snapshot of poll db")
+		}
+		err = os.MkdirAll(dir, 0700)
+		if err == nil {
+			err = pollSnapshot.Backup(filepath.Join(dir, blockchain.BackupPollDBFile))
+		}
+		pollSnapshot.Release()
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to back up poll db")
+		}
+		pollDB = blockchain.BackupPollDBFile
+	}
+	manifest, err := cs.chain.Backup(dir)
+	if err != nil {
+		return nil, err
+	}
+	manifest.PollDB = pollDB
+	if err := blockchain.WriteBackupManifest(dir, manifest); err != nil {
+		return nil, err
+	}
+	log.L().Info("Backed up chain service.", zap.Uint64("height", manifest.Height), zap.String("dir", dir))
+	return manifest, nil
+}
+
 // HandleAction handles incoming action request.
-func (cs *ChainService) HandleAction(_ context.Context, actPb *iotextypes.Action) error {
+func (cs *ChainService) HandleAction(ctx context.Context, actPb *iotextypes.Action) error {
 	var act action.SealedEnvelope
 	if err := act.LoadProto(actPb); err != nil {
 		return err
 	}
+	if p2pCtx, ok := p2p.GetContext(ctx); ok && p2pCtx.Peer != "" {
+		return cs.actpool.AddFromPeer(act, p2pCtx.Peer)
+	}
 	return cs.actpool.Add(act)
 }
 
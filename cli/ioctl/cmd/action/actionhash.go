@@ -123,10 +123,17 @@ func printActionProto(action *iotextypes.Action) (string, error) {
 
 func printReceiptProto(receipt *iotextypes.Receipt) string {
 	status := []string{"Fail", "Success"}
-	return fmt.Sprintf("returnValue %x\n", receipt.ReturnValue) +
+	output := fmt.Sprintf("returnValue %x\n", receipt.ReturnValue) +
 		fmt.Sprintf("status: %d (%s)\n", receipt.Status, status[receipt.Status]) +
 		fmt.Sprintf("actHash: %x\n", receipt.ActHash) +
 		fmt.Sprintf("gasConsumed: %d\n", receipt.GasConsumed) +
 		fmt.Sprintf("contractAddress: %s\n", receipt.ContractAddress)
+	if receipt.ErrorClass != iotextypes.ExecutionErrorClass_ExecutionNoError {
+		output += fmt.Sprintf("errorClass: %s\n", receipt.ErrorClass)
+	}
+	if receipt.RevertReason != "" {
+		output += fmt.Sprintf("revertReason: %s\n", receipt.RevertReason)
+	}
 	//TODO: print logs
+	return output
 }
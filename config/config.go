@@ -8,6 +8,7 @@ package config
 
 import (
 	"flag"
+	"math/big"
 	"os"
 	"strings"
 	"time"
@@ -65,6 +66,8 @@ const (
 const (
 	// GatewayPlugin is the plugin of accepting user API requests and serving blockchain data to users
 	GatewayPlugin = iota
+	// Web3Plugin is the plugin of serving the gateway API in Ethereum JSON-RPC to web3 tools
+	Web3Plugin
 )
 
 type strs []string
@@ -105,12 +108,22 @@ var (
 			EnableTrielessStateDB:   true,
 			EnableAsyncIndexWrite:   true,
 			CompressBlock:           false,
+			EnableArchiveMode:       false,
 			AllowedBlockGasResidue:  10000,
 		},
 		ActPool: ActPool{
-			MaxNumActsPerPool: 32000,
-			MaxNumActsPerAcct: 2000,
-			ActionExpiry:      10 * time.Minute,
+			MaxNumActsPerPool:             32000,
+			MaxNumActsPerAcct:             2000,
+			ActionExpiry:                  10 * time.Minute,
+			MinGasPriceBumpPercent:        10,
+			JournalPath:                   "",
+			JournalCompactInterval:        time.Minute,
+			LocalActionExpiry:             time.Hour,
+			LocalRebroadcastInterval:      5,
+			MaxLocalRebroadcastInterval:   80,
+			MinGasPrice:                   "0",
+			MaxActsPerSenderPerSecond:     0,
+			MaxGossipActsPerPeerPerSecond: 0,
 		},
 		Consensus: Consensus{
 			Scheme: StandaloneScheme,
@@ -125,6 +138,7 @@ var (
 				},
 				ToleratedOvertime: 2 * time.Second,
 				Delay:             5 * time.Second,
+				MintTimeBudget:    0,
 			},
 		},
 		BlockSync: BlockSync{
@@ -150,12 +164,14 @@ var (
 		API: API{
 			UseRDS:    false,
 			Port:      14014,
+			Web3Port:  15014,
 			TpsWindow: 10,
 			GasStation: GasStation{
 				SuggestBlockWindow: 20,
 				DefaultGas:         1,
 				Percentile:         60,
 			},
+			RangeQueryLimit: 1000,
 		},
 		Indexer: Indexer{
 			Enabled:           false,
@@ -172,6 +188,7 @@ var (
 		},
 		DB: DB{
 			UseBadgerDB: false,
+			UseLevelDB:  false,
 			NumRetries:  3,
 			SQLITE3: SQLITE3{
 				SQLite3File: "./explorer.db",
@@ -185,6 +202,7 @@ var (
 
 	// Validates is the collection config validation functions
 	Validates = []Validate{
+		ValidateChain,
 		ValidateRollDPoS,
 		ValidateDispatcher,
 		ValidateExplorer,
@@ -224,6 +242,8 @@ type (
 		EnableAsyncIndexWrite bool `yaml:"enableAsyncIndexWrite"`
 		// CompressBlock enables gzip compression on block data
 		CompressBlock bool `yaml:"compressBlock"`
+		// EnableArchiveMode keeps the state trie of every height, so that the historical state could be read
+		EnableArchiveMode bool `yaml:"enableArchiveMode"`
 		// AllowedBlockGasResidue is the amount of gas remained when block producer could stop processing more actions
 		AllowedBlockGasResidue uint64 `yaml:"allowedBlockGasResidue"`
 	}
@@ -247,6 +267,10 @@ type (
 		FSM               consensusfsm.Config `yaml:"fsm"`
 		ToleratedOvertime time.Duration       `yaml:"toleratedOvertime"`
 		Delay             time.Duration       `yaml:"delay"`
+		// MintTimeBudget is the time since the start of a round in which the proposer picks actions into the block,
+		// which leaves the rest of AcceptBlockTTL to broadcast and validate the block. It is half of AcceptBlockTTL if
+		// it is zero
+		MintTimeBudget time.Duration `yaml:"mintTimeBudget"`
 	}
 
 	// Dispatcher is the dispatcher config
@@ -270,8 +294,11 @@ type (
 	API struct {
 		UseRDS     bool       `yaml:"useRDS"`
 		Port       int        `yaml:"port"`
+		Web3Port   int        `yaml:"web3Port"`
 		TpsWindow  int        `yaml:"tpsWindow"`
 		GasStation GasStation `yaml:"gasStation"`
+		// RangeQueryLimit is the maximum number of blocks a range query could cover
+		RangeQueryLimit uint64 `yaml:"rangeQueryLimit"`
 	}
 
 	// GasStation is the gas station config
@@ -308,8 +335,33 @@ type (
 		MaxNumActsPerPool uint64 `yaml:"maxNumActsPerPool"`
 		// MaxNumActsPerAcct indicates maximum number of actions an account queue can hold
 		MaxNumActsPerAcct uint64 `yaml:"maxNumActsPerAcct"`
-		// ActionExpiry defines how long an action will be kept in action pool.
+		// ActionExpiry defines how long an action will be kept in action pool. It is a local policy of this node,
+		// while the action which must not be included after some height carries its own valid until height
 		ActionExpiry time.Duration `yaml:"actionExpiry"`
+		// MinGasPriceBumpPercent is the minimum percentage by which the gas price of an action has to be higher
+		// than the one of the pending action with the same nonce to replace it
+		MinGasPriceBumpPercent uint64 `yaml:"minGasPriceBumpPercent"`
+		// JournalPath is the path of the file journaling the accepted actions, so that they survive a restart. The
+		// journal is disabled if the path is empty
+		JournalPath string `yaml:"journalPath"`
+		// JournalCompactInterval indicates how often the journal is compacted to the actions remaining in pool
+		JournalCompactInterval time.Duration `yaml:"journalCompactInterval"`
+		// LocalActionExpiry defines how long an action submitted to this node will be kept in action pool, which is
+		// supposed to be longer than ActionExpiry
+		LocalActionExpiry time.Duration `yaml:"localActionExpiry"`
+		// LocalRebroadcastInterval is the number of blocks after which an action submitted to this node is broadcast
+		// again if it is not included yet. Re-broadcast is disabled if it is zero
+		LocalRebroadcastInterval uint64 `yaml:"localRebroadcastInterval"`
+		// MaxLocalRebroadcastInterval caps the interval, which doubles after each re-broadcast
+		MaxLocalRebroadcastInterval uint64 `yaml:"maxLocalRebroadcastInterval"`
+		// MinGasPrice is the minimum gas price in Rau of the actions accepted by this node, regardless of the chain
+		MinGasPrice string `yaml:"minGasPrice"`
+		// MaxActsPerSenderPerSecond limits the number of actions of a sender accepted per second. The limit is
+		// disabled if it is zero
+		MaxActsPerSenderPerSecond uint64 `yaml:"maxActsPerSenderPerSecond"`
+		// MaxGossipActsPerPeerPerSecond limits the number of actions gossiped by a peer per second. The limit is
+		// disabled if it is zero
+		MaxGossipActsPerPeerPerSecond uint64 `yaml:"maxGossipActsPerPeerPerSecond"`
 	}
 
 	// DB is the config for database
@@ -317,6 +369,8 @@ type (
 		DbPath string `yaml:"dbPath"`
 		// Use BadgerDB, otherwise use BoltDB
 		UseBadgerDB bool `yaml:"useBadgerDB"`
+		// Use LevelDB if not using BadgerDB, otherwise use BoltDB
+		UseLevelDB bool `yaml:"useLevelDB"`
 		// NumRetries is the number of retries
 		NumRetries uint8 `yaml:"numRetries"`
 
@@ -402,6 +456,8 @@ func New(validates ...Validate) (Config, error) {
 		switch strings.ToLower(plugin) {
 		case "gateway":
 			cfg.Plugins[GatewayPlugin] = nil
+		case "web3":
+			cfg.Plugins[Web3Plugin] = nil
 		default:
 			return Config{}, errors.Errorf("Plugin %s is not supported", plugin)
 		}
@@ -478,6 +534,26 @@ func (cfg Config) ProducerPrivateKey() keypair.PrivateKey {
 	return sk
 }
 
+// MinGasPriceRau returns the configured minimum gas price of the actions accepted by this node
+func (ap ActPool) MinGasPriceRau() (*big.Int, error) {
+	if ap.MinGasPrice == "" {
+		return big.NewInt(0), nil
+	}
+	price, ok := new(big.Int).SetString(ap.MinGasPrice, 10)
+	if !ok || price.Sign() < 0 {
+		return nil, errors.Wrapf(ErrInvalidCfg, "invalid minimum gas price %s", ap.MinGasPrice)
+	}
+	return price, nil
+}
+
+// ValidateChain validates the chain configs
+func ValidateChain(cfg Config) error {
+	if cfg.Chain.EnableArchiveMode && cfg.Chain.EnableTrielessStateDB {
+		return errors.Wrap(ErrInvalidCfg, "archive mode requires the trie state db")
+	}
+	return nil
+}
+
 // ValidateDispatcher validates the dispatcher configs
 func ValidateDispatcher(cfg Config) error {
 	if cfg.Dispatcher.EventChanSize <= 0 {
@@ -486,6 +562,14 @@ func ValidateDispatcher(cfg Config) error {
 	return nil
 }
 
+// MintBudget returns the time since the start of a round in which the proposer picks actions into the block
+func (r RollDPoS) MintBudget() time.Duration {
+	if r.MintTimeBudget == 0 {
+		return r.FSM.AcceptBlockTTL / 2
+	}
+	return r.MintTimeBudget
+}
+
 // ValidateRollDPoS validates the roll-DPoS configs
 func ValidateRollDPoS(cfg Config) error {
 	if cfg.Consensus.Scheme != RollDPoSScheme {
@@ -496,6 +580,9 @@ func ValidateRollDPoS(cfg Config) error {
 	if fsm.EventChanSize <= 0 {
 		return errors.Wrap(ErrInvalidCfg, "roll-DPoS event chan size should be greater than 0")
 	}
+	if budget := rollDPoS.MintBudget(); budget <= 0 || budget >= fsm.AcceptBlockTTL {
+		return errors.Wrap(ErrInvalidCfg, "roll-DPoS mint time budget should be positive and less than accept block ttl")
+	}
 	return nil
 }
 
@@ -531,6 +618,9 @@ func ValidateActPool(cfg Config) error {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		)
 	}
+	if _, err := cfg.ActPool.MinGasPriceRau(); err != nil {
+		return err
+	}
 	return nil
 }
 
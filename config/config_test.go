@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -147,6 +148,19 @@ chain:
 	require.NotNil(t, cfg)
 }
 
+func TestValidateChain(t *testing.T) {
+	cfg := Default
+	cfg.Chain.EnableArchiveMode = true
+	cfg.Chain.EnableTrielessStateDB = true
+	err := ValidateChain(cfg)
+	require.NotNil(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "archive mode requires the trie state db"))
+
+	cfg.Chain.EnableTrielessStateDB = false
+	require.NoError(t, ValidateChain(cfg))
+}
+
 func TestValidateExplorer(t *testing.T) {
 	cfg := Default
 	cfg.Explorer.Enabled = true
@@ -184,6 +198,22 @@ func TestValidateRollDPoS(t *testing.T) {
 		t,
 		strings.Contains(err.Error(), "roll-DPoS event chan size should be greater than 0"),
 	)
+
+	cfg.Consensus.RollDPoS.FSM.EventChanSize = 10000
+	cfg.Consensus.RollDPoS.MintTimeBudget = cfg.Consensus.RollDPoS.FSM.AcceptBlockTTL
+	err = ValidateRollDPoS(cfg)
+	require.NotNil(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(
+		t,
+		strings.Contains(err.Error(), "roll-DPoS mint time budget should be positive and less than accept block ttl"),
+	)
+
+	// the default budget is derived from the accept block ttl
+	cfg.Consensus.RollDPoS.MintTimeBudget = 0
+	cfg.Consensus.RollDPoS.FSM.AcceptBlockTTL = time.Second
+	require.NoError(t, ValidateRollDPoS(cfg))
+	require.Equal(t, 500*time.Millisecond, cfg.Consensus.RollDPoS.MintBudget())
 }
 
 func TestValidateActPool(t *testing.T) {
@@ -224,4 +254,17 @@ func TestValidateActPool(t *testing.T) {
 			"maximum number of actions per pool cannot be less than maximum number of actions per account",
 		),
 	)
+
+	cfg.ActPool.MaxNumActsPerPool = 100
+	cfg.ActPool.MinGasPrice = "-1"
+	err = ValidateActPool(cfg)
+	require.NotNil(t, err)
+	require.Equal(t, ErrInvalidCfg, errors.Cause(err))
+	require.True(t, strings.Contains(err.Error(), "invalid minimum gas price"))
+
+	cfg.ActPool.MinGasPrice = "1000000000000"
+	require.NoError(t, ValidateActPool(cfg))
+	price, err := cfg.ActPool.MinGasPriceRau()
+	require.NoError(t, err)
+	require.Equal(t, "1000000000000", price.String())
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	rp "github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/address"
@@ -97,7 +98,13 @@ func NewConsensus(
 	mintBlockCB := func() (*block.Block, error) {
 		actionMap := ap.PendingActionMap()
 		log.L().Debug("Pick actions.", zap.Int("actions", len(actionMap)))
-		blk, err := bc.MintNewBlock(actionMap, clock.Now().Unix())
+		blk, err := bc.MintNewBlock(
+			actionMap,
+			clock.Now().Unix(),
+			blockchain.WithRevertedBundleHandler(func(selp action.SealedEnvelope) {
+				ap.DropAction(selp, action.ErrBundleReverted)
+			}),
+		)
 		if err != nil {
 			log.L().Error("Failed to mint a block.", zap.Error(err))
 			return nil, err
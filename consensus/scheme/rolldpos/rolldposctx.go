@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
 
+	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/action/protocol/rolldpos"
 	"github.com/iotexproject/iotex-core/actpool"
 	"github.com/iotexproject/iotex-core/blockchain"
@@ -156,9 +157,15 @@ func (ctx *rollDPoSCtx) MintBlock() (consensusfsm.Endorsement, error) {
 	if blk == nil {
 		actionMap := ctx.actPool.PendingActionMap()
 		log.L().Debug("Pick actions from the action pool.", zap.Int("action", len(actionMap)))
+		// The budget counts from the start of the round, as the other delegates wait for the block since then
+		budget := ctx.round.timestamp.Add(ctx.cfg.MintBudget()).Sub(ctx.clock.Now())
 		b, err := ctx.chain.MintNewBlock(
 			actionMap,
 			ctx.round.timestamp.Unix(),
+			blockchain.WithMintTimeBudget(budget),
+			blockchain.WithRevertedBundleHandler(func(selp action.SealedEnvelope) {
+				ctx.actPool.DropAction(selp, action.ErrBundleReverted)
+			}),
 		)
 		if err != nil {
 			return nil, err
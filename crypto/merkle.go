@@ -7,14 +7,17 @@
 package crypto
 
 import (
+	"github.com/pkg/errors"
+
 	"github.com/iotexproject/iotex-core/pkg/hash"
 )
 
 // Merkle tree struct
 type Merkle struct {
-	root hash.Hash256
-	leaf []hash.Hash256
-	size int
+	root   hash.Hash256
+	leaf   []hash.Hash256
+	size   int
+	leaves int // the number of the leaves given, while size counts the copied last one for an odd number
 }
 
 // NewMerkleTree creates a merkle tree given hashed leaves
@@ -25,8 +28,9 @@ func NewMerkleTree(leaves []hash.Hash256) *Merkle {
 	}
 
 	mk := &Merkle{
-		leaf: make([]hash.Hash256, (size+1)>>1<<1),
-		size: size,
+		leaf:   make([]hash.Hash256, (size+1)>>1<<1),
+		size:   size,
+		leaves: size,
 	}
 
 	copy(mk.leaf, leaves)
@@ -79,3 +83,49 @@ func (mk *Merkle) HashTree() hash.Hash256 {
 	mk.root = merkle[0]
 	return mk.root
 }
+
+// Proof returns the Merkle path of the leaf at the index, which are the sibling hashes from the leaf up to the root
+func (mk *Merkle) Proof(index int) ([]hash.Hash256, error) {
+	if index < 0 || index >= mk.leaves {
+		return nil, errors.Errorf("index %d is out of the range of %d leaves", index, mk.leaves)
+	}
+	path := []hash.Hash256{}
+	merkle := mk.leaf[:mk.size]
+	for length := len(merkle); length > 1; length = len(merkle) {
+		if length&1 != 0 {
+			merkle = append(merkle, merkle[length-1])
+			length++
+		}
+		path = append(path, merkle[index^1])
+
+		length >>= 1
+		parents := make([]hash.Hash256, length)
+		for i := 0; i < length; i++ {
+			h := merkle[i<<1][:]
+			h = append(h, merkle[i<<1+1][:]...)
+			parents[i] = hash.Hash256b(h)
+		}
+		merkle = parents
+		index >>= 1
+	}
+	return path, nil
+}
+
+// VerifyMerkleProof verifies the Merkle path of the leaf at the index against the root hash
+func VerifyMerkleProof(root hash.Hash256, leaf hash.Hash256, index int, path []hash.Hash256) bool {
+	if index < 0 {
+		return false
+	}
+	h := leaf
+	for _, sibling := range path {
+		var data []byte
+		if index&1 == 0 {
+			data = append(h[:], sibling[:]...)
+		} else {
+			data = append(sibling[:], h[:]...)
+		}
+		h = hash.Hash256b(data)
+		index >>= 1
+	}
+	return index == 0 && h == root
+}
@@ -41,3 +41,29 @@ func TestMerkleTree(t *testing.T) {
 	rootHashHex := hex.EncodeToString(rootHash[:])
 	assert.Equal(t, "4de26a6d1d6618f7bfeb3d168e37ef645db94c2d558bf8c3546d1311877ddffa", rootHashHex)
 }
+
+func TestMerkleProof(t *testing.T) {
+	assert := assert.New(t)
+
+	inputs := []hash.Hash256{}
+	for i := 0; i < 7; i++ {
+		inputs = append(inputs, hash.Hash256b([]byte{byte(i)}))
+		m := NewMerkleTree(inputs)
+		root := m.HashTree()
+		for j, leaf := range inputs {
+			path, err := m.Proof(j)
+			assert.NoError(err)
+			assert.True(VerifyMerkleProof(root, leaf, j, path))
+			// the index has to be consistent with the length of the path
+			assert.False(VerifyMerkleProof(root, leaf, j+1<<uint(len(path)), path))
+			if i > 0 {
+				assert.False(VerifyMerkleProof(root, inputs[(j+1)%len(inputs)], j, path))
+			}
+		}
+		_, err := m.Proof(-1)
+		assert.Error(err)
+		// the copied last leaf of an odd number of leaves is not a leaf to prove
+		_, err = m.Proof(len(inputs))
+		assert.Error(err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+)
+
+// archiveKVStore is a KVStore which never deletes any record, so that the trie nodes written into it stay available
+// after the trie moves on to a new root
+type archiveKVStore struct {
+	kvStore KVStore
+}
+
+// NewArchiveKVStore wraps a KVStore to ignore the deletions
+func NewArchiveKVStore(kvStore KVStore) KVStore {
+	return &archiveKVStore{kvStore: kvStore}
+}
+
+func (s *archiveKVStore) Start(ctx context.Context) error { return s.kvStore.Start(ctx) }
+
+func (s *archiveKVStore) Stop(ctx context.Context) error { return s.kvStore.Stop(ctx) }
+
+// Put inserts a <key, value> record
+func (s *archiveKVStore) Put(namespace string, key, value []byte) error {
+	return s.kvStore.Put(namespace, key, value)
+}
+
+// Get retrieves a record
+func (s *archiveKVStore) Get(namespace string, key []byte) ([]byte, error) {
+	return s.kvStore.Get(namespace, key)
+}
+
+// Iterate calls fn on the records of namespace within the range in key order
+func (s *archiveKVStore) Iterate(namespace string, r *Range, fn func(key, value []byte) bool) error {
+	return s.kvStore.Iterate(namespace, r, fn)
+}
+
+// Delete does nothing, as the records are archived
+func (s *archiveKVStore) Delete(namespace string, key []byte) error { return nil }
+
+// Commit commits the puts in a batch, skipping the deletions
+func (s *archiveKVStore) Commit(b KVStoreBatch) error {
+	b.Lock()
+	puts := &baseKVStoreBatch{}
+	for i := 0; i < b.Size(); i++ {
+		write, err := b.Entry(i)
+		if err != nil {
+			b.Unlock()
+			return err
+		}
+		if write.writeType == Put {
+			puts.writeQueue = append(puts.writeQueue, *write)
+		}
+	}
+	b.Unlock()
+	if err := s.kvStore.Commit(puts); err != nil {
+		return err
+	}
+	// clear the batch as the underlying KVStore does when commit succeeds
+	b.Lock()
+	b.ClearAndUnlock()
+	return nil
+}
@@ -0,0 +1,214 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"os"
+
+	"github.com/dgraph-io/badger"
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	bolt "go.etcd.io/bbolt"
+)
+
+// levelDBBackupBatchSize is the number of records written into the backup of a LevelDB in one batch
+const levelDBBackupBatchSize = 1024
+
+// ErrBackupNotSupported indicates the KV store cannot be backed up
+var ErrBackupNotSupported = errors.New("backup is not supported by the KV store")
+
+type (
+	// Snapshot is a consistent view of the records of a KVStore at the time it was taken, which stays unchanged while
+	// the KVStore keeps serving
+	Snapshot interface {
+		// Backup writes the records into a new store at path, which is of the same type as the KVStore and can be
+		// opened with its config with the path replaced
+		Backup(path string) error
+		// Release releases the resources held by the snapshot
+		Release()
+	}
+
+	// snapshotter is a KVStore able to take a snapshot of itself
+	snapshotter interface {
+		snapshot() (Snapshot, error)
+	}
+
+	// boltDBSnapshot is a read transaction of a BoltDB
+	boltDBSnapshot struct {
+		tx *bolt.Tx
+	}
+
+	// badgerDBSnapshot is a read transaction of a badgerDB
+	badgerDBSnapshot struct {
+		txn *badger.Txn
+	}
+
+	// levelDBSnapshot is a snapshot of a LevelDB
+	levelDBSnapshot struct {
+		snapshot *leveldb.Snapshot
+	}
+)
+
+// TakeSnapshot takes a snapshot of kv with the native snapshot of the underlying DB. The snapshot is cheap to take,
+// while writing the backup from it takes time, so that a caller holding off the writes of kv needs to do so only
+// while the snapshot is taken. As a BoltDB cannot grow its memory map while a read transaction is open, a commit growing
+// the file of a BoltDB, and the reads after it, wait until the snapshot is released, so that the commits of a BoltDB
+// may also be paused while the backup is written. It returns ErrBackupNotSupported for a KVStore without a native
+// snapshot, such as the in-memory one
+func TakeSnapshot(kv KVStore) (Snapshot, error) {
+	s, ok := kv.(snapshotter)
+	if !ok {
+		return nil, ErrBackupNotSupported
+	}
+	return s.snapshot()
+}
+
+// Backup writes a consistent snapshot of the records of kv into a new store at path while kv keeps serving
+func Backup(kv KVStore, path string) error {
+	snapshot, err := TakeSnapshot(kv)
+	if err != nil {
+		return err
+	}
+	defer snapshot.Release()
+	return snapshot.Backup(path)
+}
+
+func (s *archiveKVStore) snapshot() (Snapshot, error) { return TakeSnapshot(s.kvStore) }
+
+func (s *instrumentedKVStore) snapshot() (Snapshot, error) { return TakeSnapshot(s.kvStore) }
+
+func (b *boltDB) snapshot() (Snapshot, error) {
+	tx, err := b.db.Begin(false)
+	if err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return &boltDBSnapshot{tx: tx}, nil
+}
+
+// Backup copies the file of the BoltDB as seen by the read transaction
+func (s *boltDBSnapshot) Backup(path string) error {
+	if err := checkBackupPath(path); err != nil {
+		return err
+	}
+	if err := s.tx.CopyFile(path, fileMode); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+func (s *boltDBSnapshot) Release() { _ = s.tx.Rollback() }
+
+func (b *badgerDB) snapshot() (Snapshot, error) {
+	return &badgerDBSnapshot{txn: b.db.NewTransaction(false)}, nil
+}
+
+// Backup writes the records seen by the read transaction into a new badgerDB
+func (s *badgerDBSnapshot) Backup(path string) (err error) {
+	if err := checkBackupPath(path); err != nil {
+		return err
+	}
+	opts := badger.DefaultOptions
+	opts.Dir = path
+	opts.ValueDir = path
+	target, err := badger.Open(opts)
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	defer func() {
+		if closeErr := target.Close(); err == nil && closeErr != nil {
+			err = errors.Wrap(ErrIO, closeErr.Error())
+		}
+	}()
+
+	it := s.txn.NewIterator(badger.DefaultIteratorOptions)
+	defer it.Close()
+	txn := target.NewTransaction(true)
+	defer func() { txn.Discard() }()
+	for it.Rewind(); it.Valid(); it.Next() {
+		item := it.Item()
+		value, err := item.ValueCopy(nil)
+		if err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+		key := item.KeyCopy(nil)
+		if err = txn.Set(key, value); err == badger.ErrTxnTooBig {
+			// commit the records so far and put this one into the next transaction
+			if err := txn.Commit(nil); err != nil {
+				return errors.Wrap(ErrIO, err.Error())
+			}
+			txn = target.NewTransaction(true)
+			err = txn.Set(key, value)
+		}
+		if err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	if err := txn.Commit(nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+func (s *badgerDBSnapshot) Release() { s.txn.Discard() }
+
+func (l *levelDB) snapshot() (Snapshot, error) {
+	snapshot, err := l.db.GetSnapshot()
+	if err != nil {
+		return nil, errors.Wrap(ErrIO, err.Error())
+	}
+	return &levelDBSnapshot{snapshot: snapshot}, nil
+}
+
+// Backup writes the records in the snapshot into a new LevelDB
+func (s *levelDBSnapshot) Backup(path string) (err error) {
+	if err := checkBackupPath(path); err != nil {
+		return err
+	}
+	target, err := leveldb.OpenFile(path, &opt.Options{ErrorIfExist: true})
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	defer func() {
+		if closeErr := target.Close(); err == nil && closeErr != nil {
+			err = errors.Wrap(ErrIO, closeErr.Error())
+		}
+	}()
+
+	it := s.snapshot.NewIterator(nil, nil)
+	defer it.Release()
+	batch := new(leveldb.Batch)
+	for it.Next() {
+		batch.Put(it.Key(), it.Value())
+		if batch.Len() < levelDBBackupBatchSize {
+			continue
+		}
+		if err := target.Write(batch, nil); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+		batch.Reset()
+	}
+	if err := it.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	if err := target.Write(batch, nil); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+func (s *levelDBSnapshot) Release() { s.snapshot.Release() }
+
+// checkBackupPath makes sure a backup does not overwrite anything
+func checkBackupPath(path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return errors.Errorf("backup path %s already exists", path)
+	} else if !os.IsNotExist(err) {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
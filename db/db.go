@@ -8,6 +8,8 @@ package db
 
 import (
 	"context"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -39,6 +41,9 @@ type KVStore interface {
 	Delete(string, []byte) error
 	// Commit commits a batch
 	Commit(KVStoreBatch) error
+	// Iterate calls fn on the records of namespace within the range in key order, until fn returns false. A nil range
+	// covers the whole namespace, and a namespace not existing has no record
+	Iterate(string, *Range, func(key, value []byte) bool) error
 }
 
 const (
@@ -124,10 +129,43 @@ func (m *memKVStore) Commit(b KVStoreBatch) (e error) {
 	return e
 }
 
+// Iterate calls fn on the records of namespace within the range in key order
+func (m *memKVStore) Iterate(namespace string, r *Range, fn func(key, value []byte) bool) error {
+	prefix := namespace + keyDelimiter
+	keys := make([]string, 0)
+	m.data.Range(func(k, _ interface{}) bool {
+		key := k.(string)
+		if strings.HasPrefix(key, prefix) && r.Contains([]byte(key[len(prefix):])) {
+			keys = append(keys, key)
+		}
+		return true
+	})
+	sort.Strings(keys)
+	if r != nil && r.Reverse {
+		for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+			keys[i], keys[j] = keys[j], keys[i]
+		}
+	}
+	for _, key := range keys {
+		value, ok := m.data.Load(key)
+		if !ok {
+			// deleted during the iteration
+			continue
+		}
+		if !fn([]byte(key[len(prefix):]), value.([]byte)) {
+			break
+		}
+	}
+	return nil
+}
+
 // NewOnDiskDB instantiates an on-disk KV store
 func NewOnDiskDB(cfg config.DB) KVStore {
 	if cfg.UseBadgerDB {
 		return &badgerDB{db: nil, path: cfg.DbPath, config: cfg}
 	}
+	if cfg.UseLevelDB {
+		return &levelDB{db: nil, path: cfg.DbPath, config: cfg}
+	}
 	return &boltDB{db: nil, path: cfg.DbPath, config: cfg}
 }
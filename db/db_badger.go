@@ -7,20 +7,50 @@
 package db
 
 import (
+	"bytes"
 	"context"
 
 	"github.com/dgraph-io/badger"
 	"github.com/pkg/errors"
+	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
 )
 
-// badgerDB is KVStore implementation based bolt DB
-type badgerDB struct {
-	db     *badger.DB
-	path   string
-	config config.DB
-}
+// keyLayoutMigrationBatchSize is the number of records moved into the delimited key layout in one batch
+const keyLayoutMigrationBatchSize = 1024
+
+var (
+	// keyLayoutKey marks a BadgerDB whose records are keyed by the namespace, the delimiter and the key. As no
+	// namespace is empty, a key beginning with the delimiter is never the key of a record
+	keyLayoutKey = []byte{namespaceDelimiter, 'l', 'a', 'y', 'o', 'u', 't'}
+	// keyLayoutProgressKey keeps the last legacy key moved into the delimited key layout
+	keyLayoutProgressKey = []byte{namespaceDelimiter, 'p', 'r', 'o', 'g', 'r', 'e', 's', 's'}
+)
+
+type (
+	// badgerDB is KVStore implementation based bolt DB, which keeps all the namespaces in one key space with the
+	// namespace as the prefix of the key
+	badgerDB struct {
+		db     *badger.DB
+		path   string
+		config config.DB
+		// legacyLayout tells the records are keyed by the namespace followed by the key without a delimiter, as they
+		// were before the db is migrated by KeyLayoutMigration
+		legacyLayout bool
+	}
+
+	// keyLayoutMigrator is a KVStore whose records may be of the legacy key layout
+	keyLayoutMigrator interface {
+		// legacyKeyLayout tells whether the records are of the legacy key layout, where the records of the empty
+		// namespace are the records under their raw keys
+		legacyKeyLayout() bool
+		// commitKeyLayout commits the batch of the writes of the raw keys, after which the records are of the
+		// delimited key layout if done
+		commitKeyLayout(batch KVStoreBatch, done bool) error
+	}
+)
 
 // Start opens the badgerDB (creates new file if not existing yet)
 func (b *badgerDB) Start(_ context.Context) error {
@@ -32,6 +62,26 @@ func (b *badgerDB) Start(_ context.Context) error {
 		return errors.Wrap(ErrIO, err.Error())
 	}
 	b.db = db
+	// a db which is neither empty nor marked is of the legacy key layout
+	var empty bool
+	if err := db.View(func(txn *badger.Txn) error {
+		if _, err := txn.Get(keyLayoutKey); err != badger.ErrKeyNotFound {
+			return err
+		}
+		it := txn.NewIterator(badger.IteratorOptions{})
+		defer it.Close()
+		it.Rewind()
+		empty = !it.Valid()
+		b.legacyLayout = !empty
+		return nil
+	}); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	if empty {
+		if err := db.Update(func(txn *badger.Txn) error { return txn.Set(keyLayoutKey, []byte{1}) }); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
 	return nil
 }
 
@@ -49,7 +99,7 @@ func (b *badgerDB) Stop(_ context.Context) error {
 func (b *badgerDB) Put(namespace string, key, value []byte) (err error) {
 	for c := uint8(0); c < b.config.NumRetries; c++ {
 		err = b.db.Update(func(txn *badger.Txn) error {
-			k := append([]byte(namespace), key...)
+			k := b.key(namespace, key)
 			// put <k, v>
 			return txn.Set(k, value)
 		})
@@ -67,7 +117,7 @@ func (b *badgerDB) Put(namespace string, key, value []byte) (err error) {
 func (b *badgerDB) Get(namespace string, key []byte) ([]byte, error) {
 	var value []byte
 	err := b.db.View(func(txn *badger.Txn) error {
-		k := append([]byte(namespace), key...)
+		k := b.key(namespace, key)
 		item, err := txn.Get(k)
 		if err != nil {
 			return errors.Wrapf(err, "failed to get key = %x", k)
@@ -80,7 +130,7 @@ func (b *badgerDB) Get(namespace string, key []byte) ([]byte, error) {
 	if err == nil {
 		return value, nil
 	}
-	if err == badger.ErrKeyNotFound {
+	if errors.Cause(err) == badger.ErrKeyNotFound {
 		return nil, errors.Wrap(ErrNotExist, err.Error())
 	}
 	return nil, errors.Wrap(ErrIO, err.Error())
@@ -90,7 +140,7 @@ func (b *badgerDB) Get(namespace string, key []byte) ([]byte, error) {
 func (b *badgerDB) Delete(namespace string, key []byte) (err error) {
 	for c := uint8(0); c < b.config.NumRetries; c++ {
 		err = b.db.Update(func(txn *badger.Txn) error {
-			k := append([]byte(namespace), key...)
+			k := b.key(namespace, key)
 			return txn.Delete(k)
 		})
 		if err == nil {
@@ -103,6 +153,58 @@ func (b *badgerDB) Delete(namespace string, key []byte) (err error) {
 	return err
 }
 
+// Iterate calls fn on the records of namespace within the range in key order
+func (b *badgerDB) Iterate(namespace string, r *Range, fn func(key, value []byte) bool) error {
+	if r == nil {
+		r = &Range{}
+	}
+	ns := b.key(namespace, nil)
+	err := b.db.View(func(txn *badger.Txn) error {
+		opts := badger.DefaultIteratorOptions
+		opts.Reverse = r.Reverse
+		it := txn.NewIterator(opts)
+		defer it.Close()
+		var seek []byte
+		switch {
+		case !r.Reverse:
+			seek = append(append([]byte{}, ns...), r.Start...)
+		case r.End != nil:
+			seek = append(append([]byte{}, ns...), r.End...)
+		default:
+			// a reverse iterator seeks the largest key not greater than the given one
+			if seek = prefixEnd(ns); seek == nil {
+				seek = bytes.Repeat([]byte{0xff}, len(ns)+1)
+			}
+		}
+		for it.Seek(seek); it.Valid(); it.Next() {
+			item := it.Item()
+			if !bytes.HasPrefix(item.Key(), ns) {
+				break
+			}
+			key := item.KeyCopy(nil)[len(ns):]
+			if !r.Contains(key) {
+				if r.Reverse && r.End != nil && bytes.Compare(key, r.End) >= 0 {
+					// the end itself is excluded
+					continue
+				}
+				break
+			}
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return errors.Wrapf(err, "failed to get value from key = %x", item.Key())
+			}
+			if !fn(key, value) {
+				break
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
 // Commit commits a batch
 func (b *badgerDB) Commit(batch KVStoreBatch) (err error) {
 	succeed := true
@@ -124,7 +226,7 @@ func (b *badgerDB) Commit(batch KVStoreBatch) (err error) {
 				if err != nil {
 					return err
 				}
-				k := append([]byte(write.namespace), write.key...)
+				k := b.key(write.namespace, write.key)
 
 				if write.writeType == Put {
 					if err := txn.Set(k, write.value); err != nil {
@@ -153,6 +255,141 @@ func (b *badgerDB) Commit(batch KVStoreBatch) (err error) {
 // private functions
 //======================================
 
+// key returns the key of a record in the BadgerDB
+func (b *badgerDB) key(namespace string, key []byte) []byte {
+	if b.legacyLayout {
+		return append([]byte(namespace), key...)
+	}
+	return levelDBKey(namespace, key)
+}
+
+func (b *badgerDB) legacyKeyLayout() bool { return b.legacyLayout }
+
+func (b *badgerDB) commitKeyLayout(batch KVStoreBatch, done bool) error {
+	if done {
+		batch.Put("", keyLayoutKey, []byte{1}, "failed to put key layout")
+		batch.Delete("", keyLayoutProgressKey, "failed to delete key layout progress")
+	}
+	if err := b.Commit(batch); err != nil {
+		return err
+	}
+	if done {
+		b.legacyLayout = false
+	}
+	return nil
+}
+
+func (s *archiveKVStore) legacyKeyLayout() bool { return legacyKeyLayout(s.kvStore) }
+
+func (s *archiveKVStore) commitKeyLayout(batch KVStoreBatch, done bool) error {
+	return s.kvStore.(keyLayoutMigrator).commitKeyLayout(batch, done)
+}
+
+func (s *instrumentedKVStore) legacyKeyLayout() bool { return legacyKeyLayout(s.kvStore) }
+
+func (s *instrumentedKVStore) commitKeyLayout(batch KVStoreBatch, done bool) error {
+	return s.kvStore.(keyLayoutMigrator).commitKeyLayout(batch, done)
+}
+
+// legacyKeyLayout tells whether the records of kv are of the legacy key layout
+func legacyKeyLayout(kv KVStore) bool {
+	m, ok := kv.(keyLayoutMigrator)
+	return ok && m.legacyKeyLayout()
+}
+
+// KeyLayoutMigration returns the migration step moving the records of a BadgerDB from the legacy key layout, where a
+// key is the namespace followed by the key, into the layout with a delimiter between them, so that the records of a
+// namespace are never mixed up with the ones of another namespace beginning with it. The namespaces are all the
+// namespaces of the db apart from SchemaNamespace, where a key beginning with more than one of them is taken as of the
+// longest one, and the records of any other namespace are left as they are. The step does nothing on the other
+// KVStores
+func KeyLayoutMigration(namespaces ...string) Migration {
+	return Migration{
+		Description: "delimit namespace from key in BadgerDB",
+		Run: func(kv KVStore, _ []byte, _ func([]byte) error) error {
+			if !legacyKeyLayout(kv) {
+				return nil
+			}
+			return migrateKeyLayout(kv, append([]string{SchemaNamespace}, namespaces...))
+		},
+	}
+}
+
+// migrateKeyLayout moves the records of the legacy key layout in key order. A moved key is less than its legacy key
+// unless the key is empty, so that the records after the last moved one are of the legacy layout apart from the moved
+// records of the empty keys. As the moved records before it could not be told apart from the legacy ones, the progress
+// is committed together with every batch instead of checkpointed. The records of the schema are moved last together
+// with the mark of the layout, so that the schema is read in the legacy layout till then
+func migrateKeyLayout(kv KVStore, namespaces []string) error {
+	m := kv.(keyLayoutMigrator)
+	progress, err := kv.Get("", keyLayoutProgressKey)
+	if err != nil && errors.Cause(err) != ErrNotExist {
+		return errors.Wrap(err, "failed to get key layout progress")
+	}
+	namespaceOf := func(k []byte) (namespace string, ok bool) {
+		for _, ns := range namespaces {
+			if bytes.HasPrefix(k, []byte(ns)) && len(ns) >= len(namespace) {
+				namespace, ok = ns, true
+			}
+		}
+		return
+	}
+	move := func(batch KVStoreBatch, ns string, k, v []byte) {
+		batch.Put("", levelDBKey(ns, k[len(ns):]), v, "failed to put key %x", k)
+		batch.Delete("", k, "failed to delete key %x", k)
+	}
+	for full := true; full; {
+		full = false
+		batch := NewBatch()
+		r := &Range{}
+		if progress != nil {
+			r.Start = append(append([]byte{}, progress...), 0)
+		}
+		if err = kv.Iterate("", r, func(k, v []byte) bool {
+			ns, ok := namespaceOf(k)
+			switch {
+			case bytes.HasPrefix(k, []byte{namespaceDelimiter}):
+				return true
+			case !ok:
+				log.L().Warn("Record of no known namespace is left as it is.", zap.Binary("key", k))
+			case bytes.Equal(k, levelDBKey(ns, nil)) && bytes.Compare(progress, []byte(ns)) >= 0:
+				// the moved record of the empty key
+				return true
+			case len(k) == len(ns):
+				// the moved record of the empty key would replace the record of the key made of the delimiter
+				if _, err = kv.Get("", levelDBKey(ns, nil)); errors.Cause(err) != ErrNotExist {
+					err = errors.Wrapf(ErrIO, "cannot move the record of the empty key of namespace %s", ns)
+					return false
+				}
+				err = nil
+				move(batch, ns, k, v)
+			case ns != SchemaNamespace:
+				move(batch, ns, k, v)
+			}
+			progress = k
+			full = batch.Size() >= 2*keyLayoutMigrationBatchSize
+			return !full
+		}); err != nil {
+			return err
+		}
+		if batch.Size() == 0 {
+			continue
+		}
+		batch.Put("", keyLayoutProgressKey, progress, "failed to put key layout progress")
+		if err := m.commitKeyLayout(batch, false); err != nil {
+			return err
+		}
+	}
+	batch := NewBatch()
+	if err := kv.Iterate("", PrefixRange([]byte(SchemaNamespace)), func(k, v []byte) bool {
+		move(batch, SchemaNamespace, k, v)
+		return true
+	}); err != nil {
+		return err
+	}
+	return m.commitKeyLayout(batch, true)
+}
+
 // intentionally fail to test DB can successfully rollback
 func (b *badgerDB) batchPutForceFail(namespace string, key [][]byte, value [][]byte) error {
 	return b.db.Update(func(txn *badger.Txn) error {
@@ -160,8 +397,7 @@ func (b *badgerDB) batchPutForceFail(namespace string, key [][]byte, value [][]b
 			return errors.Wrap(ErrIO, "batch put <k, v> size not match")
 		}
 		for i := 0; i < len(key); i++ {
-			k := []byte(namespace)
-			k = append(k, key[i]...)
+			k := b.key(namespace, key[i])
 			if err := txn.Set(k, value[i]); err != nil {
 				return err
 			}
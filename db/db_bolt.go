@@ -111,6 +111,54 @@ func (b *boltDB) Delete(namespace string, key []byte) (err error) {
 	return err
 }
 
+// Iterate calls fn on the records of namespace within the range in key order
+func (b *boltDB) Iterate(namespace string, r *Range, fn func(key, value []byte) bool) error {
+	if r == nil {
+		r = &Range{}
+	}
+	err := b.db.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(namespace))
+		if bucket == nil {
+			return nil
+		}
+		c := bucket.Cursor()
+		var k, v []byte
+		if r.Reverse {
+			if r.End == nil {
+				k, v = c.Last()
+			} else if k, v = c.Seek(r.End); k == nil {
+				// all the keys are smaller than the end
+				k, v = c.Last()
+			} else {
+				k, v = c.Prev()
+			}
+		} else if r.Start == nil {
+			k, v = c.First()
+		} else {
+			k, v = c.Seek(r.Start)
+		}
+		for k != nil {
+			if !r.Contains(k) {
+				break
+			}
+			// nested buckets have nil value, and there is none in a namespace
+			if v != nil && !fn(copyBytes(k), copyBytes(v)) {
+				break
+			}
+			if r.Reverse {
+				k, v = c.Prev()
+			} else {
+				k, v = c.Next()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
 // Commit commits a batch
 func (b *boltDB) Commit(batch KVStoreBatch) (err error) {
 	succeed := true
@@ -190,3 +238,10 @@ func (b *boltDB) batchPutForceFail(namespace string, key [][]byte, value [][]byt
 		return nil
 	})
 }
+
+// copyBytes returns a copy of the bytes, which stay valid after the bolt transaction
+func copyBytes(b []byte) []byte {
+	c := make([]byte, len(b))
+	copy(c, b)
+	return c
+}
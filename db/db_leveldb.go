@@ -0,0 +1,172 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+
+	"github.com/iotexproject/iotex-core/config"
+)
+
+// namespaceDelimiter separates the namespace from the key, so that a namespace never shares keys with another one
+// beginning with it
+const namespaceDelimiter = byte(0)
+
+// levelDB is KVStore implementation based on LevelDB, which keeps all the namespaces in one key space with the
+// namespace as the prefix of the key
+type levelDB struct {
+	db     *leveldb.DB
+	path   string
+	config config.DB
+}
+
+// Start opens the LevelDB (creates new directory if not existing yet)
+func (l *levelDB) Start(_ context.Context) error {
+	db, err := leveldb.OpenFile(l.path, nil)
+	if err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	l.db = db
+	return nil
+}
+
+// Stop closes the LevelDB
+func (l *levelDB) Stop(_ context.Context) error {
+	if l.db != nil {
+		if err := l.db.Close(); err != nil {
+			return errors.Wrap(ErrIO, err.Error())
+		}
+	}
+	return nil
+}
+
+// Put inserts a <key, value> record
+func (l *levelDB) Put(namespace string, key, value []byte) (err error) {
+	for c := uint8(0); c < l.config.NumRetries; c++ {
+		if err = l.db.Put(levelDBKey(namespace, key), value, nil); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		err = errors.Wrap(ErrIO, err.Error())
+	}
+	return err
+}
+
+// Get retrieves a record
+func (l *levelDB) Get(namespace string, key []byte) ([]byte, error) {
+	value, err := l.db.Get(levelDBKey(namespace, key), nil)
+	if err == nil {
+		return value, nil
+	}
+	if err == leveldb.ErrNotFound {
+		return nil, errors.Wrapf(ErrNotExist, "key = %x doesn't exist in namespace = %s", key, namespace)
+	}
+	return nil, errors.Wrap(ErrIO, err.Error())
+}
+
+// Delete deletes a record
+func (l *levelDB) Delete(namespace string, key []byte) (err error) {
+	for c := uint8(0); c < l.config.NumRetries; c++ {
+		if err = l.db.Delete(levelDBKey(namespace, key), nil); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		err = errors.Wrap(ErrIO, err.Error())
+	}
+	return err
+}
+
+// Iterate calls fn on the records of namespace within the range in key order
+func (l *levelDB) Iterate(namespace string, r *Range, fn func(key, value []byte) bool) error {
+	if r == nil {
+		r = &Range{}
+	}
+	prefix := levelDBKey(namespace, nil)
+	slice := util.BytesPrefix(prefix)
+	if r.Start != nil {
+		slice.Start = levelDBKey(namespace, r.Start)
+	}
+	if r.End != nil {
+		slice.Limit = levelDBKey(namespace, r.End)
+	}
+	it := l.db.NewIterator(slice, nil)
+	defer it.Release()
+	next := it.Next
+	ok := it.First()
+	if r.Reverse {
+		next = it.Prev
+		ok = it.Last()
+	}
+	for ; ok; ok = next() {
+		// the iterator reuses the buffers of the key and the value
+		key := copyBytes(it.Key()[len(prefix):])
+		if !fn(key, copyBytes(it.Value())) {
+			break
+		}
+	}
+	if err := it.Error(); err != nil {
+		return errors.Wrap(ErrIO, err.Error())
+	}
+	return nil
+}
+
+// Commit commits a batch
+func (l *levelDB) Commit(batch KVStoreBatch) (err error) {
+	succeed := true
+	batch.Lock()
+	defer func() {
+		if succeed {
+			// clear the batch if commit succeeds
+			batch.ClearAndUnlock()
+		} else {
+			batch.Unlock()
+		}
+	}()
+
+	b := new(leveldb.Batch)
+	for i := 0; i < batch.Size(); i++ {
+		write, err := batch.Entry(i)
+		if err != nil {
+			succeed = false
+			return err
+		}
+		k := levelDBKey(write.namespace, write.key)
+		if write.writeType == Put {
+			b.Put(k, write.value)
+		} else if write.writeType == Delete {
+			b.Delete(k)
+		}
+	}
+	for c := uint8(0); c < l.config.NumRetries; c++ {
+		if err = l.db.Write(b, nil); err == nil {
+			break
+		}
+	}
+	if err != nil {
+		succeed = false
+		err = errors.Wrap(ErrIO, err.Error())
+	}
+	return err
+}
+
+//======================================
+// private functions
+//======================================
+
+// levelDBKey returns the key of a record in the LevelDB
+func levelDBKey(namespace string, key []byte) []byte {
+	k := make([]byte, 0, len(namespace)+1+len(key))
+	k = append(k, namespace...)
+	k = append(k, namespaceDelimiter)
+	return append(k, key...)
+}
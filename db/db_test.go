@@ -8,8 +8,13 @@ package db
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -47,7 +52,7 @@ func TestKVStorePutGet(t *testing.T) {
 		assert.NotNil(err)
 		assert.Nil(value)
 		value, err = kvStore.Get(bucket1, testK1[0])
-		assert.NotNil(err)
+		assert.Equal(ErrNotExist, errors.Cause(err))
 		assert.Nil(value)
 	}
 
@@ -71,6 +76,17 @@ func TestKVStorePutGet(t *testing.T) {
 		defer testutil.CleanupPath(t, path)
 		testKVStorePutGet(NewOnDiskDB(cfg), t)
 	})
+
+	path = "test-kv-store.leveldb"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = false
+	cfg.UseLevelDB = true
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testKVStorePutGet(NewOnDiskDB(cfg), t)
+	})
+	cfg.UseLevelDB = false
 }
 
 func TestBatchRollback(t *testing.T) {
@@ -133,6 +149,17 @@ func TestBatchRollback(t *testing.T) {
 		defer testutil.CleanupPath(t, path)
 		testBatchRollback(NewOnDiskDB(cfg), t)
 	})
+
+	path = "test-batch-rollback.leveldb"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = false
+	cfg.UseLevelDB = true
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testBatchRollback(NewOnDiskDB(cfg), t)
+	})
+	cfg.UseLevelDB = false
 }
 
 func TestDBInMemBatchCommit(t *testing.T) {
@@ -163,6 +190,230 @@ func TestDBInMemBatchCommit(t *testing.T) {
 	require.Equal(testV1[0], value)
 }
 
+func TestArchiveKVStore(t *testing.T) {
+	require := require.New(t)
+	kvStore := NewArchiveKVStore(NewMemKVStore())
+	ctx := context.Background()
+
+	require.NoError(kvStore.Start(ctx))
+	defer func() {
+		require.NoError(kvStore.Stop(ctx))
+	}()
+
+	require.NoError(kvStore.Put(bucket1, testK1[0], testV1[0]))
+	require.NoError(kvStore.Delete(bucket1, testK1[0]))
+	value, err := kvStore.Get(bucket1, testK1[0])
+	require.NoError(err)
+	require.Equal(testV1[0], value)
+
+	// deletions are dropped from the batch, while the puts go through
+	batch := NewBatch()
+	batch.Put(bucket1, testK1[1], testV1[1], "")
+	batch.Delete(bucket1, testK1[0], "")
+	require.NoError(kvStore.Commit(batch))
+	require.Equal(0, batch.Size())
+	value, err = kvStore.Get(bucket1, testK1[0])
+	require.NoError(err)
+	require.Equal(testV1[0], value)
+	value, err = kvStore.Get(bucket1, testK1[1])
+	require.NoError(err)
+	require.Equal(testV1[1], value)
+}
+
+func TestInstrumentedKVStore(t *testing.T) {
+	require := require.New(t)
+	// the collectors are global, so that a store name of its own keeps the counts of other runs out
+	name := fmt.Sprintf("test-%d", time.Now().UnixNano())
+	kvStore := NewInstrumentedKVStore(name, NewMemKVStore())
+	ctx := context.Background()
+
+	require.NoError(kvStore.Start(ctx))
+	defer func() {
+		require.NoError(kvStore.Stop(ctx))
+	}()
+
+	counter := func(c *prometheus.CounterVec, labels ...string) float64 {
+		m := &dto.Metric{}
+		require.NoError(c.WithLabelValues(labels...).Write(m))
+		return m.GetCounter().GetValue()
+	}
+
+	require.NoError(kvStore.Put(bucket1, testK1[0], testV1[0]))
+	value, err := kvStore.Get(bucket1, testK1[0])
+	require.NoError(err)
+	require.Equal(testV1[0], value)
+	_, err = kvStore.Get(bucket1, testK1[1])
+	require.Error(err)
+	require.NoError(kvStore.Delete(bucket1, testK1[0]))
+	require.Equal(float64(1), counter(kvStoreOpMtc, name, bucket1, "put", "success"))
+	require.Equal(float64(1), counter(kvStoreOpMtc, name, bucket1, "get", "success"))
+	require.Equal(float64(1), counter(kvStoreOpMtc, name, bucket1, "get", "failure"))
+	require.Equal(float64(1), counter(kvStoreOpMtc, name, bucket1, "delete", "success"))
+	require.Equal(float64(len(testK1[0])+len(testV1[0])), counter(kvStoreBytesMtc, name, bucket1, "put"))
+	require.Equal(float64(len(testV1[0])), counter(kvStoreBytesMtc, name, bucket1, "get"))
+
+	batch := NewBatch()
+	batch.Put(bucket1, testK1[1], testV1[1], "")
+	batch.Put(bucket2, testK2[1], testV2[1], "")
+	batch.Delete(bucket2, testK2[0], "")
+	require.NoError(kvStore.Commit(batch))
+	require.Equal(0, batch.Size())
+	value, err = kvStore.Get(bucket2, testK2[1])
+	require.NoError(err)
+	require.Equal(testV2[1], value)
+	require.Equal(float64(1), counter(kvStoreOpMtc, name, allNamespaces, "commit", "success"))
+	require.Equal(float64(len(testK1[1])+len(testV1[1])), counter(kvStoreBytesMtc, name, bucket1, "commit"))
+	require.Equal(
+		float64(len(testK2[1])+len(testV2[1])+len(testK2[0])),
+		counter(kvStoreBytesMtc, name, bucket2, "commit"),
+	)
+	m := &dto.Metric{}
+	require.NoError(kvStoreBatchSizeMtc.WithLabelValues(name).(prometheus.Metric).Write(m))
+	require.Equal(uint64(1), m.GetHistogram().GetSampleCount())
+	require.Equal(float64(3), m.GetHistogram().GetSampleSum())
+}
+
+func TestKVStoreIterate(t *testing.T) {
+	testKVStoreIterate := func(kvStore KVStore, t *testing.T) {
+		require := require.New(t)
+		ctx := context.Background()
+
+		require.NoError(kvStore.Start(ctx))
+		defer func() {
+			require.NoError(kvStore.Stop(ctx))
+		}()
+
+		keys := [][]byte{[]byte("a1"), []byte("a2"), []byte("a3"), {'b', 0xff}, []byte("c1")}
+		for _, k := range keys {
+			require.NoError(kvStore.Put(bucket1, k, append([]byte("v"), k...)))
+		}
+		// records of the namespaces around are not visited
+		require.NoError(kvStore.Put("test_ns0", []byte("a0"), []byte("x")))
+		require.NoError(kvStore.Put(bucket2, []byte("a4"), []byte("x")))
+
+		collect := func(namespace string, r *Range) [][]byte {
+			res := [][]byte{}
+			require.NoError(kvStore.Iterate(namespace, r, func(k, v []byte) bool {
+				require.Equal(append([]byte("v"), k...), v)
+				res = append(res, k)
+				return true
+			}))
+			return res
+		}
+		require.Equal(keys, collect(bucket1, nil))
+		require.Equal(keys[:3], collect(bucket1, PrefixRange([]byte("a"))))
+		require.Equal(keys[3:4], collect(bucket1, PrefixRange([]byte("b"))))
+		require.Equal(keys[1:3], collect(bucket1, &Range{Start: []byte("a2"), End: []byte("b")}))
+		require.Equal(keys[:2], collect(bucket1, &Range{End: []byte("a3")}))
+		require.Equal(keys[2:], collect(bucket1, &Range{Start: []byte("a25")}))
+		require.Equal([][]byte{keys[2], keys[1]}, collect(bucket1, &Range{
+			Start:   []byte("a2"),
+			End:     []byte("b"),
+			Reverse: true,
+		}))
+		require.Equal([][]byte{keys[1], keys[0]}, collect(bucket1, &Range{End: []byte("a3"), Reverse: true}))
+		require.Equal([][]byte{keys[4], keys[3], keys[2]}, collect(bucket1, &Range{
+			Start:   []byte("a3"),
+			Reverse: true,
+		}))
+		require.Equal(0, len(collect(bucket1, PrefixRange([]byte("d")))))
+		require.Equal(0, len(collect(bucket3, nil)))
+
+		// stop when fn returns false
+		n := 0
+		require.NoError(kvStore.Iterate(bucket1, &Range{Reverse: true}, func(k, _ []byte) bool {
+			n++
+			require.Equal(keys[4], k)
+			return false
+		}))
+		require.Equal(1, n)
+
+		require.NoError(kvStore.Delete(bucket1, keys[1]))
+		require.Equal([][]byte{keys[0], keys[2]}, collect(bucket1, PrefixRange([]byte("a"))))
+	}
+
+	t.Run("In-memory KV Store", func(t *testing.T) {
+		testKVStoreIterate(NewMemKVStore(), t)
+	})
+
+	path := "test-kv-store-iterate.bolt"
+	cfg := config.Default.DB
+	cfg.DbPath = path
+	t.Run("Bolt DB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testKVStoreIterate(NewOnDiskDB(cfg), t)
+	})
+
+	path = "test-kv-store-iterate.badger"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = true
+	t.Run("Badger DB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testKVStoreIterate(NewOnDiskDB(cfg), t)
+	})
+
+	path = "test-kv-store-iterate.leveldb"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = false
+	cfg.UseLevelDB = true
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testKVStoreIterate(NewOnDiskDB(cfg), t)
+	})
+	cfg.UseLevelDB = false
+}
+
+func TestBadgerIterateOverlappingNamespaces(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	path := "test-kv-store-overlap.badger"
+	cfg := config.Default.DB
+	cfg.DbPath = path
+	cfg.UseBadgerDB = true
+	testutil.CleanupPath(t, path)
+	defer testutil.CleanupPath(t, path)
+	kvStore := NewOnDiskDB(cfg)
+	require.NoError(kvStore.Start(ctx))
+	defer func() {
+		require.NoError(kvStore.Stop(ctx))
+	}()
+
+	// the records of "ns1" are not mixed up with the record of "ns" with key "1a"
+	require.NoError(kvStore.Put("ns", []byte("1a"), []byte("v1")))
+	require.NoError(kvStore.Put("ns", []byte("a"), []byte("v2")))
+	require.NoError(kvStore.Put("ns1", []byte("a"), []byte("v3")))
+	iterate := func(namespace string, r *Range) []string {
+		var values []string
+		require.NoError(kvStore.Iterate(namespace, r, func(_, value []byte) bool {
+			values = append(values, string(value))
+			return true
+		}))
+		return values
+	}
+	require.Equal([]string{"v1", "v2"}, iterate("ns", nil))
+	require.Equal([]string{"v2", "v1"}, iterate("ns", &Range{Reverse: true}))
+	require.Equal([]string{"v3"}, iterate("ns1", nil))
+	require.Equal([]string{"v3"}, iterate("ns1", &Range{Reverse: true}))
+	require.Nil(iterate("other", nil))
+}
+
+func TestPrefixRange(t *testing.T) {
+	require := require.New(t)
+
+	r := PrefixRange([]byte{1, 2})
+	require.Equal([]byte{1, 3}, r.End)
+	require.True(r.Contains([]byte{1, 2, 0xff}))
+	require.False(r.Contains([]byte{1, 3}))
+	require.Equal([]byte{2}, PrefixRange([]byte{1, 0xff, 0xff}).End)
+	require.Nil(PrefixRange([]byte{0xff}).End)
+	require.Equal(&Range{}, PrefixRange(nil))
+	require.True((*Range)(nil).Contains([]byte{0}))
+}
+
 func TestDBBatch(t *testing.T) {
 	testBatchRollback := func(kvStore KVStore, t *testing.T) {
 		require := require.New(t)
@@ -257,6 +508,17 @@ func TestDBBatch(t *testing.T) {
 		defer testutil.CleanupPath(t, path)
 		testBatchRollback(NewOnDiskDB(cfg), t)
 	})
+
+	path = "test-batch-commit.leveldb"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = false
+	cfg.UseLevelDB = true
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testBatchRollback(NewOnDiskDB(cfg), t)
+	})
+	cfg.UseLevelDB = false
 }
 
 func TestCacheKV(t *testing.T) {
@@ -316,4 +578,95 @@ func TestCacheKV(t *testing.T) {
 		defer testutil.CleanupPath(t, path)
 		testFunc(NewOnDiskDB(cfg), t)
 	})
+
+	path = "test-cache-kv.leveldb"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = false
+	cfg.UseLevelDB = true
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testFunc(NewOnDiskDB(cfg), t)
+	})
+	cfg.UseLevelDB = false
+}
+
+func TestBackup(t *testing.T) {
+	testBackup := func(cfg config.DB, t *testing.T) {
+		require := require.New(t)
+		ctx := context.Background()
+
+		kvStore := NewInstrumentedKVStore("test", NewOnDiskDB(cfg))
+		require.NoError(kvStore.Start(ctx))
+		defer func() {
+			require.NoError(kvStore.Stop(ctx))
+		}()
+		for i := range testK1 {
+			require.NoError(kvStore.Put(bucket1, testK1[i], testV1[i]))
+			require.NoError(kvStore.Put(bucket2, testK2[i], testV2[i]))
+		}
+
+		backupPath := cfg.DbPath + ".backup"
+		testutil.CleanupPath(t, backupPath)
+		defer testutil.CleanupPath(t, backupPath)
+		snapshot, err := TakeSnapshot(kvStore)
+		require.NoError(err)
+		// writes after the snapshot is taken are not in the backup. A write into BoltDB may wait until the snapshot
+		// is released, so it is made in another routine
+		done := make(chan error)
+		go func() {
+			done <- kvStore.Put(bucket3, testK1[0], testV1[0])
+		}()
+		require.NoError(snapshot.Backup(backupPath))
+		snapshot.Release()
+		require.NoError(<-done)
+		require.Error(Backup(kvStore, backupPath))
+
+		cfg.DbPath = backupPath
+		backup := NewOnDiskDB(cfg)
+		require.NoError(backup.Start(ctx))
+		defer func() {
+			require.NoError(backup.Stop(ctx))
+		}()
+		for i := range testK1 {
+			value, err := backup.Get(bucket1, testK1[i])
+			require.NoError(err)
+			require.Equal(testV1[i], value)
+			value, err = backup.Get(bucket2, testK2[i])
+			require.NoError(err)
+			require.Equal(testV2[i], value)
+		}
+		_, err = backup.Get(bucket3, testK1[0])
+		require.Equal(ErrNotExist, errors.Cause(err))
+	}
+
+	require.Equal(t, ErrBackupNotSupported, Backup(NewMemKVStore(), "test-backup.mem"))
+
+	cfg := config.Default.DB
+	path := "test-backup.bolt"
+	cfg.DbPath = path
+	t.Run("Bolt DB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testBackup(cfg, t)
+	})
+
+	path = "test-backup.badger"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = true
+	t.Run("Badger DB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testBackup(cfg, t)
+	})
+
+	path = "test-backup.leveldb"
+	cfg.DbPath = path
+	cfg.UseBadgerDB = false
+	cfg.UseLevelDB = true
+	t.Run("LevelDB", func(t *testing.T) {
+		testutil.CleanupPath(t, path)
+		defer testutil.CleanupPath(t, path)
+		testBackup(cfg, t)
+	})
 }
@@ -0,0 +1,155 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// allNamespaces is the namespace label of the metrics of the operations not bound to one namespace
+const allNamespaces = "*"
+
+var (
+	kvStoreOpMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_kvstore_operations_total",
+			Help: "Number of KV store operations",
+		},
+		[]string{"store", "namespace", "op", "status"},
+	)
+	kvStoreLatencyMtc = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "iotex_kvstore_operation_duration_seconds",
+			Help:    "Latency of KV store operations",
+			Buckets: prometheus.ExponentialBuckets(0.00001, 4, 12),
+		},
+		[]string{"store", "namespace", "op"},
+	)
+	kvStoreBytesMtc = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "iotex_kvstore_bytes_total",
+			Help: "Bytes of the keys and values read from and written into KV stores",
+		},
+		[]string{"store", "namespace", "op"},
+	)
+	kvStoreBatchSizeMtc = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "iotex_kvstore_batch_entries",
+			Help:    "Number of entries in the batches committed into KV stores",
+			Buckets: prometheus.ExponentialBuckets(1, 4, 10),
+		},
+		[]string{"store"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(kvStoreOpMtc)
+	prometheus.MustRegister(kvStoreLatencyMtc)
+	prometheus.MustRegister(kvStoreBytesMtc)
+	prometheus.MustRegister(kvStoreBatchSizeMtc)
+}
+
+// instrumentedKVStore is a KVStore which records the counts, the latencies and the byte sizes of the operations on
+// the underlying KVStore into prometheus metrics, labeled by the name of the store and the namespace
+type instrumentedKVStore struct {
+	name    string
+	kvStore KVStore
+}
+
+// NewInstrumentedKVStore wraps a KVStore to record the metrics of its operations under the label store=name
+func NewInstrumentedKVStore(name string, kvStore KVStore) KVStore {
+	return &instrumentedKVStore{name: name, kvStore: kvStore}
+}
+
+func (s *instrumentedKVStore) Start(ctx context.Context) error { return s.kvStore.Start(ctx) }
+
+func (s *instrumentedKVStore) Stop(ctx context.Context) error { return s.kvStore.Stop(ctx) }
+
+// Put inserts a <key, value> record
+func (s *instrumentedKVStore) Put(namespace string, key, value []byte) error {
+	start := time.Now()
+	err := s.kvStore.Put(namespace, key, value)
+	s.observe(namespace, "put", start, err)
+	if err == nil {
+		kvStoreBytesMtc.WithLabelValues(s.name, namespace, "put").Add(float64(len(key) + len(value)))
+	}
+	return err
+}
+
+// Get retrieves a record
+func (s *instrumentedKVStore) Get(namespace string, key []byte) ([]byte, error) {
+	start := time.Now()
+	value, err := s.kvStore.Get(namespace, key)
+	s.observe(namespace, "get", start, err)
+	if err == nil {
+		kvStoreBytesMtc.WithLabelValues(s.name, namespace, "get").Add(float64(len(value)))
+	}
+	return value, err
+}
+
+// Delete deletes a record
+func (s *instrumentedKVStore) Delete(namespace string, key []byte) error {
+	start := time.Now()
+	err := s.kvStore.Delete(namespace, key)
+	s.observe(namespace, "delete", start, err)
+	return err
+}
+
+// Iterate calls fn on the records of namespace within the range in key order
+func (s *instrumentedKVStore) Iterate(namespace string, r *Range, fn func(key, value []byte) bool) error {
+	size := 0
+	start := time.Now()
+	err := s.kvStore.Iterate(namespace, r, func(key, value []byte) bool {
+		size += len(key) + len(value)
+		return fn(key, value)
+	})
+	s.observe(namespace, "iterate", start, err)
+	kvStoreBytesMtc.WithLabelValues(s.name, namespace, "iterate").Add(float64(size))
+	return err
+}
+
+// Commit commits a batch
+func (s *instrumentedKVStore) Commit(b KVStoreBatch) error {
+	// the batch is cleared once committed, so the sizes are taken beforehand
+	sizes := make(map[string]int)
+	b.Lock()
+	entries := b.Size()
+	for i := 0; i < entries; i++ {
+		write, err := b.Entry(i)
+		if err != nil {
+			b.Unlock()
+			return err
+		}
+		sizes[write.namespace] += len(write.key) + len(write.value)
+	}
+	b.Unlock()
+
+	start := time.Now()
+	err := s.kvStore.Commit(b)
+	s.observe(allNamespaces, "commit", start, err)
+	if err != nil {
+		return err
+	}
+	kvStoreBatchSizeMtc.WithLabelValues(s.name).Observe(float64(entries))
+	for namespace, size := range sizes {
+		kvStoreBytesMtc.WithLabelValues(s.name, namespace, "commit").Add(float64(size))
+	}
+	return nil
+}
+
+// observe records the count and the latency of an operation
+func (s *instrumentedKVStore) observe(namespace, op string, start time.Time, err error) {
+	status := "success"
+	if err != nil {
+		status = "failure"
+	}
+	kvStoreOpMtc.WithLabelValues(s.name, namespace, op, status).Inc()
+	kvStoreLatencyMtc.WithLabelValues(s.name, namespace, op).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"bytes"
+)
+
+// Range is a key range [Start, End) of a namespace to iterate through. A nil Start or End leaves the range unbounded
+// on that side, and Reverse iterates from the largest key down to the smallest one
+type Range struct {
+	Start   []byte
+	End     []byte
+	Reverse bool
+}
+
+// PrefixRange returns the range of all the keys beginning with prefix
+func PrefixRange(prefix []byte) *Range {
+	if len(prefix) == 0 {
+		return &Range{}
+	}
+	return &Range{Start: prefix, End: prefixEnd(prefix)}
+}
+
+// Contains returns whether key falls into the range
+func (r *Range) Contains(key []byte) bool {
+	if r == nil {
+		return true
+	}
+	if r.Start != nil && bytes.Compare(key, r.Start) < 0 {
+		return false
+	}
+	return r.End == nil || bytes.Compare(key, r.End) < 0
+}
+
+// prefixEnd returns the smallest key greater than all the keys beginning with prefix, or nil if there is none
+func prefixEnd(prefix []byte) []byte {
+	end := make([]byte, len(prefix))
+	copy(end, prefix)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
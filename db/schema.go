@@ -0,0 +1,218 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"encoding/hex"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+)
+
+// SchemaNamespace is the namespace reserved for the name and the version of the schema of a KVStore
+const SchemaNamespace = "Schema"
+
+var (
+	schemaNameKey     = []byte("name")
+	schemaVersionKey  = []byte("version")
+	schemaProgressKey = []byte("progress.")
+
+	// ErrUnknownSchema indicates the KVStore is of another schema, or of a version newer than the latest one known
+	ErrUnknownSchema = errors.New("unknown schema")
+)
+
+type (
+	// Migration is a step upgrading the records of a KVStore in place from a schema version to the next one
+	Migration struct {
+		// Description tells what the step changes
+		Description string
+		// Run migrates the records of kv. It starts from the progress checkpointed by the last interrupted run, which is
+		// nil if there is none, and should call checkpoint every now and then, so that the step resumes from there if
+		// interrupted
+		Run func(kv KVStore, progress []byte, checkpoint func(progress []byte) error) error
+	}
+
+	// Schema is the registry of the ordered migrations of a KVStore. Version 1 is the format from before the schema
+	// was versioned, and the i-th migration upgrades version i to version i+1
+	Schema struct {
+		name       string
+		migrations []Migration
+	}
+)
+
+// NewSchema creates a schema with the migrations in order
+func NewSchema(name string, migrations ...Migration) *Schema {
+	return &Schema{name: name, migrations: migrations}
+}
+
+// Latest returns the latest version of the schema
+func (s *Schema) Latest() uint64 { return uint64(len(s.migrations)) + 1 }
+
+// Version returns the schema version of kv, which is 0 if kv has not been versioned yet
+func (s *Schema) Version(kv KVStore) (uint64, error) {
+	name, err := kv.Get(SchemaNamespace, schemaNameKey)
+	if errors.Cause(err) == ErrNotExist {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get schema name")
+	}
+	if string(name) != s.name {
+		return 0, errors.Wrapf(ErrUnknownSchema, "schema %s is not %s", name, s.name)
+	}
+	value, err := kv.Get(SchemaNamespace, schemaVersionKey)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get schema version")
+	}
+	if len(value) != 8 {
+		return 0, errors.New("schema version is broken")
+	}
+	version := byteutil.BytesToUint64(value)
+	if version == 0 || version > s.Latest() {
+		return 0, errors.Wrapf(ErrUnknownSchema, "version %d of schema %s is unknown, latest is %d",
+			version, s.name, s.Latest())
+	}
+	return version, nil
+}
+
+// Open checks the schema of kv when it is started, and migrates it to the latest version. A kv not versioned yet is
+// of the latest version if it is fresh, otherwise of version 1
+func (s *Schema) Open(kv KVStore, fresh bool) error {
+	version, err := s.Version(kv)
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		version = 1
+		if fresh {
+			version = s.Latest()
+		}
+		if err := s.setVersion(kv, version); err != nil {
+			return err
+		}
+	}
+	return s.migrate(kv, version, false)
+}
+
+// Migrate migrates kv to the latest version. In a dry run, the steps run without writing anything, and only the
+// numbers of the writes they would make are logged. As nothing is written, every step of a dry run reads the records
+// as they are now
+func (s *Schema) Migrate(kv KVStore, dryRun bool) error {
+	version, err := s.Version(kv)
+	if err != nil {
+		return err
+	}
+	if version == 0 {
+		version = 1
+		if !dryRun {
+			if err := s.setVersion(kv, version); err != nil {
+				return err
+			}
+		}
+	}
+	return s.migrate(kv, version, dryRun)
+}
+
+func (s *Schema) migrate(kv KVStore, version uint64, dryRun bool) error {
+	for ; version < s.Latest(); version++ {
+		step := s.migrations[version-1]
+		logger := log.L().With(
+			zap.String("schema", s.name),
+			zap.Uint64("from", version),
+			zap.Uint64("to", version+1),
+			zap.String("step", step.Description),
+			zap.Bool("dryRun", dryRun),
+		)
+		progressKey := append(schemaProgressKey, byteutil.Uint64ToBytes(version)...)
+		progress, err := kv.Get(SchemaNamespace, progressKey)
+		if err != nil {
+			if errors.Cause(err) != ErrNotExist {
+				return errors.Wrap(err, "failed to get migration progress")
+			}
+			progress = nil
+		}
+		logger.Info("Migrating schema.", zap.String("progress", hex.EncodeToString(progress)))
+
+		store := kv
+		checkpoint := func(progress []byte) error {
+			logger.Info("Migration progress.", zap.String("progress", hex.EncodeToString(progress)))
+			return kv.Put(SchemaNamespace, progressKey, progress)
+		}
+		var dryRunStore *dryRunKVStore
+		if dryRun {
+			dryRunStore = &dryRunKVStore{KVStore: kv}
+			store = dryRunStore
+			checkpoint = func(progress []byte) error {
+				logger.Info("Migration progress.", zap.String("progress", hex.EncodeToString(progress)))
+				return nil
+			}
+		}
+		if err := step.Run(store, progress, checkpoint); err != nil {
+			return errors.Wrapf(err, "failed to migrate schema %s from version %d", s.name, version)
+		}
+		if dryRun {
+			logger.Info("Migrated schema.", zap.Int("puts", dryRunStore.puts), zap.Int("deletes", dryRunStore.deletes))
+			continue
+		}
+		if err := s.setVersion(kv, version+1); err != nil {
+			return err
+		}
+		logger.Info("Migrated schema.")
+	}
+	return nil
+}
+
+// setVersion writes the name and the version of the schema into kv
+func (s *Schema) setVersion(kv KVStore, version uint64) error {
+	batch := NewBatch()
+	batch.Put(SchemaNamespace, schemaNameKey, []byte(s.name), "failed to put schema name")
+	batch.Put(SchemaNamespace, schemaVersionKey, byteutil.Uint64ToBytes(version), "failed to put schema version")
+	return errors.Wrapf(kv.Commit(batch), "failed to set version %d of schema %s", version, s.name)
+}
+
+// dryRunKVStore is a KVStore which counts the writes instead of making them
+type dryRunKVStore struct {
+	KVStore
+
+	puts    int
+	deletes int
+}
+
+func (s *dryRunKVStore) Put(string, []byte, []byte) error {
+	s.puts++
+	return nil
+}
+
+func (s *dryRunKVStore) Delete(string, []byte) error {
+	s.deletes++
+	return nil
+}
+
+func (s *dryRunKVStore) legacyKeyLayout() bool { return legacyKeyLayout(s.KVStore) }
+
+func (s *dryRunKVStore) commitKeyLayout(b KVStoreBatch, _ bool) error { return s.Commit(b) }
+
+func (s *dryRunKVStore) Commit(b KVStoreBatch) error {
+	b.Lock()
+	for i := 0; i < b.Size(); i++ {
+		write, err := b.Entry(i)
+		if err != nil {
+			b.Unlock()
+			return err
+		}
+		if write.writeType == Put {
+			s.puts++
+		} else if write.writeType == Delete {
+			s.deletes++
+		}
+	}
+	b.ClearAndUnlock()
+	return nil
+}
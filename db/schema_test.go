@@ -0,0 +1,215 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package db
+
+import (
+	"context"
+	"testing"
+
+	"github.com/dgraph-io/badger"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/util/byteutil"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestSchemaOpen(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	// a fresh store is of the latest version without running any migration
+	runs := 0
+	schema := NewSchema("test", Migration{
+		Description: "count",
+		Run: func(KVStore, []byte, func([]byte) error) error {
+			runs++
+			return nil
+		},
+	})
+	require.Equal(uint64(2), schema.Latest())
+	kv := NewMemKVStore()
+	require.NoError(kv.Start(ctx))
+	version, err := schema.Version(kv)
+	require.NoError(err)
+	require.Equal(uint64(0), version)
+	require.NoError(schema.Open(kv, true))
+	version, err = schema.Version(kv)
+	require.NoError(err)
+	require.Equal(uint64(2), version)
+	require.Equal(0, runs)
+
+	// an existing store not versioned yet is of version 1 and gets migrated
+	kv = NewMemKVStore()
+	require.NoError(kv.Start(ctx))
+	require.NoError(schema.Open(kv, false))
+	version, err = schema.Version(kv)
+	require.NoError(err)
+	require.Equal(uint64(2), version)
+	require.Equal(1, runs)
+	require.NoError(schema.Open(kv, false))
+	require.Equal(1, runs)
+
+	// refuse to open a newer version or another schema
+	require.NoError(kv.Put(SchemaNamespace, schemaVersionKey, byteutil.Uint64ToBytes(3)))
+	require.Equal(ErrUnknownSchema, errors.Cause(schema.Open(kv, false)))
+	require.NoError(kv.Put(SchemaNamespace, schemaVersionKey, byteutil.Uint64ToBytes(2)))
+	require.Equal(ErrUnknownSchema, errors.Cause(NewSchema("other").Open(kv, false)))
+}
+
+func TestSchemaMigrate(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	kv := NewMemKVStore()
+	require.NoError(kv.Start(ctx))
+	for i := byte(0); i < 4; i++ {
+		require.NoError(kv.Put(bucket1, []byte{i}, []byte{i}))
+	}
+
+	// move the records from bucket1 to bucket2 one by one, which fails after the first two
+	failAt := byte(2)
+	move := func(kv KVStore, progress []byte, checkpoint func([]byte) error) error {
+		var start []byte
+		if progress != nil {
+			start = []byte{progress[0] + 1}
+		}
+		var err error
+		if iterErr := kv.Iterate(bucket1, &Range{Start: start}, func(k, v []byte) bool {
+			if k[0] == failAt {
+				err = errors.New("interrupted")
+				return false
+			}
+			batch := NewBatch()
+			batch.Put(bucket2, k, v, "")
+			batch.Delete(bucket1, k, "")
+			if err = kv.Commit(batch); err != nil {
+				return false
+			}
+			err = checkpoint(k)
+			return err == nil
+		}); iterErr != nil {
+			return iterErr
+		}
+		return err
+	}
+	schema := NewSchema("test", Migration{Description: "move", Run: move})
+
+	// dry run writes nothing
+	require.Error(schema.Migrate(kv, true))
+	failAt = 0xff
+	require.NoError(schema.Migrate(kv, true))
+	version, err := schema.Version(kv)
+	require.NoError(err)
+	require.Equal(uint64(0), version)
+	for i := byte(0); i < 4; i++ {
+		_, err := kv.Get(bucket2, []byte{i})
+		require.Error(err)
+	}
+
+	// the interrupted migration resumes from the progress checkpointed
+	failAt = 2
+	require.Error(schema.Migrate(kv, false))
+	version, err = schema.Version(kv)
+	require.NoError(err)
+	require.Equal(uint64(1), version)
+	failAt = 0xff
+	require.NoError(kv.Put(bucket1, []byte{0}, []byte{0}))
+	require.NoError(schema.Migrate(kv, false))
+	version, err = schema.Version(kv)
+	require.NoError(err)
+	require.Equal(uint64(2), version)
+	for i := byte(0); i < 4; i++ {
+		value, err := kv.Get(bucket2, []byte{i})
+		require.NoError(err)
+		require.Equal([]byte{i}, value)
+	}
+	// the record put back before the checkpoint is not migrated again
+	value, err := kv.Get(bucket1, []byte{0})
+	require.NoError(err)
+	require.Equal([]byte{0}, value)
+}
+
+func TestKeyLayoutMigration(t *testing.T) {
+	require := require.New(t)
+	ctx := context.Background()
+
+	path := "test-key-layout.badger"
+	cfg := config.Default.DB
+	cfg.DbPath = path
+	cfg.UseBadgerDB = true
+	testutil.CleanupPath(t, path)
+	defer testutil.CleanupPath(t, path)
+
+	// a BadgerDB of records keyed by the namespace followed by the key is of the legacy layout
+	opts := badger.DefaultOptions
+	opts.Dir = path
+	opts.ValueDir = path
+	legacy, err := badger.Open(opts)
+	require.NoError(err)
+	require.NoError(legacy.Update(func(txn *badger.Txn) error { return txn.Set([]byte("nsb"), []byte("v2")) }))
+	require.NoError(legacy.Close())
+	kv := NewOnDiskDB(cfg)
+	require.NoError(kv.Start(ctx))
+	require.True(legacyKeyLayout(kv))
+	records := map[string]map[string]string{
+		"ns":    {"": "v0", "a": "v1", "b": "v2"},
+		"ns1":   {"a": "v3"},
+		"other": {"a": "v4"},
+	}
+	for ns, kvs := range records {
+		for k, v := range kvs {
+			require.NoError(kv.Put(ns, []byte(k), []byte(v)))
+		}
+	}
+	schema := NewSchema("test", KeyLayoutMigration("ns", "ns1"))
+
+	// dry run writes nothing
+	require.NoError(schema.Migrate(kv, true))
+	require.True(legacyKeyLayout(kv))
+	value, err := kv.Get("ns", []byte("a"))
+	require.NoError(err)
+	require.Equal([]byte("v1"), value)
+
+	// the records of the namespaces are moved, and are no longer mixed up
+	require.NoError(schema.Open(kv, false))
+	check := func() {
+		require.False(legacyKeyLayout(kv))
+		version, err := schema.Version(kv)
+		require.NoError(err)
+		require.Equal(uint64(2), version)
+		for _, ns := range []string{"ns", "ns1"} {
+			for k, v := range records[ns] {
+				value, err := kv.Get(ns, []byte(k))
+				require.NoError(err)
+				require.Equal([]byte(v), value)
+			}
+		}
+		for ns, kvs := range records {
+			n := 0
+			require.NoError(kv.Iterate(ns, nil, func(_, _ []byte) bool {
+				n++
+				return true
+			}))
+			if ns == "other" {
+				require.Equal(0, n)
+			} else {
+				require.Equal(len(kvs), n)
+			}
+		}
+		_, err = kv.Get("other", []byte("a"))
+		require.Equal(ErrNotExist, errors.Cause(err))
+	}
+	check()
+
+	// the db stays of the delimited layout once reopened
+	require.NoError(kv.Stop(ctx))
+	require.NoError(kv.Start(ctx))
+	check()
+	require.NoError(kv.Stop(ctx))
+}
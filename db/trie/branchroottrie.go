@@ -13,8 +13,6 @@ import (
 
 	"github.com/iotexproject/iotex-core/db"
 
-	"github.com/golang/protobuf/proto"
-	"github.com/iotexproject/iotex-core/db/trie/triepb"
 	"github.com/pkg/errors"
 )
 
@@ -113,6 +111,43 @@ func (tr *branchRootTrie) Delete(key []byte) error {
 	return nil
 }
 
+func (tr *branchRootTrie) Prove(key []byte) ([][]byte, error) {
+	trieMtc.WithLabelValues("root", "Prove").Inc()
+	kt, err := tr.checkKeyType(key)
+	if err != nil {
+		return nil, err
+	}
+	proof := [][]byte{}
+	var node Node = tr.root
+	for offset := uint8(0); ; {
+		proof = append(proof, node.serialize())
+		switch n := node.(type) {
+		case *branchNode:
+			node, err = n.child(tr, kt[offset])
+			offset++
+		case *extensionNode:
+			if n.commonPrefixLength(kt[offset:]) != uint8(len(n.path)) {
+				return nil, errors.Wrapf(ErrNotExist, "key %x does not exist", kt)
+			}
+			node, err = n.child(tr)
+			offset += uint8(len(n.path))
+		case *leafNode:
+			if !bytes.Equal(n.key, kt) {
+				return nil, errors.Wrapf(ErrNotExist, "key %x does not exist", kt)
+			}
+			return proof, nil
+		default:
+			return nil, errors.Wrapf(ErrInvalidTrie, "unexpected node type %d", node.Type())
+		}
+		if errors.Cause(err) == ErrNotExist {
+			return nil, errors.Wrapf(ErrNotExist, "key %x does not exist", kt)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
 func (tr *branchRootTrie) Upsert(key []byte, value []byte) error {
 	trieMtc.WithLabelValues("root", "Upsert").Inc()
 	kt, err := tr.checkKeyType(key)
@@ -158,20 +193,7 @@ func (tr *branchRootTrie) loadNodeFromDB(key []byte) (Node, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to get key %x", key)
 	}
-	pb := triepb.NodePb{}
-	if err := proto.Unmarshal(s, &pb); err != nil {
-		return nil, err
-	}
-	if pbBranch := pb.GetBranch(); pbBranch != nil {
-		return newBranchNodeFromProtoPb(pbBranch), nil
-	}
-	if pbLeaf := pb.GetLeaf(); pbLeaf != nil {
-		return newLeafNodeFromProtoPb(pbLeaf), nil
-	}
-	if pbExtend := pb.GetExtend(); pbExtend != nil {
-		return newExtensionNodeFromProtoPb(pbExtend), nil
-	}
-	return nil, errors.New("invalid node type")
+	return decodeNode(s)
 }
 
 func (tr *branchRootTrie) isEmptyRootHash(h []byte) bool {
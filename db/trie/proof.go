@@ -0,0 +1,83 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package trie
+
+import (
+	"bytes"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/db/trie/triepb"
+)
+
+// VerifyProof verifies the proof of a key against the root hash of a trie with the default hash function, and returns
+// the value of the key
+func VerifyProof(rootHash []byte, key []byte, proof [][]byte) ([]byte, error) {
+	return VerifyProofWithHashFunc(DefaultHashFunc, rootHash, key, proof)
+}
+
+// VerifyProofWithHashFunc verifies the proof of a key against the root hash of a trie with the given hash function,
+// and returns the value of the key
+func VerifyProofWithHashFunc(hashFunc HashFunc, rootHash []byte, key []byte, proof [][]byte) ([]byte, error) {
+	expected := rootHash
+	offset := 0
+	for i, ser := range proof {
+		if !bytes.Equal(hashFunc(ser), expected) {
+			return nil, errors.Wrapf(ErrInvalidProof, "hash of node %d doesn't match", i)
+		}
+		node, err := decodeNode(ser)
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidProof, "failed to decode node %d: %v", i, err)
+		}
+		switch n := node.(type) {
+		case *branchNode:
+			if offset >= len(key) {
+				return nil, errors.Wrapf(ErrInvalidProof, "branch node %d is beyond the key", i)
+			}
+			h, ok := n.hashes[key[offset]]
+			if !ok {
+				return nil, errors.Wrapf(ErrInvalidProof, "branch node %d doesn't have the key", i)
+			}
+			expected = h
+			offset++
+		case *extensionNode:
+			if offset+len(n.path) > len(key) || !bytes.Equal(n.path, key[offset:offset+len(n.path)]) {
+				return nil, errors.Wrapf(ErrInvalidProof, "path of extension node %d doesn't match the key", i)
+			}
+			expected = n.childHash
+			offset += len(n.path)
+		case *leafNode:
+			if i != len(proof)-1 {
+				return nil, errors.Wrapf(ErrInvalidProof, "leaf node %d is not the last one", i)
+			}
+			if !bytes.Equal(n.key, key) {
+				return nil, errors.Wrapf(ErrInvalidProof, "key of leaf node %d doesn't match", i)
+			}
+			return n.value, nil
+		}
+	}
+	return nil, errors.Wrap(ErrInvalidProof, "proof doesn't end with a leaf")
+}
+
+// decodeNode deserializes a node from the bytes stored in db
+func decodeNode(s []byte) (Node, error) {
+	pb := triepb.NodePb{}
+	if err := proto.Unmarshal(s, &pb); err != nil {
+		return nil, err
+	}
+	if pbBranch := pb.GetBranch(); pbBranch != nil {
+		return newBranchNodeFromProtoPb(pbBranch), nil
+	}
+	if pbLeaf := pb.GetLeaf(); pbLeaf != nil {
+		return newLeafNodeFromProtoPb(pbLeaf), nil
+	}
+	if pbExtend := pb.GetExtend(); pbExtend != nil {
+		return newExtensionNodeFromProtoPb(pbExtend), nil
+	}
+	return nil, errors.New("invalid node type")
+}
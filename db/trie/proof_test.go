@@ -0,0 +1,60 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package trie
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProof(t *testing.T) {
+	require := require.New(t)
+
+	tr, err := NewTrie(KVStoreOption(newInMemKVStore()), KeyLengthOption(8))
+	require.NoError(err)
+	require.NoError(tr.Start(context.Background()))
+	// the keys make the paths through branch, extension and leaf nodes
+	keys := [][]byte{ham, car, cat, rat, egg, dog, fox, ant}
+	for i, key := range keys {
+		require.NoError(tr.Upsert(key, testV[i]))
+	}
+	root := tr.RootHash()
+
+	for i, key := range keys {
+		proof, err := tr.Prove(key)
+		require.NoError(err)
+		value, err := VerifyProof(root, key, proof)
+		require.NoError(err)
+		require.Equal(testV[i], value)
+	}
+
+	// the proof is only valid for its own key against its own root
+	proof, err := tr.Prove(cat)
+	require.NoError(err)
+	_, err = VerifyProof(root, rat, proof)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyProofWithHashFunc(func(data []byte) []byte {
+		return DefaultHashFunc(append([]byte{0}, data...))
+	}, root, cat, proof)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	_, err = VerifyProof(root, cat, proof[:len(proof)-1])
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+	require.NoError(tr.Upsert(cat, []byte("kitten")))
+	_, err = VerifyProof(tr.RootHash(), cat, proof)
+	require.Equal(ErrInvalidProof, errors.Cause(err))
+
+	// no proof for the keys not in the trie
+	_, err = tr.Prove(cow)
+	require.Equal(ErrNotExist, errors.Cause(err))
+	_, err = tr.Prove(br1)
+	require.Equal(ErrNotExist, errors.Cause(err))
+	_, err = tr.Prove([]byte{1, 2, 3, 4, 5, 6, 7, 0})
+	require.Equal(ErrNotExist, errors.Cause(err))
+}
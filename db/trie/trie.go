@@ -35,6 +35,9 @@ var (
 
 	// ErrNotExist indicates entry does not exist
 	ErrNotExist = errors.New("not exist in trie")
+
+	// ErrInvalidProof indicates the proof fails to prove the entry against the root hash
+	ErrInvalidProof = errors.New("invalid proof")
 )
 
 // DefaultHashFunc implements a default hash function
@@ -59,6 +62,8 @@ type Trie interface {
 	RootHash() []byte
 	// SetRootHash sets a new root to trie
 	SetRootHash([]byte) error
+	// Prove returns the serialized nodes on the path from the root to the leaf of an existing entry
+	Prove([]byte) ([][]byte, error)
 	// DB returns the KVStore storing the node data
 	DB() KVStore
 	// deleteNodeFromDB deletes the data of node from db
@@ -20,6 +20,8 @@ import (
 	"github.com/cenkalti/backoff"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/iotexproject/iotex-core/action"
 	"github.com/iotexproject/iotex-core/address"
@@ -325,11 +327,21 @@ func TestLocalTransfer(t *testing.T) {
 		retryNum := 5
 		retryInterval := 1
 		bo := backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Duration(retryInterval)*time.Second), uint64(retryNum))
+		var sendErr error
 		err = backoff.Retry(func() error {
-			_, err := client.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: tsf.Proto()})
-			return err
+			_, sendErr = client.SendAction(context.Background(), &iotexapi.SendActionRequest{Action: tsf.Proto()})
+			// The action rejected as invalid is never accepted however many times it is sent
+			if status.Code(sendErr) == codes.InvalidArgument {
+				return nil
+			}
+			return sendErr
 		}, bo)
 		require.NoError(err, tsfTest.message)
+		if tsfTest.expectedResult == TsfFail {
+			require.Error(sendErr, tsfTest.message)
+		} else {
+			require.NoError(sendErr, tsfTest.message)
+		}
 
 		switch tsfTest.expectedResult {
 		case TsfSuccess:
@@ -83,11 +83,7 @@ func (gs *GasStation) EstimateGasForAction(actPb *iotextypes.Action) (uint64, er
 		if err != nil {
 			return 0, err
 		}
-		receipt, err := gs.bc.ExecuteContractRead(callerAddr, sc)
-		if err != nil {
-			return 0, err
-		}
-		return receipt.GasConsumed, nil
+		return gs.EstimateGasForExecution(callerAddr, sc)
 	}
 	gas, err := selp.IntrinsicGas()
 	if err != nil {
@@ -96,6 +92,15 @@ func (gs *GasStation) EstimateGasForAction(actPb *iotextypes.Action) (uint64, er
 	return gas, nil
 }
 
+// EstimateGasForExecution estimate gas for an unsigned execution called by the caller
+func (gs *GasStation) EstimateGasForExecution(caller address.Address, sc *action.Execution) (uint64, error) {
+	receipt, err := gs.bc.ExecuteContractRead(caller, sc)
+	if err != nil {
+		return 0, err
+	}
+	return receipt.GasConsumed, nil
+}
+
 type bigIntArray []*big.Int
 
 func (s bigIntArray) Len() int           { return len(s) }
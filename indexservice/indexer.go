@@ -133,9 +133,8 @@ func (idx *Indexer) BuildIndex(blk *block.Block) error {
 			if err := idx.UpdateIndexHistory(blk, tx, config.IndexAction, callerAddr.String(), selp.Hash()); err != nil {
 				return errors.Wrapf(err, "failed to update action to action history table")
 			}
-			// put new transfer for recipient
-			dst, ok := selp.Destination()
-			if ok {
+			// put new transfer for every recipient
+			for _, dst := range selp.Destinations() {
 				if err := idx.UpdateIndexHistory(blk, tx, config.IndexAction, dst, selp.Hash()); err != nil {
 					return errors.Wrapf(err, "failed to update action to action history table")
 				}
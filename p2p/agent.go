@@ -155,6 +155,7 @@ func (p *Agent) Start(ctx context.Context) error {
 			err = errors.Wrap(err, "error when typifying broadcast message")
 			return
 		}
+		ctx = WitContext(ctx, Context{ChainID: broadcast.ChainId, Peer: peerID})
 		p.broadcastInboundHandler(ctx, broadcast.ChainId, msg)
 		return
 	}); err != nil {
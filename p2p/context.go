@@ -13,6 +13,8 @@ type p2pCtxKey struct{}
 // Context provides the auxiliary information Agent network operations
 type Context struct {
 	ChainID uint32
+	// Peer is the ID of the peer from which an inbound message comes
+	Peer string
 }
 
 // WitContext add Agent context into context.
@@ -23,8 +23,50 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type PendingActionEvent int32
+
+const (
+	PendingActionEvent_PendingActionAccepted PendingActionEvent = 0
+	// the nonce of the action is confirmed in a block
+	PendingActionEvent_PendingActionConfirmed PendingActionEvent = 1
+	// replaced by an action with the same nonce and a higher gas price
+	PendingActionEvent_PendingActionReplaced PendingActionEvent = 2
+	// evicted by an action with a higher gas price as actpool is full
+	PendingActionEvent_PendingActionEvicted PendingActionEvent = 3
+	PendingActionEvent_PendingActionExpired PendingActionEvent = 4
+	// the balance of the sender is insufficient
+	PendingActionEvent_PendingActionInvalidated PendingActionEvent = 5
+)
+
+var PendingActionEvent_name = map[int32]string{
+	0: "PendingActionAccepted",
+	1: "PendingActionConfirmed",
+	2: "PendingActionReplaced",
+	3: "PendingActionEvicted",
+	4: "PendingActionExpired",
+	5: "PendingActionInvalidated",
+}
+
+var PendingActionEvent_value = map[string]int32{
+	"PendingActionAccepted":    0,
+	"PendingActionConfirmed":   1,
+	"PendingActionReplaced":    2,
+	"PendingActionEvicted":     3,
+	"PendingActionExpired":     4,
+	"PendingActionInvalidated": 5,
+}
+
+func (x PendingActionEvent) String() string {
+	return proto.EnumName(PendingActionEvent_name, int32(x))
+}
+
+func (PendingActionEvent) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{0}
+}
+
 type GetAccountRequest struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Height               uint64   `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -62,6 +104,13 @@ func (m *GetAccountRequest) GetAddress() string {
 	return ""
 }
 
+func (m *GetAccountRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type GetAccountResponse struct {
 	AccountMeta          *iotextypes.AccountMeta `protobuf:"bytes,1,opt,name=accountMeta,proto3" json:"accountMeta,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
@@ -1019,6 +1068,7 @@ func (m *GetReceiptByActionResponse) GetReceipt() *iotextypes.Receipt {
 
 type ReadContractRequest struct {
 	Action               *iotextypes.Action `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Height               uint64             `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -1056,6 +1106,13 @@ func (m *ReadContractRequest) GetAction() *iotextypes.Action {
 	return nil
 }
 
+func (m *ReadContractRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 type ReadContractResponse struct {
 	Data                 string   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -1423,297 +1480,2143 @@ func (m *GetProductivityResponse) GetBlksPerDelegate() map[string]uint64 {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetAccountRequest)(nil), "iotexapi.GetAccountRequest")
-	proto.RegisterType((*GetAccountResponse)(nil), "iotexapi.GetAccountResponse")
-	proto.RegisterType((*GetActionsRequest)(nil), "iotexapi.GetActionsRequest")
-	proto.RegisterType((*GetActionsByIndexRequest)(nil), "iotexapi.GetActionsByIndexRequest")
-	proto.RegisterType((*GetActionByHashRequest)(nil), "iotexapi.GetActionByHashRequest")
-	proto.RegisterType((*GetActionsByAddressRequest)(nil), "iotexapi.GetActionsByAddressRequest")
-	proto.RegisterType((*GetUnconfirmedActionsByAddressRequest)(nil), "iotexapi.GetUnconfirmedActionsByAddressRequest")
-	proto.RegisterType((*GetActionsByBlockRequest)(nil), "iotexapi.GetActionsByBlockRequest")
-	proto.RegisterType((*GetActionsResponse)(nil), "iotexapi.GetActionsResponse")
-	proto.RegisterType((*GetBlockMetasRequest)(nil), "iotexapi.GetBlockMetasRequest")
-	proto.RegisterType((*GetBlockMetasByIndexRequest)(nil), "iotexapi.GetBlockMetasByIndexRequest")
-	proto.RegisterType((*GetBlockMetaByHashRequest)(nil), "iotexapi.GetBlockMetaByHashRequest")
-	proto.RegisterType((*GetBlockMetasResponse)(nil), "iotexapi.GetBlockMetasResponse")
-	proto.RegisterType((*GetChainMetaRequest)(nil), "iotexapi.GetChainMetaRequest")
-	proto.RegisterType((*GetChainMetaResponse)(nil), "iotexapi.GetChainMetaResponse")
-	proto.RegisterType((*GetServerMetaRequest)(nil), "iotexapi.GetServerMetaRequest")
-	proto.RegisterType((*GetServerMetaResponse)(nil), "iotexapi.GetServerMetaResponse")
-	proto.RegisterType((*SendActionRequest)(nil), "iotexapi.SendActionRequest")
-	proto.RegisterType((*SendActionResponse)(nil), "iotexapi.SendActionResponse")
-	proto.RegisterType((*GetReceiptByActionRequest)(nil), "iotexapi.GetReceiptByActionRequest")
-	proto.RegisterType((*GetReceiptByActionResponse)(nil), "iotexapi.GetReceiptByActionResponse")
-	proto.RegisterType((*ReadContractRequest)(nil), "iotexapi.ReadContractRequest")
-	proto.RegisterType((*ReadContractResponse)(nil), "iotexapi.ReadContractResponse")
-	proto.RegisterType((*SuggestGasPriceRequest)(nil), "iotexapi.SuggestGasPriceRequest")
-	proto.RegisterType((*SuggestGasPriceResponse)(nil), "iotexapi.SuggestGasPriceResponse")
-	proto.RegisterType((*EstimateGasForActionRequest)(nil), "iotexapi.EstimateGasForActionRequest")
-	proto.RegisterType((*EstimateGasForActionResponse)(nil), "iotexapi.EstimateGasForActionResponse")
-	proto.RegisterType((*ReadStateRequest)(nil), "iotexapi.ReadStateRequest")
-	proto.RegisterType((*ReadStateResponse)(nil), "iotexapi.ReadStateResponse")
-	proto.RegisterType((*GetProductivityRequest)(nil), "iotexapi.GetProductivityRequest")
-	proto.RegisterType((*GetProductivityResponse)(nil), "iotexapi.GetProductivityResponse")
-	proto.RegisterMapType((map[string]uint64)(nil), "iotexapi.GetProductivityResponse.BlksPerDelegateEntry")
+type StreamBlocksRequest struct {
+	// start height of the stream, 0 means only new blocks
+	StartHeight          uint64   `protobuf:"varint,1,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("proto/api/api.proto", fileDescriptor_ca6d5bbc959d58c0) }
+func (m *StreamBlocksRequest) Reset()         { *m = StreamBlocksRequest{} }
+func (m *StreamBlocksRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksRequest) ProtoMessage()    {}
+func (*StreamBlocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{31}
+}
 
-var fileDescriptor_ca6d5bbc959d58c0 = []byte{
-	// 1164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xff, 0x6f, 0xdb, 0x44,
-	0x14, 0x4f, 0x9b, 0xae, 0x6d, 0x5e, 0x83, 0xd6, 0x5e, 0xd3, 0x36, 0xb8, 0xa5, 0x94, 0x63, 0x83,
-	0x6a, 0xa2, 0x29, 0x74, 0xac, 0x82, 0x22, 0x86, 0xe2, 0x6d, 0xed, 0xca, 0xc4, 0x5a, 0xb9, 0x42,
-	0x42, 0x08, 0x89, 0x9d, 0xed, 0x23, 0x31, 0x71, 0x7c, 0xc1, 0xbe, 0x54, 0xcb, 0xbf, 0xc3, 0xff,
-	0xc5, 0xbf, 0xc1, 0xaf, 0xa0, 0x3b, 0x9f, 0xed, 0xf3, 0xb7, 0x8c, 0x4d, 0xfc, 0x10, 0x29, 0xf7,
-	0xee, 0xbd, 0xcf, 0x7b, 0xf7, 0x79, 0xf7, 0x3e, 0x3e, 0xd8, 0x9c, 0x84, 0x8c, 0xb3, 0x63, 0x32,
-	0xf1, 0xc4, 0xaf, 0x27, 0x57, 0x68, 0xd5, 0x63, 0x9c, 0xbe, 0x26, 0x13, 0xcf, 0x68, 0x13, 0x87,
-	0x7b, 0x2c, 0x88, 0xed, 0xc6, 0xba, 0xed, 0x33, 0x67, 0xe4, 0x0c, 0x89, 0x97, 0x58, 0x20, 0x60,
-	0x2e, 0x8d, 0xff, 0xe3, 0x23, 0xd8, 0xb8, 0xa0, 0xbc, 0xef, 0x38, 0x6c, 0x1a, 0x70, 0x8b, 0xfe,
-	0x31, 0xa5, 0x11, 0x47, 0x5d, 0x58, 0x21, 0xae, 0x1b, 0xd2, 0x28, 0xea, 0x2e, 0x1c, 0x2c, 0x1c,
-	0xb6, 0xac, 0x64, 0x89, 0xaf, 0x00, 0xe9, 0xee, 0xd1, 0x84, 0x05, 0x11, 0x45, 0x5f, 0xc3, 0x1a,
-	0x89, 0x4d, 0x3f, 0x50, 0x4e, 0x64, 0xcc, 0xda, 0xc9, 0x4e, 0x4f, 0x16, 0xc4, 0x67, 0x13, 0x1a,
-	0xf5, 0xfa, 0xd9, 0xb6, 0xa5, 0xfb, 0xe2, 0xbf, 0x17, 0x55, 0x01, 0xa2, 0xe2, 0x28, 0x29, 0xe0,
-	0x31, 0xac, 0xd8, 0xb3, 0xcb, 0xc0, 0xa5, 0xaf, 0x15, 0x18, 0xee, 0x25, 0xa7, 0xeb, 0x65, 0xde,
-	0x66, 0xec, 0xa2, 0x82, 0x9e, 0x37, 0xac, 0x24, 0x08, 0x9d, 0xc1, 0xb2, 0x3d, 0x7b, 0x4e, 0xa2,
-	0x61, 0x77, 0x51, 0x86, 0x1f, 0x54, 0x84, 0x9b, 0xd2, 0x21, 0x0b, 0x56, 0x11, 0xe8, 0xb1, 0x88,
-	0xed, 0xbb, 0x6e, 0xd8, 0x6d, 0xca, 0xd8, 0x7b, 0xd5, 0xa9, 0xfb, 0x31, 0x23, 0xb9, 0x78, 0x61,
-	0x43, 0xbf, 0xc2, 0xc6, 0x34, 0x70, 0x58, 0xf0, 0x9b, 0x17, 0x8e, 0xa9, 0x1b, 0x3b, 0x76, 0x97,
-	0x24, 0xd4, 0x71, 0x0e, 0xea, 0xc7, 0xcc, 0xab, 0x1e, 0xb5, 0x8c, 0x85, 0xce, 0xe0, 0x8e, 0x3d,
-	0x33, 0xfd, 0x51, 0xf7, 0xce, 0x3c, 0x6a, 0x4c, 0xd1, 0xf5, 0x0c, 0x27, 0x0e, 0x31, 0x57, 0x61,
-	0xd9, 0x67, 0x6c, 0x34, 0x9d, 0xe0, 0x73, 0xe8, 0xd6, 0x31, 0x89, 0x3a, 0x70, 0x27, 0xe2, 0x24,
-	0xe4, 0x92, 0xfc, 0x25, 0x2b, 0x5e, 0x08, 0xab, 0xec, 0x9b, 0xe4, 0x74, 0xc9, 0x8a, 0x17, 0xf8,
-	0x17, 0xd8, 0xae, 0xa6, 0x14, 0xed, 0x03, 0xc4, 0x17, 0x51, 0x36, 0x22, 0xbe, 0x48, 0x9a, 0x05,
-	0x61, 0x68, 0x3b, 0x43, 0xea, 0x8c, 0xae, 0x69, 0xe0, 0x7a, 0xc1, 0x40, 0xc2, 0xae, 0x5a, 0x39,
-	0x1b, 0xb6, 0xc1, 0xa8, 0x27, 0xbd, 0xfe, 0x9e, 0x66, 0x27, 0x58, 0xac, 0x3c, 0x41, 0x53, 0x3f,
-	0xc1, 0x18, 0xee, 0xff, 0xa7, 0x6e, 0xfc, 0x4f, 0xe9, 0x5e, 0xe5, 0x89, 0xd7, 0xfb, 0x24, 0x32,
-	0xd8, 0xfe, 0x48, 0xe3, 0x2b, 0x59, 0xbe, 0x55, 0x06, 0x53, 0x0d, 0xa9, 0x1a, 0x29, 0x35, 0xa4,
-	0x9f, 0xc1, 0x4a, 0x4c, 0xbe, 0xa8, 0xbe, 0x79, 0xb8, 0x76, 0x82, 0xf2, 0x03, 0x2a, 0xb6, 0xac,
-	0xc4, 0x05, 0xff, 0xb9, 0x00, 0x9d, 0x0b, 0xca, 0x65, 0x75, 0x62, 0x50, 0x53, 0x12, 0xfa, 0xc5,
-	0xd1, 0xbc, 0x9f, 0xbb, 0x7f, 0x59, 0x40, 0xfd, 0x74, 0x7e, 0x5b, 0x98, 0xce, 0x8f, 0xab, 0x11,
-	0x6a, 0x06, 0x54, 0xbb, 0xc3, 0x97, 0xb0, 0x3b, 0x27, 0xe5, 0x5b, 0x5d, 0xe3, 0x47, 0xf0, 0x7e,
-	0x6d, 0xee, 0xfa, 0xb6, 0xe0, 0xef, 0x61, 0xab, 0xc0, 0x92, 0x62, 0xfb, 0x0b, 0x58, 0xb5, 0xfd,
-	0xd8, 0xa6, 0xe8, 0xde, 0xd2, 0xe9, 0x4e, 0x23, 0xac, 0xd4, 0x0d, 0x6f, 0xc1, 0xe6, 0x05, 0xe5,
-	0x4f, 0x84, 0x50, 0xcb, 0x9d, 0x38, 0x39, 0x7e, 0x21, 0x1b, 0xa1, 0x99, 0x55, 0x86, 0x87, 0xd0,
-	0x72, 0x12, 0xa3, 0x6a, 0x45, 0x2e, 0x45, 0x16, 0x91, 0xf9, 0xe1, 0x6d, 0x09, 0x76, 0x43, 0xc3,
-	0x5b, 0x1a, 0xea, 0x49, 0xae, 0xe4, 0x39, 0x74, 0xbb, 0xca, 0x72, 0x0a, 0x10, 0xa5, 0x56, 0x95,
-	0x66, 0x5b, 0x4f, 0xa3, 0xc5, 0x68, 0x9e, 0xf8, 0x3b, 0xd8, 0xb8, 0xa1, 0x81, 0x1a, 0xa5, 0x84,
-	0xc7, 0x07, 0xb0, 0x1c, 0xdf, 0x2f, 0x05, 0x54, 0x75, 0x03, 0x95, 0x07, 0xee, 0x00, 0xd2, 0x01,
-	0xe2, 0x72, 0xf0, 0x37, 0xb2, 0x4d, 0x16, 0x75, 0xa8, 0x37, 0xe1, 0xe6, 0x2c, 0x0f, 0xff, 0x06,
-	0xc1, 0xc1, 0x2f, 0xa4, 0x98, 0x94, 0x82, 0xd5, 0x49, 0x8f, 0x60, 0x25, 0x8c, 0xb7, 0x54, 0x75,
-	0x9b, 0x7a, 0x75, 0x2a, 0xca, 0x4a, 0x7c, 0x70, 0x1f, 0x36, 0x2d, 0x4a, 0xdc, 0x27, 0x2c, 0xe0,
-	0x21, 0x71, 0xf8, 0xbb, 0x1c, 0xf1, 0x01, 0x74, 0xf2, 0x10, 0xaa, 0x12, 0x04, 0x4b, 0x2e, 0x51,
-	0x6c, 0xb7, 0x2c, 0xf9, 0x1f, 0x77, 0x61, 0xfb, 0x66, 0x3a, 0x18, 0xd0, 0x88, 0x5f, 0x90, 0xe8,
-	0x3a, 0xf4, 0x1c, 0x9a, 0xb4, 0xee, 0x11, 0xec, 0x94, 0x76, 0x14, 0x90, 0x01, 0xab, 0x03, 0x65,
-	0x53, 0x33, 0x90, 0xae, 0xc5, 0xec, 0x3c, 0x8b, 0xb8, 0x37, 0x26, 0x9c, 0x5e, 0x90, 0xe8, 0x9c,
-	0x85, 0xef, 0xde, 0xaa, 0xcf, 0x61, 0xaf, 0x1a, 0x4a, 0x95, 0xb1, 0x0e, 0xcd, 0x01, 0x89, 0x54,
-	0x05, 0xe2, 0x2f, 0x9e, 0xc0, 0xba, 0x38, 0xf9, 0x0d, 0x27, 0x9c, 0x6a, 0xdd, 0x93, 0x4f, 0x12,
-	0x87, 0xf9, 0x97, 0x4f, 0xa5, 0x73, 0xdb, 0xd2, 0x2c, 0x62, 0x7f, 0x4c, 0xf9, 0x90, 0xb9, 0x2f,
-	0xc9, 0x98, 0xca, 0xe1, 0x6d, 0x5b, 0x9a, 0x05, 0xed, 0x41, 0x8b, 0x84, 0x83, 0xe9, 0x98, 0x06,
-	0x3c, 0xea, 0x36, 0x0f, 0x9a, 0x87, 0x6d, 0x2b, 0x33, 0xe0, 0x4f, 0x61, 0x43, 0xcb, 0x58, 0x41,
-	0x74, 0x5b, 0x11, 0x7d, 0x26, 0xbf, 0x67, 0xd7, 0x21, 0x73, 0xa7, 0x0e, 0xf7, 0x6e, 0x3d, 0x3e,
-	0x4b, 0x0a, 0x3c, 0x80, 0x35, 0x3a, 0x61, 0xce, 0xf0, 0xe5, 0x74, 0x6c, 0xd3, 0x50, 0x1d, 0x47,
-	0x37, 0xe1, 0xbf, 0x16, 0x60, 0xa7, 0x14, 0xac, 0x72, 0xed, 0x41, 0x8b, 0x33, 0x4e, 0x7c, 0xd3,
-	0x1f, 0x25, 0x54, 0x64, 0x06, 0xf4, 0x0a, 0xee, 0xda, 0xfe, 0x28, 0xba, 0xa6, 0xe1, 0x53, 0xea,
-	0xd3, 0x01, 0xe1, 0xe2, 0x84, 0x42, 0x35, 0x4e, 0x73, 0xda, 0x58, 0x85, 0xdc, 0x33, 0xf3, 0x81,
-	0xcf, 0x02, 0x1e, 0xce, 0xac, 0x22, 0x9c, 0x61, 0x42, 0xa7, 0xca, 0x51, 0x34, 0x67, 0x44, 0x67,
-	0xea, 0xae, 0x89, 0xbf, 0x42, 0x20, 0x6f, 0x89, 0x3f, 0xa5, 0x89, 0x40, 0xca, 0xc5, 0xd9, 0xe2,
-	0x57, 0x0b, 0x27, 0xff, 0xac, 0x00, 0xf4, 0xaf, 0x2f, 0xc5, 0xc8, 0x7b, 0x0e, 0x45, 0x97, 0x00,
-	0xd9, 0x63, 0x10, 0xed, 0x16, 0xde, 0x21, 0xfa, 0x8b, 0xd2, 0xd8, 0xab, 0xde, 0x54, 0x53, 0xdd,
-	0x48, 0xa1, 0xe4, 0xc7, 0xa7, 0x04, 0xa5, 0xbf, 0x0d, 0x4b, 0x50, 0xb9, 0xaf, 0x1c, 0x6e, 0x20,
-	0x0b, 0xde, 0xcb, 0x49, 0x32, 0xda, 0xaf, 0xf9, 0x40, 0x25, 0x80, 0x1f, 0xd6, 0xee, 0xa7, 0x98,
-	0x57, 0xd0, 0xd6, 0x35, 0x18, 0x7d, 0x90, 0x0b, 0x29, 0x4a, 0xb6, 0xb1, 0x5f, 0xb7, 0x5d, 0x28,
-	0x32, 0xd3, 0xce, 0x42, 0x91, 0x25, 0x81, 0x2e, 0x14, 0x59, 0x16, 0xea, 0x98, 0xc3, 0x4c, 0x31,
-	0x75, 0x0e, 0x4b, 0x42, 0xac, 0x73, 0x58, 0x21, 0xb2, 0x0d, 0x44, 0xe4, 0x0b, 0xa2, 0xa0, 0x94,
-	0x28, 0xff, 0x9d, 0xae, 0x16, 0x61, 0xe3, 0xde, 0x7c, 0x27, 0x9d, 0x52, 0x5d, 0xfc, 0x74, 0x4a,
-	0x2b, 0x74, 0x55, 0xa7, 0xb4, 0x4a, 0x33, 0x71, 0x03, 0xfd, 0x04, 0x77, 0x0b, 0x3a, 0x88, 0xb4,
-	0x67, 0x7f, 0xb5, 0x78, 0x1a, 0x1f, 0xcd, 0xf1, 0x48, 0x91, 0x07, 0xd0, 0xa9, 0xd2, 0x37, 0xa4,
-	0xbd, 0x7c, 0xe6, 0x48, 0xa9, 0xf1, 0xc9, 0x9b, 0xdc, 0xd2, 0x44, 0xe7, 0xd0, 0x4a, 0x45, 0x0a,
-	0x19, 0xf9, 0x13, 0xeb, 0x5a, 0x69, 0xec, 0x56, 0xee, 0xe9, 0x54, 0x14, 0xc4, 0x02, 0x1d, 0xcc,
-	0xd1, 0x91, 0x12, 0x15, 0x35, 0x4a, 0x83, 0x1b, 0xe6, 0xe9, 0xcf, 0x5f, 0x0e, 0x3c, 0x3e, 0x9c,
-	0xda, 0x3d, 0x87, 0x8d, 0x8f, 0x65, 0xc0, 0x24, 0x64, 0xbf, 0x53, 0x87, 0xc7, 0x8b, 0x23, 0x87,
-	0x85, 0xf4, 0x58, 0xaa, 0xf6, 0x80, 0x06, 0xc7, 0x09, 0xa2, 0xbd, 0x2c, 0x4d, 0x0f, 0xff, 0x0d,
-	0x00, 0x00, 0xff, 0xff, 0x4d, 0x0d, 0x66, 0x89, 0xba, 0x0e, 0x00, 0x00,
+func (m *StreamBlocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBlocksRequest.Unmarshal(m, b)
+}
+func (m *StreamBlocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBlocksRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamBlocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksRequest.Merge(m, src)
+}
+func (m *StreamBlocksRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamBlocksRequest.Size(m)
+}
+func (m *StreamBlocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_StreamBlocksRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *StreamBlocksRequest) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
 
-// APIServiceClient is the client API for APIService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type APIServiceClient interface {
-	// get the address detail of an address
-	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
-	// get action(s) by:
-	// 1. start index and action count
-	// 2. action hash
-	// 3. address with start index and action count
-	// 4. get unconfirmed actions by address with start index and action count
-	// 5. block hash with start index and action count
-	GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error)
-	// get block metadata(s) by:
-	// 1. start index and block count
-	// 2. block hash
-	GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error)
-	// get chain metadata
-	GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error)
-	// get server version
-	GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error)
-	// sendAction
-	SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error)
-	// get receipt by action Hash
-	GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error)
-	// TODO: read contract
-	ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error)
-	// suggest gas price
-	SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error)
-	// estimate gas for action
-	EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error)
-	// read state from blockchain
-	ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error)
-	// get block producers' productivity metrics
-	GetProductivity(ctx context.Context, in *GetProductivityRequest, opts ...grpc.CallOption) (*GetProductivityResponse, error)
+type StreamBlocksResponse struct {
+	Block                *BlockInfo `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-type aPIServiceClient struct {
-	cc *grpc.ClientConn
+func (m *StreamBlocksResponse) Reset()         { *m = StreamBlocksResponse{} }
+func (m *StreamBlocksResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamBlocksResponse) ProtoMessage()    {}
+func (*StreamBlocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{32}
 }
 
-func NewAPIServiceClient(cc *grpc.ClientConn) APIServiceClient {
-	return &aPIServiceClient{cc}
+func (m *StreamBlocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamBlocksResponse.Unmarshal(m, b)
+}
+func (m *StreamBlocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamBlocksResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamBlocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamBlocksResponse.Merge(m, src)
+}
+func (m *StreamBlocksResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamBlocksResponse.Size(m)
+}
+func (m *StreamBlocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamBlocksResponse.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
-	out := new(GetAccountResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamBlocksResponse proto.InternalMessageInfo
+
+func (m *StreamBlocksResponse) GetBlock() *BlockInfo {
+	if m != nil {
+		return m.Block
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error) {
-	out := new(GetActionsResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type BlockInfo struct {
+	BlkMeta              *iotextypes.BlockMeta `protobuf:"bytes,1,opt,name=blkMeta,proto3" json:"blkMeta,omitempty"`
+	Actions              []*iotextypes.Action  `protobuf:"bytes,2,rep,name=actions,proto3" json:"actions,omitempty"`
+	Receipts             []*iotextypes.Receipt `protobuf:"bytes,3,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (c *aPIServiceClient) GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error) {
-	out := new(GetBlockMetasResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetBlockMetas", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BlockInfo) Reset()         { *m = BlockInfo{} }
+func (m *BlockInfo) String() string { return proto.CompactTextString(m) }
+func (*BlockInfo) ProtoMessage()    {}
+func (*BlockInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{33}
 }
 
-func (c *aPIServiceClient) GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error) {
-	out := new(GetChainMetaResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetChainMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *BlockInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BlockInfo.Unmarshal(m, b)
+}
+func (m *BlockInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BlockInfo.Marshal(b, m, deterministic)
+}
+func (m *BlockInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockInfo.Merge(m, src)
+}
+func (m *BlockInfo) XXX_Size() int {
+	return xxx_messageInfo_BlockInfo.Size(m)
+}
+func (m *BlockInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockInfo.DiscardUnknown(m)
 }
 
-func (c *aPIServiceClient) GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error) {
-	out := new(GetServerMetaResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetServerMeta", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BlockInfo proto.InternalMessageInfo
+
+func (m *BlockInfo) GetBlkMeta() *iotextypes.BlockMeta {
+	if m != nil {
+		return m.BlkMeta
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error) {
-	out := new(SendActionResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SendAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BlockInfo) GetActions() []*iotextypes.Action {
+	if m != nil {
+		return m.Actions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error) {
-	out := new(GetReceiptByActionResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetReceiptByAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BlockInfo) GetReceipts() []*iotextypes.Receipt {
+	if m != nil {
+		return m.Receipts
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error) {
-	out := new(ReadContractResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadContract", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type LogsFilter struct {
+	// emitting addresses, empty means any address
+	Address []string `protobuf:"bytes,1,rep,name=address,proto3" json:"address,omitempty"`
+	// accepted topics at each position, an empty entry means any topic
+	Topics               []*Topics `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (c *aPIServiceClient) SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error) {
-	out := new(SuggestGasPriceResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SuggestGasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LogsFilter) Reset()         { *m = LogsFilter{} }
+func (m *LogsFilter) String() string { return proto.CompactTextString(m) }
+func (*LogsFilter) ProtoMessage()    {}
+func (*LogsFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{34}
 }
 
-func (c *aPIServiceClient) EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error) {
-	out := new(EstimateGasForActionResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/EstimateGasForAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LogsFilter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsFilter.Unmarshal(m, b)
+}
+func (m *LogsFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsFilter.Marshal(b, m, deterministic)
+}
+func (m *LogsFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsFilter.Merge(m, src)
+}
+func (m *LogsFilter) XXX_Size() int {
+	return xxx_messageInfo_LogsFilter.Size(m)
+}
+func (m *LogsFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsFilter proto.InternalMessageInfo
+
+func (m *LogsFilter) GetAddress() []string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error) {
-	out := new(ReadStateResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LogsFilter) GetTopics() []*Topics {
+	if m != nil {
+		return m.Topics
 	}
-	return out, nil
+	return nil
 }
 
-func (c *aPIServiceClient) GetProductivity(ctx context.Context, in *GetProductivityRequest, opts ...grpc.CallOption) (*GetProductivityResponse, error) {
-	out := new(GetProductivityResponse)
-	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetProductivity", in, out, opts...)
-	if err != nil {
-		return nil, err
+type Topics struct {
+	Topic                [][]byte `protobuf:"bytes,1,rep,name=topic,proto3" json:"topic,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Topics) Reset()         { *m = Topics{} }
+func (m *Topics) String() string { return proto.CompactTextString(m) }
+func (*Topics) ProtoMessage()    {}
+func (*Topics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{35}
+}
+
+func (m *Topics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Topics.Unmarshal(m, b)
+}
+func (m *Topics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Topics.Marshal(b, m, deterministic)
+}
+func (m *Topics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Topics.Merge(m, src)
+}
+func (m *Topics) XXX_Size() int {
+	return xxx_messageInfo_Topics.Size(m)
+}
+func (m *Topics) XXX_DiscardUnknown() {
+	xxx_messageInfo_Topics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Topics proto.InternalMessageInfo
+
+func (m *Topics) GetTopic() [][]byte {
+	if m != nil {
+		return m.Topic
 	}
-	return out, nil
+	return nil
 }
 
-// APIServiceServer is the server API for APIService service.
-type APIServiceServer interface {
-	// get the address detail of an address
-	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
-	// get action(s) by:
-	// 1. start index and action count
-	// 2. action hash
-	// 3. address with start index and action count
-	// 4. get unconfirmed actions by address with start index and action count
-	// 5. block hash with start index and action count
-	GetActions(context.Context, *GetActionsRequest) (*GetActionsResponse, error)
-	// get block metadata(s) by:
-	// 1. start index and block count
-	// 2. block hash
-	GetBlockMetas(context.Context, *GetBlockMetasRequest) (*GetBlockMetasResponse, error)
-	// get chain metadata
+type GetLogsRequest struct {
+	Filter    *LogsFilter `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	FromBlock uint64      `protobuf:"varint,2,opt,name=fromBlock,proto3" json:"fromBlock,omitempty"`
+	// end of the block range (inclusive), 0 means the tip
+	ToBlock              uint64   `protobuf:"varint,3,opt,name=toBlock,proto3" json:"toBlock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLogsRequest) Reset()         { *m = GetLogsRequest{} }
+func (m *GetLogsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLogsRequest) ProtoMessage()    {}
+func (*GetLogsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{36}
+}
+
+func (m *GetLogsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsRequest.Unmarshal(m, b)
+}
+func (m *GetLogsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLogsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsRequest.Merge(m, src)
+}
+func (m *GetLogsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLogsRequest.Size(m)
+}
+func (m *GetLogsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsRequest proto.InternalMessageInfo
+
+func (m *GetLogsRequest) GetFilter() *LogsFilter {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *GetLogsRequest) GetFromBlock() uint64 {
+	if m != nil {
+		return m.FromBlock
+	}
+	return 0
+}
+
+func (m *GetLogsRequest) GetToBlock() uint64 {
+	if m != nil {
+		return m.ToBlock
+	}
+	return 0
+}
+
+type GetLogsResponse struct {
+	Logs                 []*iotextypes.Log `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetLogsResponse) Reset()         { *m = GetLogsResponse{} }
+func (m *GetLogsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLogsResponse) ProtoMessage()    {}
+func (*GetLogsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{37}
+}
+
+func (m *GetLogsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLogsResponse.Unmarshal(m, b)
+}
+func (m *GetLogsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLogsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetLogsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLogsResponse.Merge(m, src)
+}
+func (m *GetLogsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLogsResponse.Size(m)
+}
+func (m *GetLogsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLogsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLogsResponse proto.InternalMessageInfo
+
+func (m *GetLogsResponse) GetLogs() []*iotextypes.Log {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type GetAccountProofRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys          [][]byte `protobuf:"bytes,2,rep,name=storageKeys,proto3" json:"storageKeys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAccountProofRequest) Reset()         { *m = GetAccountProofRequest{} }
+func (m *GetAccountProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountProofRequest) ProtoMessage()    {}
+func (*GetAccountProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{38}
+}
+
+func (m *GetAccountProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountProofRequest.Unmarshal(m, b)
+}
+func (m *GetAccountProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountProofRequest.Merge(m, src)
+}
+func (m *GetAccountProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountProofRequest.Size(m)
+}
+func (m *GetAccountProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountProofRequest proto.InternalMessageInfo
+
+func (m *GetAccountProofRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GetAccountProofRequest) GetStorageKeys() [][]byte {
+	if m != nil {
+		return m.StorageKeys
+	}
+	return nil
+}
+
+type StorageProof struct {
+	Key                  []byte   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Proof                [][]byte `protobuf:"bytes,3,rep,name=proof,proto3" json:"proof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageProof) Reset()         { *m = StorageProof{} }
+func (m *StorageProof) String() string { return proto.CompactTextString(m) }
+func (*StorageProof) ProtoMessage()    {}
+func (*StorageProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{39}
+}
+
+func (m *StorageProof) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageProof.Unmarshal(m, b)
+}
+func (m *StorageProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageProof.Marshal(b, m, deterministic)
+}
+func (m *StorageProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageProof.Merge(m, src)
+}
+func (m *StorageProof) XXX_Size() int {
+	return xxx_messageInfo_StorageProof.Size(m)
+}
+func (m *StorageProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageProof proto.InternalMessageInfo
+
+func (m *StorageProof) GetKey() []byte {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (m *StorageProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *StorageProof) GetProof() [][]byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+type GetAccountProofResponse struct {
+	StateRoot []byte `protobuf:"bytes,1,opt,name=stateRoot,proto3" json:"stateRoot,omitempty"`
+	// the serialized account state, which is the value proved by the account proof
+	Account              []byte          `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	AccountProof         [][]byte        `protobuf:"bytes,3,rep,name=accountProof,proto3" json:"accountProof,omitempty"`
+	StorageRoot          []byte          `protobuf:"bytes,4,opt,name=storageRoot,proto3" json:"storageRoot,omitempty"`
+	StorageProofs        []*StorageProof `protobuf:"bytes,5,rep,name=storageProofs,proto3" json:"storageProofs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetAccountProofResponse) Reset()         { *m = GetAccountProofResponse{} }
+func (m *GetAccountProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountProofResponse) ProtoMessage()    {}
+func (*GetAccountProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{40}
+}
+
+func (m *GetAccountProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountProofResponse.Unmarshal(m, b)
+}
+func (m *GetAccountProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountProofResponse.Merge(m, src)
+}
+func (m *GetAccountProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountProofResponse.Size(m)
+}
+func (m *GetAccountProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountProofResponse proto.InternalMessageInfo
+
+func (m *GetAccountProofResponse) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *GetAccountProofResponse) GetAccount() []byte {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *GetAccountProofResponse) GetAccountProof() [][]byte {
+	if m != nil {
+		return m.AccountProof
+	}
+	return nil
+}
+
+func (m *GetAccountProofResponse) GetStorageRoot() []byte {
+	if m != nil {
+		return m.StorageRoot
+	}
+	return nil
+}
+
+func (m *GetAccountProofResponse) GetStorageProofs() []*StorageProof {
+	if m != nil {
+		return m.StorageProofs
+	}
+	return nil
+}
+
+type GetActionProofRequest struct {
+	ActionHash           string   `protobuf:"bytes,1,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActionProofRequest) Reset()         { *m = GetActionProofRequest{} }
+func (m *GetActionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActionProofRequest) ProtoMessage()    {}
+func (*GetActionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{41}
+}
+
+func (m *GetActionProofRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionProofRequest.Unmarshal(m, b)
+}
+func (m *GetActionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionProofRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionProofRequest.Merge(m, src)
+}
+func (m *GetActionProofRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActionProofRequest.Size(m)
+}
+func (m *GetActionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionProofRequest proto.InternalMessageInfo
+
+func (m *GetActionProofRequest) GetActionHash() string {
+	if m != nil {
+		return m.ActionHash
+	}
+	return ""
+}
+
+type GetActionProofResponse struct {
+	BlockHeader *iotextypes.BlockHeader `protobuf:"bytes,1,opt,name=blockHeader,proto3" json:"blockHeader,omitempty"`
+	// index of the action and the receipt in the block
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// merkle path of the action hash to the tx root
+	ActionProof [][]byte            `protobuf:"bytes,3,rep,name=actionProof,proto3" json:"actionProof,omitempty"`
+	Receipt     *iotextypes.Receipt `protobuf:"bytes,4,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	// merkle path of the receipt hash to the receipt root
+	ReceiptProof         [][]byte `protobuf:"bytes,5,rep,name=receiptProof,proto3" json:"receiptProof,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActionProofResponse) Reset()         { *m = GetActionProofResponse{} }
+func (m *GetActionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActionProofResponse) ProtoMessage()    {}
+func (*GetActionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{42}
+}
+
+func (m *GetActionProofResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActionProofResponse.Unmarshal(m, b)
+}
+func (m *GetActionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActionProofResponse.Marshal(b, m, deterministic)
+}
+func (m *GetActionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActionProofResponse.Merge(m, src)
+}
+func (m *GetActionProofResponse) XXX_Size() int {
+	return xxx_messageInfo_GetActionProofResponse.Size(m)
+}
+func (m *GetActionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActionProofResponse proto.InternalMessageInfo
+
+func (m *GetActionProofResponse) GetBlockHeader() *iotextypes.BlockHeader {
+	if m != nil {
+		return m.BlockHeader
+	}
+	return nil
+}
+
+func (m *GetActionProofResponse) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *GetActionProofResponse) GetActionProof() [][]byte {
+	if m != nil {
+		return m.ActionProof
+	}
+	return nil
+}
+
+func (m *GetActionProofResponse) GetReceipt() *iotextypes.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *GetActionProofResponse) GetReceiptProof() [][]byte {
+	if m != nil {
+		return m.ReceiptProof
+	}
+	return nil
+}
+
+type TraceActionRequest struct {
+	// Types that are valid to be assigned to Lookup:
+	//	*TraceActionRequest_ActionHash
+	//	*TraceActionRequest_Execution
+	Lookup isTraceActionRequest_Lookup `protobuf_oneof:"lookup"`
+	// the stack and the memory of the opcode steps are left out unless they are enabled
+	EnableStack  bool `protobuf:"varint,3,opt,name=enableStack,proto3" json:"enableStack,omitempty"`
+	EnableMemory bool `protobuf:"varint,4,opt,name=enableMemory,proto3" json:"enableMemory,omitempty"`
+	// the maximum number of the opcode steps to return, which is capped by the server, and 0 means the cap
+	Limit                uint32   `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceActionRequest) Reset()         { *m = TraceActionRequest{} }
+func (m *TraceActionRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceActionRequest) ProtoMessage()    {}
+func (*TraceActionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{43}
+}
+
+func (m *TraceActionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceActionRequest.Unmarshal(m, b)
+}
+func (m *TraceActionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceActionRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceActionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceActionRequest.Merge(m, src)
+}
+func (m *TraceActionRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceActionRequest.Size(m)
+}
+func (m *TraceActionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceActionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceActionRequest proto.InternalMessageInfo
+
+type isTraceActionRequest_Lookup interface {
+	isTraceActionRequest_Lookup()
+}
+
+type TraceActionRequest_ActionHash struct {
+	ActionHash string `protobuf:"bytes,1,opt,name=actionHash,proto3,oneof"`
+}
+
+type TraceActionRequest_Execution struct {
+	Execution *TraceExecutionRequest `protobuf:"bytes,2,opt,name=execution,proto3,oneof"`
+}
+
+func (*TraceActionRequest_ActionHash) isTraceActionRequest_Lookup() {}
+
+func (*TraceActionRequest_Execution) isTraceActionRequest_Lookup() {}
+
+func (m *TraceActionRequest) GetLookup() isTraceActionRequest_Lookup {
+	if m != nil {
+		return m.Lookup
+	}
+	return nil
+}
+
+func (m *TraceActionRequest) GetActionHash() string {
+	if x, ok := m.GetLookup().(*TraceActionRequest_ActionHash); ok {
+		return x.ActionHash
+	}
+	return ""
+}
+
+func (m *TraceActionRequest) GetExecution() *TraceExecutionRequest {
+	if x, ok := m.GetLookup().(*TraceActionRequest_Execution); ok {
+		return x.Execution
+	}
+	return nil
+}
+
+func (m *TraceActionRequest) GetEnableStack() bool {
+	if m != nil {
+		return m.EnableStack
+	}
+	return false
+}
+
+func (m *TraceActionRequest) GetEnableMemory() bool {
+	if m != nil {
+		return m.EnableMemory
+	}
+	return false
+}
+
+func (m *TraceActionRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TraceActionRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TraceActionRequest_ActionHash)(nil),
+		(*TraceActionRequest_Execution)(nil),
+	}
+}
+
+// an unsigned execution, which is run on top of the tip state
+type TraceExecutionRequest struct {
+	Execution            *iotextypes.Execution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	CallerAddress        string                `protobuf:"bytes,2,opt,name=callerAddress,proto3" json:"callerAddress,omitempty"`
+	GasLimit             uint64                `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TraceExecutionRequest) Reset()         { *m = TraceExecutionRequest{} }
+func (m *TraceExecutionRequest) String() string { return proto.CompactTextString(m) }
+func (*TraceExecutionRequest) ProtoMessage()    {}
+func (*TraceExecutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{44}
+}
+
+func (m *TraceExecutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceExecutionRequest.Unmarshal(m, b)
+}
+func (m *TraceExecutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceExecutionRequest.Marshal(b, m, deterministic)
+}
+func (m *TraceExecutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceExecutionRequest.Merge(m, src)
+}
+func (m *TraceExecutionRequest) XXX_Size() int {
+	return xxx_messageInfo_TraceExecutionRequest.Size(m)
+}
+func (m *TraceExecutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceExecutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceExecutionRequest proto.InternalMessageInfo
+
+func (m *TraceExecutionRequest) GetExecution() *iotextypes.Execution {
+	if m != nil {
+		return m.Execution
+	}
+	return nil
+}
+
+func (m *TraceExecutionRequest) GetCallerAddress() string {
+	if m != nil {
+		return m.CallerAddress
+	}
+	return ""
+}
+
+func (m *TraceExecutionRequest) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+type OpcodeStep struct {
+	Pc                   uint64   `protobuf:"varint,1,opt,name=pc,proto3" json:"pc,omitempty"`
+	Op                   string   `protobuf:"bytes,2,opt,name=op,proto3" json:"op,omitempty"`
+	Gas                  uint64   `protobuf:"varint,3,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasCost              uint64   `protobuf:"varint,4,opt,name=gasCost,proto3" json:"gasCost,omitempty"`
+	Depth                uint32   `protobuf:"varint,5,opt,name=depth,proto3" json:"depth,omitempty"`
+	Stack                [][]byte `protobuf:"bytes,6,rep,name=stack,proto3" json:"stack,omitempty"`
+	Memory               []byte   `protobuf:"bytes,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpcodeStep) Reset()         { *m = OpcodeStep{} }
+func (m *OpcodeStep) String() string { return proto.CompactTextString(m) }
+func (*OpcodeStep) ProtoMessage()    {}
+func (*OpcodeStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{45}
+}
+
+func (m *OpcodeStep) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpcodeStep.Unmarshal(m, b)
+}
+func (m *OpcodeStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpcodeStep.Marshal(b, m, deterministic)
+}
+func (m *OpcodeStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpcodeStep.Merge(m, src)
+}
+func (m *OpcodeStep) XXX_Size() int {
+	return xxx_messageInfo_OpcodeStep.Size(m)
+}
+func (m *OpcodeStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpcodeStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpcodeStep proto.InternalMessageInfo
+
+func (m *OpcodeStep) GetPc() uint64 {
+	if m != nil {
+		return m.Pc
+	}
+	return 0
+}
+
+func (m *OpcodeStep) GetOp() string {
+	if m != nil {
+		return m.Op
+	}
+	return ""
+}
+
+func (m *OpcodeStep) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *OpcodeStep) GetGasCost() uint64 {
+	if m != nil {
+		return m.GasCost
+	}
+	return 0
+}
+
+func (m *OpcodeStep) GetDepth() uint32 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *OpcodeStep) GetStack() [][]byte {
+	if m != nil {
+		return m.Stack
+	}
+	return nil
+}
+
+func (m *OpcodeStep) GetMemory() []byte {
+	if m != nil {
+		return m.Memory
+	}
+	return nil
+}
+
+func (m *OpcodeStep) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type CallFrame struct {
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	From    string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To      string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Value   string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	Gas     uint64 `protobuf:"varint,5,opt,name=gas,proto3" json:"gas,omitempty"`
+	GasUsed uint64 `protobuf:"varint,6,opt,name=gasUsed,proto3" json:"gasUsed,omitempty"`
+	Input   []byte `protobuf:"bytes,7,opt,name=input,proto3" json:"input,omitempty"`
+	Output  []byte `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
+	// empty if the call succeeds
+	Error                string       `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Calls                []*CallFrame `protobuf:"bytes,10,rep,name=calls,proto3" json:"calls,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CallFrame) Reset()         { *m = CallFrame{} }
+func (m *CallFrame) String() string { return proto.CompactTextString(m) }
+func (*CallFrame) ProtoMessage()    {}
+func (*CallFrame) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{46}
+}
+
+func (m *CallFrame) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CallFrame.Unmarshal(m, b)
+}
+func (m *CallFrame) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CallFrame.Marshal(b, m, deterministic)
+}
+func (m *CallFrame) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CallFrame.Merge(m, src)
+}
+func (m *CallFrame) XXX_Size() int {
+	return xxx_messageInfo_CallFrame.Size(m)
+}
+func (m *CallFrame) XXX_DiscardUnknown() {
+	xxx_messageInfo_CallFrame.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CallFrame proto.InternalMessageInfo
+
+func (m *CallFrame) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *CallFrame) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CallFrame) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CallFrame) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *CallFrame) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+func (m *CallFrame) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *CallFrame) GetInput() []byte {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (m *CallFrame) GetOutput() []byte {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (m *CallFrame) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CallFrame) GetCalls() []*CallFrame {
+	if m != nil {
+		return m.Calls
+	}
+	return nil
+}
+
+type TraceActionResponse struct {
+	Receipt *iotextypes.Receipt `protobuf:"bytes,1,opt,name=receipt,proto3" json:"receipt,omitempty"`
+	Opcodes []*OpcodeStep       `protobuf:"bytes,2,rep,name=opcodes,proto3" json:"opcodes,omitempty"`
+	Call    *CallFrame          `protobuf:"bytes,3,opt,name=call,proto3" json:"call,omitempty"`
+	// the data returned by the REVERT opcode if the execution is reverted
+	RevertData []byte `protobuf:"bytes,4,opt,name=revertData,proto3" json:"revertData,omitempty"`
+	// the opcode steps are truncated by the limit of the number of them, or of the size of the response
+	Truncated            bool     `protobuf:"varint,5,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TraceActionResponse) Reset()         { *m = TraceActionResponse{} }
+func (m *TraceActionResponse) String() string { return proto.CompactTextString(m) }
+func (*TraceActionResponse) ProtoMessage()    {}
+func (*TraceActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{47}
+}
+
+func (m *TraceActionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TraceActionResponse.Unmarshal(m, b)
+}
+func (m *TraceActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TraceActionResponse.Marshal(b, m, deterministic)
+}
+func (m *TraceActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TraceActionResponse.Merge(m, src)
+}
+func (m *TraceActionResponse) XXX_Size() int {
+	return xxx_messageInfo_TraceActionResponse.Size(m)
+}
+func (m *TraceActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TraceActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TraceActionResponse proto.InternalMessageInfo
+
+func (m *TraceActionResponse) GetReceipt() *iotextypes.Receipt {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
+func (m *TraceActionResponse) GetOpcodes() []*OpcodeStep {
+	if m != nil {
+		return m.Opcodes
+	}
+	return nil
+}
+
+func (m *TraceActionResponse) GetCall() *CallFrame {
+	if m != nil {
+		return m.Call
+	}
+	return nil
+}
+
+func (m *TraceActionResponse) GetRevertData() []byte {
+	if m != nil {
+		return m.RevertData
+	}
+	return nil
+}
+
+func (m *TraceActionResponse) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type GetActPoolStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActPoolStatusRequest) Reset()         { *m = GetActPoolStatusRequest{} }
+func (m *GetActPoolStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActPoolStatusRequest) ProtoMessage()    {}
+func (*GetActPoolStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{48}
+}
+
+func (m *GetActPoolStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActPoolStatusRequest.Unmarshal(m, b)
+}
+func (m *GetActPoolStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActPoolStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActPoolStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActPoolStatusRequest.Merge(m, src)
+}
+func (m *GetActPoolStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActPoolStatusRequest.Size(m)
+}
+func (m *GetActPoolStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActPoolStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActPoolStatusRequest proto.InternalMessageInfo
+
+type GetActPoolStatusResponse struct {
+	// number of the actions which are executable
+	Pending uint64 `protobuf:"varint,1,opt,name=pending,proto3" json:"pending,omitempty"`
+	// number of the actions which are not executable yet, because of a gap of nonce or an insufficient balance
+	Queued               uint64   `protobuf:"varint,2,opt,name=queued,proto3" json:"queued,omitempty"`
+	Capacity             uint64   `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Accounts             uint64   `protobuf:"varint,4,opt,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActPoolStatusResponse) Reset()         { *m = GetActPoolStatusResponse{} }
+func (m *GetActPoolStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActPoolStatusResponse) ProtoMessage()    {}
+func (*GetActPoolStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{49}
+}
+
+func (m *GetActPoolStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActPoolStatusResponse.Unmarshal(m, b)
+}
+func (m *GetActPoolStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActPoolStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GetActPoolStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActPoolStatusResponse.Merge(m, src)
+}
+func (m *GetActPoolStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GetActPoolStatusResponse.Size(m)
+}
+func (m *GetActPoolStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActPoolStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActPoolStatusResponse proto.InternalMessageInfo
+
+func (m *GetActPoolStatusResponse) GetPending() uint64 {
+	if m != nil {
+		return m.Pending
+	}
+	return 0
+}
+
+func (m *GetActPoolStatusResponse) GetQueued() uint64 {
+	if m != nil {
+		return m.Queued
+	}
+	return 0
+}
+
+func (m *GetActPoolStatusResponse) GetCapacity() uint64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *GetActPoolStatusResponse) GetAccounts() uint64 {
+	if m != nil {
+		return m.Accounts
+	}
+	return 0
+}
+
+type GetActPoolContentRequest struct {
+	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActPoolContentRequest) Reset()         { *m = GetActPoolContentRequest{} }
+func (m *GetActPoolContentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActPoolContentRequest) ProtoMessage()    {}
+func (*GetActPoolContentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{50}
+}
+
+func (m *GetActPoolContentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActPoolContentRequest.Unmarshal(m, b)
+}
+func (m *GetActPoolContentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActPoolContentRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActPoolContentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActPoolContentRequest.Merge(m, src)
+}
+func (m *GetActPoolContentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActPoolContentRequest.Size(m)
+}
+func (m *GetActPoolContentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActPoolContentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActPoolContentRequest proto.InternalMessageInfo
+
+func (m *GetActPoolContentRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// a range of missing nonces, from start to end inclusively
+type NonceGap struct {
+	Start                uint64   `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	End                  uint64   `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NonceGap) Reset()         { *m = NonceGap{} }
+func (m *NonceGap) String() string { return proto.CompactTextString(m) }
+func (*NonceGap) ProtoMessage()    {}
+func (*NonceGap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{51}
+}
+
+func (m *NonceGap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NonceGap.Unmarshal(m, b)
+}
+func (m *NonceGap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NonceGap.Marshal(b, m, deterministic)
+}
+func (m *NonceGap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonceGap.Merge(m, src)
+}
+func (m *NonceGap) XXX_Size() int {
+	return xxx_messageInfo_NonceGap.Size(m)
+}
+func (m *NonceGap) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonceGap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NonceGap proto.InternalMessageInfo
+
+func (m *NonceGap) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *NonceGap) GetEnd() uint64 {
+	if m != nil {
+		return m.End
+	}
+	return 0
+}
+
+type ActPoolAccount struct {
+	Address              string               `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PendingNonce         uint64               `protobuf:"varint,2,opt,name=pendingNonce,proto3" json:"pendingNonce,omitempty"`
+	Pending              []*iotextypes.Action `protobuf:"bytes,3,rep,name=pending,proto3" json:"pending,omitempty"`
+	Queued               []*iotextypes.Action `protobuf:"bytes,4,rep,name=queued,proto3" json:"queued,omitempty"`
+	Gaps                 []*NonceGap          `protobuf:"bytes,5,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ActPoolAccount) Reset()         { *m = ActPoolAccount{} }
+func (m *ActPoolAccount) String() string { return proto.CompactTextString(m) }
+func (*ActPoolAccount) ProtoMessage()    {}
+func (*ActPoolAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{52}
+}
+
+func (m *ActPoolAccount) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActPoolAccount.Unmarshal(m, b)
+}
+func (m *ActPoolAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActPoolAccount.Marshal(b, m, deterministic)
+}
+func (m *ActPoolAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActPoolAccount.Merge(m, src)
+}
+func (m *ActPoolAccount) XXX_Size() int {
+	return xxx_messageInfo_ActPoolAccount.Size(m)
+}
+func (m *ActPoolAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActPoolAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActPoolAccount proto.InternalMessageInfo
+
+func (m *ActPoolAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ActPoolAccount) GetPendingNonce() uint64 {
+	if m != nil {
+		return m.PendingNonce
+	}
+	return 0
+}
+
+func (m *ActPoolAccount) GetPending() []*iotextypes.Action {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *ActPoolAccount) GetQueued() []*iotextypes.Action {
+	if m != nil {
+		return m.Queued
+	}
+	return nil
+}
+
+func (m *ActPoolAccount) GetGaps() []*NonceGap {
+	if m != nil {
+		return m.Gaps
+	}
+	return nil
+}
+
+type GetActPoolContentResponse struct {
+	Accounts             []*ActPoolAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetActPoolContentResponse) Reset()         { *m = GetActPoolContentResponse{} }
+func (m *GetActPoolContentResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActPoolContentResponse) ProtoMessage()    {}
+func (*GetActPoolContentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{53}
+}
+
+func (m *GetActPoolContentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActPoolContentResponse.Unmarshal(m, b)
+}
+func (m *GetActPoolContentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActPoolContentResponse.Marshal(b, m, deterministic)
+}
+func (m *GetActPoolContentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActPoolContentResponse.Merge(m, src)
+}
+func (m *GetActPoolContentResponse) XXX_Size() int {
+	return xxx_messageInfo_GetActPoolContentResponse.Size(m)
+}
+func (m *GetActPoolContentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActPoolContentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActPoolContentResponse proto.InternalMessageInfo
+
+func (m *GetActPoolContentResponse) GetAccounts() []*ActPoolAccount {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+type GetActPoolActionsRequest struct {
+	SenderAddress        string   `protobuf:"bytes,1,opt,name=senderAddress,proto3" json:"senderAddress,omitempty"`
+	MinGasPrice          string   `protobuf:"bytes,2,opt,name=minGasPrice,proto3" json:"minGasPrice,omitempty"`
+	ActionType           string   `protobuf:"bytes,3,opt,name=actionType,proto3" json:"actionType,omitempty"`
+	PendingOnly          bool     `protobuf:"varint,4,opt,name=pendingOnly,proto3" json:"pendingOnly,omitempty"`
+	Start                uint64   `protobuf:"varint,5,opt,name=start,proto3" json:"start,omitempty"`
+	Count                uint64   `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActPoolActionsRequest) Reset()         { *m = GetActPoolActionsRequest{} }
+func (m *GetActPoolActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActPoolActionsRequest) ProtoMessage()    {}
+func (*GetActPoolActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{54}
+}
+
+func (m *GetActPoolActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActPoolActionsRequest.Unmarshal(m, b)
+}
+func (m *GetActPoolActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActPoolActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActPoolActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActPoolActionsRequest.Merge(m, src)
+}
+func (m *GetActPoolActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActPoolActionsRequest.Size(m)
+}
+func (m *GetActPoolActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActPoolActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActPoolActionsRequest proto.InternalMessageInfo
+
+func (m *GetActPoolActionsRequest) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *GetActPoolActionsRequest) GetMinGasPrice() string {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return ""
+}
+
+func (m *GetActPoolActionsRequest) GetActionType() string {
+	if m != nil {
+		return m.ActionType
+	}
+	return ""
+}
+
+func (m *GetActPoolActionsRequest) GetPendingOnly() bool {
+	if m != nil {
+		return m.PendingOnly
+	}
+	return false
+}
+
+func (m *GetActPoolActionsRequest) GetStart() uint64 {
+	if m != nil {
+		return m.Start
+	}
+	return 0
+}
+
+func (m *GetActPoolActionsRequest) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GetActPoolActionsResponse struct {
+	Actions []*iotextypes.Action `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	// number of the matched actions
+	Total                uint64   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActPoolActionsResponse) Reset()         { *m = GetActPoolActionsResponse{} }
+func (m *GetActPoolActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActPoolActionsResponse) ProtoMessage()    {}
+func (*GetActPoolActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{55}
+}
+
+func (m *GetActPoolActionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActPoolActionsResponse.Unmarshal(m, b)
+}
+func (m *GetActPoolActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActPoolActionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetActPoolActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActPoolActionsResponse.Merge(m, src)
+}
+func (m *GetActPoolActionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetActPoolActionsResponse.Size(m)
+}
+func (m *GetActPoolActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActPoolActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActPoolActionsResponse proto.InternalMessageInfo
+
+func (m *GetActPoolActionsResponse) GetActions() []*iotextypes.Action {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
+func (m *GetActPoolActionsResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// an action matches the filter if it matches each of the non-empty address lists
+type StreamPendingActionsRequest struct {
+	SenderAddresses []string `protobuf:"bytes,1,rep,name=senderAddresses,proto3" json:"senderAddresses,omitempty"`
+	// recipients of transfers
+	RecipientAddresses []string `protobuf:"bytes,2,rep,name=recipientAddresses,proto3" json:"recipientAddresses,omitempty"`
+	// contracts called by executions
+	ContractAddresses    []string `protobuf:"bytes,3,rep,name=contractAddresses,proto3" json:"contractAddresses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamPendingActionsRequest) Reset()         { *m = StreamPendingActionsRequest{} }
+func (m *StreamPendingActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamPendingActionsRequest) ProtoMessage()    {}
+func (*StreamPendingActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{56}
+}
+
+func (m *StreamPendingActionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPendingActionsRequest.Unmarshal(m, b)
+}
+func (m *StreamPendingActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPendingActionsRequest.Marshal(b, m, deterministic)
+}
+func (m *StreamPendingActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPendingActionsRequest.Merge(m, src)
+}
+func (m *StreamPendingActionsRequest) XXX_Size() int {
+	return xxx_messageInfo_StreamPendingActionsRequest.Size(m)
+}
+func (m *StreamPendingActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPendingActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPendingActionsRequest proto.InternalMessageInfo
+
+func (m *StreamPendingActionsRequest) GetSenderAddresses() []string {
+	if m != nil {
+		return m.SenderAddresses
+	}
+	return nil
+}
+
+func (m *StreamPendingActionsRequest) GetRecipientAddresses() []string {
+	if m != nil {
+		return m.RecipientAddresses
+	}
+	return nil
+}
+
+func (m *StreamPendingActionsRequest) GetContractAddresses() []string {
+	if m != nil {
+		return m.ContractAddresses
+	}
+	return nil
+}
+
+type StreamPendingActionsResponse struct {
+	Event      PendingActionEvent `protobuf:"varint,1,opt,name=event,proto3,enum=iotexapi.PendingActionEvent" json:"event,omitempty"`
+	Action     *iotextypes.Action `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ActionHash string             `protobuf:"bytes,3,opt,name=actionHash,proto3" json:"actionHash,omitempty"`
+	// the reason why the action is removed
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StreamPendingActionsResponse) Reset()         { *m = StreamPendingActionsResponse{} }
+func (m *StreamPendingActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamPendingActionsResponse) ProtoMessage()    {}
+func (*StreamPendingActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca6d5bbc959d58c0, []int{57}
+}
+
+func (m *StreamPendingActionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StreamPendingActionsResponse.Unmarshal(m, b)
+}
+func (m *StreamPendingActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StreamPendingActionsResponse.Marshal(b, m, deterministic)
+}
+func (m *StreamPendingActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamPendingActionsResponse.Merge(m, src)
+}
+func (m *StreamPendingActionsResponse) XXX_Size() int {
+	return xxx_messageInfo_StreamPendingActionsResponse.Size(m)
+}
+func (m *StreamPendingActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamPendingActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamPendingActionsResponse proto.InternalMessageInfo
+
+func (m *StreamPendingActionsResponse) GetEvent() PendingActionEvent {
+	if m != nil {
+		return m.Event
+	}
+	return PendingActionEvent_PendingActionAccepted
+}
+
+func (m *StreamPendingActionsResponse) GetAction() *iotextypes.Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *StreamPendingActionsResponse) GetActionHash() string {
+	if m != nil {
+		return m.ActionHash
+	}
+	return ""
+}
+
+func (m *StreamPendingActionsResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("iotexapi.PendingActionEvent", PendingActionEvent_name, PendingActionEvent_value)
+	proto.RegisterType((*GetAccountRequest)(nil), "iotexapi.GetAccountRequest")
+	proto.RegisterType((*GetAccountResponse)(nil), "iotexapi.GetAccountResponse")
+	proto.RegisterType((*GetActionsRequest)(nil), "iotexapi.GetActionsRequest")
+	proto.RegisterType((*GetActionsByIndexRequest)(nil), "iotexapi.GetActionsByIndexRequest")
+	proto.RegisterType((*GetActionByHashRequest)(nil), "iotexapi.GetActionByHashRequest")
+	proto.RegisterType((*GetActionsByAddressRequest)(nil), "iotexapi.GetActionsByAddressRequest")
+	proto.RegisterType((*GetUnconfirmedActionsByAddressRequest)(nil), "iotexapi.GetUnconfirmedActionsByAddressRequest")
+	proto.RegisterType((*GetActionsByBlockRequest)(nil), "iotexapi.GetActionsByBlockRequest")
+	proto.RegisterType((*GetActionsResponse)(nil), "iotexapi.GetActionsResponse")
+	proto.RegisterType((*GetBlockMetasRequest)(nil), "iotexapi.GetBlockMetasRequest")
+	proto.RegisterType((*GetBlockMetasByIndexRequest)(nil), "iotexapi.GetBlockMetasByIndexRequest")
+	proto.RegisterType((*GetBlockMetaByHashRequest)(nil), "iotexapi.GetBlockMetaByHashRequest")
+	proto.RegisterType((*GetBlockMetasResponse)(nil), "iotexapi.GetBlockMetasResponse")
+	proto.RegisterType((*GetChainMetaRequest)(nil), "iotexapi.GetChainMetaRequest")
+	proto.RegisterType((*GetChainMetaResponse)(nil), "iotexapi.GetChainMetaResponse")
+	proto.RegisterType((*GetServerMetaRequest)(nil), "iotexapi.GetServerMetaRequest")
+	proto.RegisterType((*GetServerMetaResponse)(nil), "iotexapi.GetServerMetaResponse")
+	proto.RegisterType((*SendActionRequest)(nil), "iotexapi.SendActionRequest")
+	proto.RegisterType((*SendActionResponse)(nil), "iotexapi.SendActionResponse")
+	proto.RegisterType((*GetReceiptByActionRequest)(nil), "iotexapi.GetReceiptByActionRequest")
+	proto.RegisterType((*GetReceiptByActionResponse)(nil), "iotexapi.GetReceiptByActionResponse")
+	proto.RegisterType((*ReadContractRequest)(nil), "iotexapi.ReadContractRequest")
+	proto.RegisterType((*ReadContractResponse)(nil), "iotexapi.ReadContractResponse")
+	proto.RegisterType((*SuggestGasPriceRequest)(nil), "iotexapi.SuggestGasPriceRequest")
+	proto.RegisterType((*SuggestGasPriceResponse)(nil), "iotexapi.SuggestGasPriceResponse")
+	proto.RegisterType((*EstimateGasForActionRequest)(nil), "iotexapi.EstimateGasForActionRequest")
+	proto.RegisterType((*EstimateGasForActionResponse)(nil), "iotexapi.EstimateGasForActionResponse")
+	proto.RegisterType((*ReadStateRequest)(nil), "iotexapi.ReadStateRequest")
+	proto.RegisterType((*ReadStateResponse)(nil), "iotexapi.ReadStateResponse")
+	proto.RegisterType((*GetProductivityRequest)(nil), "iotexapi.GetProductivityRequest")
+	proto.RegisterType((*GetProductivityResponse)(nil), "iotexapi.GetProductivityResponse")
+	proto.RegisterMapType((map[string]uint64)(nil), "iotexapi.GetProductivityResponse.BlksPerDelegateEntry")
+	proto.RegisterType((*StreamBlocksRequest)(nil), "iotexapi.StreamBlocksRequest")
+	proto.RegisterType((*StreamBlocksResponse)(nil), "iotexapi.StreamBlocksResponse")
+	proto.RegisterType((*BlockInfo)(nil), "iotexapi.BlockInfo")
+	proto.RegisterType((*LogsFilter)(nil), "iotexapi.LogsFilter")
+	proto.RegisterType((*Topics)(nil), "iotexapi.Topics")
+	proto.RegisterType((*GetLogsRequest)(nil), "iotexapi.GetLogsRequest")
+	proto.RegisterType((*GetLogsResponse)(nil), "iotexapi.GetLogsResponse")
+	proto.RegisterType((*GetAccountProofRequest)(nil), "iotexapi.GetAccountProofRequest")
+	proto.RegisterType((*StorageProof)(nil), "iotexapi.StorageProof")
+	proto.RegisterType((*GetAccountProofResponse)(nil), "iotexapi.GetAccountProofResponse")
+	proto.RegisterType((*GetActionProofRequest)(nil), "iotexapi.GetActionProofRequest")
+	proto.RegisterType((*GetActionProofResponse)(nil), "iotexapi.GetActionProofResponse")
+	proto.RegisterType((*TraceActionRequest)(nil), "iotexapi.TraceActionRequest")
+	proto.RegisterType((*TraceExecutionRequest)(nil), "iotexapi.TraceExecutionRequest")
+	proto.RegisterType((*OpcodeStep)(nil), "iotexapi.OpcodeStep")
+	proto.RegisterType((*CallFrame)(nil), "iotexapi.CallFrame")
+	proto.RegisterType((*TraceActionResponse)(nil), "iotexapi.TraceActionResponse")
+	proto.RegisterType((*GetActPoolStatusRequest)(nil), "iotexapi.GetActPoolStatusRequest")
+	proto.RegisterType((*GetActPoolStatusResponse)(nil), "iotexapi.GetActPoolStatusResponse")
+	proto.RegisterType((*GetActPoolContentRequest)(nil), "iotexapi.GetActPoolContentRequest")
+	proto.RegisterType((*NonceGap)(nil), "iotexapi.NonceGap")
+	proto.RegisterType((*ActPoolAccount)(nil), "iotexapi.ActPoolAccount")
+	proto.RegisterType((*GetActPoolContentResponse)(nil), "iotexapi.GetActPoolContentResponse")
+	proto.RegisterType((*GetActPoolActionsRequest)(nil), "iotexapi.GetActPoolActionsRequest")
+	proto.RegisterType((*GetActPoolActionsResponse)(nil), "iotexapi.GetActPoolActionsResponse")
+	proto.RegisterType((*StreamPendingActionsRequest)(nil), "iotexapi.StreamPendingActionsRequest")
+	proto.RegisterType((*StreamPendingActionsResponse)(nil), "iotexapi.StreamPendingActionsResponse")
+}
+
+func init() { proto.RegisterFile("proto/api/api.proto", fileDescriptor_ca6d5bbc959d58c0) }
+
+var fileDescriptor_ca6d5bbc959d58c0 = []byte{
+	// 2520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4b, 0x73, 0xdc, 0xc6,
+	0x11, 0xe6, 0x3e, 0xb8, 0xdc, 0x6d, 0x2e, 0x25, 0x72, 0xf8, 0xd0, 0x0a, 0xa2, 0x29, 0x7a, 0x24,
+	0xcb, 0x8c, 0x4a, 0x26, 0x1d, 0x5a, 0x96, 0x13, 0x25, 0xb1, 0xc3, 0xd5, 0x83, 0x62, 0x24, 0x4b,
+	0x34, 0x28, 0x55, 0xe5, 0x55, 0xb1, 0xb1, 0xc0, 0x68, 0x89, 0x10, 0x8b, 0x81, 0x81, 0x59, 0x96,
+	0xf6, 0x9e, 0x73, 0xfe, 0x80, 0x6f, 0xa9, 0xca, 0x1f, 0xc8, 0x21, 0x95, 0x9f, 0xe1, 0x4b, 0x4e,
+	0xa9, 0xe4, 0x57, 0xa4, 0x72, 0x4e, 0xcd, 0x0b, 0x18, 0x60, 0x81, 0xa5, 0xad, 0xf2, 0x81, 0x55,
+	0xdb, 0x8f, 0xe9, 0xe9, 0xfe, 0xa6, 0xa7, 0xa7, 0xd1, 0x84, 0xd5, 0x28, 0xa6, 0x8c, 0xee, 0x39,
+	0x91, 0xcf, 0xff, 0x76, 0x05, 0x85, 0xda, 0x3e, 0x65, 0xe4, 0x8d, 0x13, 0xf9, 0x56, 0xd7, 0x71,
+	0x99, 0x4f, 0x43, 0xc9, 0xb7, 0x96, 0x07, 0x01, 0x75, 0xcf, 0xdc, 0x53, 0xc7, 0xd7, 0x1c, 0x08,
+	0xa9, 0x47, 0xe4, 0x6f, 0xfc, 0x08, 0x56, 0x0e, 0x09, 0x3b, 0x70, 0x5d, 0x3a, 0x0e, 0x99, 0x4d,
+	0xbe, 0x1e, 0x93, 0x84, 0xa1, 0x1e, 0x2c, 0x38, 0x9e, 0x17, 0x93, 0x24, 0xe9, 0xd5, 0xb6, 0x6b,
+	0x3b, 0x1d, 0x5b, 0x93, 0x68, 0x03, 0x5a, 0xa7, 0xc4, 0x1f, 0x9e, 0xb2, 0x5e, 0x7d, 0xbb, 0xb6,
+	0xd3, 0xb4, 0x15, 0x85, 0x5f, 0x00, 0x32, 0xcd, 0x24, 0x11, 0x0d, 0x13, 0x82, 0x7e, 0x0a, 0x8b,
+	0x8e, 0x64, 0x7d, 0x4e, 0x98, 0x23, 0x6c, 0x2d, 0xee, 0x5f, 0xd9, 0x15, 0x8e, 0xb2, 0x49, 0x44,
+	0x92, 0xdd, 0x83, 0x4c, 0x6c, 0x9b, 0xba, 0xf8, 0x7f, 0x75, 0xe5, 0x18, 0x8f, 0x24, 0xd1, 0x8e,
+	0x7d, 0x0a, 0x0b, 0x83, 0xc9, 0x51, 0xe8, 0x91, 0x37, 0xca, 0x18, 0xde, 0xd5, 0x51, 0xef, 0x66,
+	0xda, 0x7d, 0xa9, 0xa2, 0x16, 0x3d, 0x99, 0xb3, 0xf5, 0x22, 0x74, 0x1f, 0x5a, 0x83, 0xc9, 0x13,
+	0x27, 0x39, 0x15, 0xee, 0x2f, 0xee, 0x6f, 0x97, 0x2c, 0xef, 0x0b, 0x85, 0x6c, 0xb1, 0x5a, 0x81,
+	0x3e, 0xe5, 0x6b, 0x0f, 0x3c, 0x2f, 0xee, 0x35, 0xc4, 0xda, 0x9b, 0xe5, 0x5b, 0x1f, 0x48, 0xa4,
+	0x72, 0xeb, 0x39, 0x0f, 0x7d, 0x09, 0x2b, 0xe3, 0xd0, 0xa5, 0xe1, 0x6b, 0x3f, 0x1e, 0x11, 0x4f,
+	0x2a, 0xf6, 0x9a, 0xc2, 0xd4, 0x5e, 0xce, 0xd4, 0xab, 0x4c, 0xab, 0xda, 0xea, 0xb4, 0x2d, 0x74,
+	0x1f, 0xe6, 0x07, 0x93, 0x7e, 0x70, 0xd6, 0x9b, 0x9f, 0x05, 0x4d, 0x9f, 0x67, 0x43, 0x66, 0x47,
+	0x2e, 0xe9, 0xb7, 0xa1, 0x15, 0x50, 0x7a, 0x36, 0x8e, 0xf0, 0x63, 0xe8, 0x55, 0x21, 0x89, 0xd6,
+	0x60, 0x3e, 0x61, 0x4e, 0xcc, 0x04, 0xf8, 0x4d, 0x5b, 0x12, 0x9c, 0x2b, 0xce, 0x4d, 0xa5, 0x84,
+	0x24, 0xf0, 0xef, 0x61, 0xa3, 0x1c, 0x52, 0xb4, 0x05, 0x20, 0x13, 0x54, 0x1c, 0x84, 0x4c, 0x30,
+	0x83, 0x83, 0x30, 0x74, 0xdd, 0x53, 0xe2, 0x9e, 0x1d, 0x93, 0xd0, 0xf3, 0xc3, 0xa1, 0x30, 0xdb,
+	0xb6, 0x73, 0x3c, 0x3c, 0x00, 0xab, 0x1a, 0xf4, 0x19, 0xf9, 0x9b, 0x46, 0x50, 0x2f, 0x8d, 0xa0,
+	0x61, 0x46, 0x30, 0x82, 0xf7, 0xbe, 0xd3, 0x69, 0xfc, 0x40, 0xdb, 0x7d, 0x05, 0xbd, 0xaa, 0x73,
+	0xe2, 0x3b, 0x0c, 0x82, 0x33, 0x03, 0x2f, 0x4d, 0x7e, 0xaf, 0x1d, 0xfa, 0x80, 0xb2, 0x1d, 0xd2,
+	0x4b, 0x7a, 0x07, 0x16, 0x24, 0xf8, 0xdc, 0xfb, 0xc6, 0xce, 0xe2, 0x3e, 0xca, 0x5f, 0x50, 0x2e,
+	0xb2, 0xb5, 0x0a, 0xfe, 0x4b, 0x0d, 0xd6, 0x0e, 0x09, 0x13, 0xde, 0xf1, 0x8b, 0x9a, 0x82, 0x70,
+	0x50, 0xbc, 0x9a, 0xef, 0xe5, 0xf2, 0x2f, 0x5b, 0x50, 0x7d, 0x3b, 0x7f, 0x51, 0xb8, 0x9d, 0x37,
+	0xca, 0x2d, 0x54, 0x5c, 0x50, 0x23, 0x87, 0x8f, 0xe0, 0xda, 0x8c, 0x2d, 0xbf, 0x57, 0x1a, 0x7f,
+	0x0c, 0x57, 0x2b, 0xf7, 0xae, 0x3e, 0x16, 0xfc, 0x2b, 0x58, 0x2f, 0xa0, 0xa4, 0xd0, 0xfe, 0x31,
+	0xb4, 0x07, 0x81, 0xe4, 0x29, 0xb8, 0xd7, 0x4d, 0xb8, 0xd3, 0x15, 0x76, 0xaa, 0x86, 0xd7, 0x61,
+	0xf5, 0x90, 0xb0, 0x07, 0xbc, 0x80, 0x0b, 0x89, 0xdc, 0x1c, 0x3f, 0x85, 0xb5, 0x3c, 0x5b, 0xed,
+	0xf0, 0x11, 0x74, 0x5c, 0xcd, 0x54, 0x47, 0x91, 0xdb, 0x22, 0x5b, 0x91, 0xe9, 0xe1, 0x0d, 0x61,
+	0xec, 0x84, 0xc4, 0xe7, 0x24, 0x36, 0x37, 0x79, 0x01, 0xeb, 0x05, 0xbe, 0xda, 0xe5, 0x1e, 0x40,
+	0x92, 0x72, 0xd5, 0x36, 0x1b, 0xe6, 0x36, 0xc6, 0x1a, 0x43, 0x13, 0x7f, 0x06, 0x2b, 0x27, 0x24,
+	0x54, 0x57, 0x49, 0xe3, 0x78, 0x1b, 0x5a, 0x32, 0xbf, 0x94, 0xa1, 0xb2, 0x0c, 0x54, 0x1a, 0x78,
+	0x0d, 0x90, 0x69, 0x40, 0xba, 0x83, 0x7f, 0x26, 0x8e, 0xc9, 0x26, 0x2e, 0xf1, 0x23, 0xd6, 0x9f,
+	0xe4, 0xcd, 0x5f, 0x50, 0x70, 0xf0, 0x53, 0xb0, 0xca, 0x16, 0xab, 0x48, 0x3f, 0x80, 0x85, 0x58,
+	0x8a, 0x94, 0x77, 0xab, 0xa6, 0x77, 0x6a, 0x95, 0xad, 0x75, 0xf0, 0x6f, 0x60, 0xd5, 0x26, 0x8e,
+	0xf7, 0x80, 0x86, 0x2c, 0x76, 0x5c, 0xf6, 0x16, 0x21, 0x56, 0x3e, 0xb2, 0xb7, 0x61, 0x2d, 0x6f,
+	0x5a, 0x79, 0x88, 0xa0, 0xe9, 0x39, 0xea, 0x14, 0x3a, 0xb6, 0xf8, 0x8d, 0x7b, 0xb0, 0x71, 0x32,
+	0x1e, 0x0e, 0x49, 0xc2, 0x0e, 0x9d, 0xe4, 0x38, 0xf6, 0x5d, 0xa2, 0x8f, 0xf4, 0x63, 0xb8, 0x32,
+	0x25, 0x51, 0x86, 0x2c, 0x68, 0x0f, 0x15, 0x4f, 0xdd, 0x8d, 0x94, 0xe6, 0x77, 0xea, 0x51, 0xc2,
+	0xfc, 0x91, 0xc3, 0xc8, 0xa1, 0x93, 0x3c, 0xa6, 0xf1, 0xdb, 0x1f, 0xe1, 0x87, 0xb0, 0x59, 0x6e,
+	0x4a, 0xb9, 0xb1, 0x0c, 0x8d, 0xa1, 0x93, 0x28, 0x0f, 0xf8, 0x4f, 0x1c, 0xc1, 0x32, 0x8f, 0xfc,
+	0x84, 0x39, 0x8c, 0x18, 0xa7, 0x2a, 0x5a, 0x18, 0x97, 0x06, 0x47, 0x0f, 0x85, 0x72, 0xd7, 0x36,
+	0x38, 0x5c, 0x3e, 0x22, 0xec, 0x94, 0x7a, 0xcf, 0x9d, 0x11, 0x11, 0x48, 0x76, 0x6d, 0x83, 0x83,
+	0x36, 0xa1, 0xe3, 0xc4, 0xc3, 0xf1, 0x88, 0x84, 0x2c, 0xe9, 0x35, 0xb6, 0x1b, 0x3b, 0x5d, 0x3b,
+	0x63, 0xe0, 0xf7, 0x61, 0xc5, 0xd8, 0xb1, 0x04, 0xe8, 0xae, 0x02, 0xfa, 0xbe, 0x78, 0xe7, 0x8e,
+	0x63, 0xea, 0x8d, 0x5d, 0xe6, 0x9f, 0xfb, 0x6c, 0xa2, 0x1d, 0xdc, 0x86, 0x45, 0x12, 0x51, 0xf7,
+	0xf4, 0xf9, 0x78, 0x34, 0x20, 0xb1, 0x0a, 0xc7, 0x64, 0xe1, 0x7f, 0xd7, 0xe0, 0xca, 0xd4, 0x62,
+	0xb5, 0xd7, 0x26, 0x74, 0x18, 0x65, 0x4e, 0xd0, 0x0f, 0xce, 0x34, 0x14, 0x19, 0x03, 0x7d, 0x05,
+	0x97, 0x07, 0xc1, 0x59, 0x72, 0x4c, 0xe2, 0x87, 0x24, 0x20, 0x43, 0x87, 0xf1, 0x08, 0x79, 0x35,
+	0xb9, 0x97, 0xab, 0x99, 0x65, 0x96, 0x77, 0xfb, 0xf9, 0x85, 0x8f, 0x42, 0x16, 0x4f, 0xec, 0xa2,
+	0x39, 0xab, 0x0f, 0x6b, 0x65, 0x8a, 0xfc, 0x70, 0xce, 0xc8, 0x44, 0xe5, 0x1a, 0xff, 0xc9, 0x0b,
+	0xe7, 0xb9, 0x13, 0x8c, 0x89, 0x2e, 0x9c, 0x82, 0xb8, 0x5f, 0xff, 0x49, 0x0d, 0x7f, 0x02, 0xab,
+	0x27, 0x2c, 0x26, 0xce, 0x48, 0x94, 0xb5, 0xc4, 0x00, 0x46, 0x94, 0xdc, 0x27, 0x32, 0xc9, 0x15,
+	0x30, 0x06, 0x0b, 0x1f, 0xc0, 0x5a, 0x7e, 0xa1, 0x02, 0xe5, 0x47, 0x30, 0x2f, 0xba, 0xd9, 0xfc,
+	0x4d, 0xe4, 0xc1, 0x0a, 0xc5, 0xa3, 0xf0, 0x35, 0xb5, 0xa5, 0x06, 0xfe, 0xa6, 0x06, 0x9d, 0x94,
+	0x89, 0xf6, 0x44, 0xa5, 0xae, 0x2a, 0x89, 0x59, 0xd5, 0xd5, 0x5a, 0xe6, 0xab, 0x58, 0xbf, 0xf0,
+	0x55, 0x44, 0x7b, 0xd0, 0x56, 0xf7, 0x5f, 0xa6, 0x52, 0x45, 0x91, 0x48, 0x95, 0xf0, 0x31, 0xc0,
+	0x33, 0x3a, 0x4c, 0x1e, 0xfb, 0x01, 0x23, 0x71, 0xbe, 0x81, 0x68, 0x98, 0x0d, 0xc4, 0x0e, 0xb4,
+	0x18, 0x8d, 0x7c, 0x57, 0x7b, 0xb1, 0x9c, 0x45, 0xfc, 0x52, 0xf0, 0x6d, 0x25, 0xc7, 0x5b, 0xd0,
+	0x92, 0x1c, 0x7e, 0x1e, 0x82, 0x27, 0x6c, 0x75, 0x6d, 0x49, 0xe0, 0x73, 0xb8, 0x74, 0x48, 0x18,
+	0xdf, 0x54, 0x1f, 0xc3, 0x1d, 0x68, 0xbd, 0x16, 0xfb, 0x2b, 0x48, 0xd6, 0x32, 0xdb, 0x99, 0x6f,
+	0xb6, 0xd2, 0xe1, 0xf9, 0xf8, 0x3a, 0xa6, 0xf2, 0x40, 0xd4, 0x49, 0x67, 0x0c, 0x1e, 0x01, 0xa3,
+	0x52, 0x26, 0x5b, 0x0e, 0x4d, 0xe2, 0x7b, 0x70, 0x39, 0xdd, 0x57, 0x9d, 0xe2, 0x0d, 0x68, 0x06,
+	0x74, 0xa8, 0xdf, 0xbf, 0xcb, 0x26, 0x52, 0xcf, 0xe8, 0xd0, 0x16, 0x42, 0xfc, 0x52, 0xf5, 0x8f,
+	0xe2, 0x19, 0x3e, 0x8e, 0x29, 0x7d, 0x7d, 0x71, 0xbb, 0x25, 0x12, 0x8b, 0xc6, 0xce, 0x90, 0x3c,
+	0x25, 0x13, 0x09, 0x59, 0xd7, 0x36, 0x59, 0xf8, 0x19, 0x74, 0x4f, 0x24, 0x29, 0x4c, 0x9a, 0xd9,
+	0xdc, 0x2d, 0xc9, 0xe6, 0xae, 0xca, 0x66, 0xce, 0x8d, 0xf8, 0x02, 0x55, 0x28, 0x24, 0x81, 0xff,
+	0x29, 0xef, 0x6f, 0xde, 0xc9, 0xec, 0xfe, 0x26, 0xa2, 0x78, 0x50, 0xca, 0x94, 0xfd, 0x8c, 0x21,
+	0x62, 0x70, 0xb3, 0x76, 0xa3, 0x6b, 0x6b, 0x92, 0x77, 0xbf, 0x8e, 0x61, 0x4f, 0x6d, 0x98, 0xe3,
+	0x19, 0x71, 0x0a, 0xeb, 0x4d, 0x61, 0xc1, 0x64, 0xa1, 0x9f, 0xc3, 0x52, 0x62, 0xc4, 0x99, 0xf4,
+	0xe6, 0xb7, 0x1b, 0xd9, 0x0b, 0xcd, 0x8f, 0xd8, 0x84, 0xc1, 0xce, 0x2b, 0xe3, 0x4f, 0xc4, 0xab,
+	0x2f, 0x93, 0x3c, 0x07, 0xfd, 0x45, 0x2f, 0xe9, 0x7f, 0x6a, 0xb0, 0x51, 0x5c, 0x99, 0x7d, 0x0b,
+	0x8a, 0x8b, 0xf9, 0x84, 0x38, 0x1e, 0x89, 0xcb, 0xbe, 0x05, 0xfb, 0x99, 0xd8, 0x36, 0x75, 0x39,
+	0xf8, 0xbe, 0x68, 0x2c, 0x39, 0x54, 0x4b, 0xb6, 0x24, 0x38, 0x08, 0x4e, 0xb6, 0x8f, 0xc2, 0xc9,
+	0x64, 0x99, 0x2f, 0x77, 0xf3, 0xe2, 0x97, 0x9b, 0x23, 0xaf, 0x7e, 0x4a, 0x8b, 0xf3, 0x12, 0x79,
+	0x93, 0xc7, 0x2b, 0x36, 0x7a, 0x19, 0x3b, 0x2e, 0xc9, 0xbf, 0x7e, 0xdb, 0xd3, 0xb8, 0x3c, 0x99,
+	0xcb, 0x7d, 0xd4, 0x7c, 0x06, 0x1d, 0xf2, 0x86, 0xb8, 0x63, 0xce, 0x50, 0xed, 0xed, 0x75, 0xe3,
+	0x2e, 0x73, 0x93, 0x8f, 0xb4, 0x3c, 0x6b, 0x6d, 0xb3, 0x35, 0x3c, 0x5c, 0x12, 0x3a, 0x83, 0x80,
+	0x9c, 0x30, 0x47, 0xdd, 0xb2, 0xb6, 0x6d, 0xb2, 0xb8, 0xff, 0x92, 0xfc, 0x9c, 0x8c, 0x68, 0x3c,
+	0x11, 0x31, 0xb7, 0xed, 0x1c, 0x8f, 0x43, 0x19, 0xf8, 0x23, 0x9f, 0x89, 0x6f, 0xc4, 0x25, 0x5b,
+	0x12, 0x46, 0xe7, 0xfc, 0xe7, 0x1a, 0xac, 0x97, 0x3a, 0xc3, 0xdb, 0xca, 0x2c, 0x80, 0x92, 0x1a,
+	0x9a, 0x2d, 0x30, 0x9c, 0xbe, 0x09, 0x4b, 0xae, 0x13, 0x04, 0x24, 0x56, 0x5f, 0x4c, 0x22, 0xf2,
+	0x8e, 0x9d, 0x67, 0xaa, 0xb6, 0xe3, 0x99, 0xf0, 0xab, 0x91, 0xb6, 0x1d, 0x82, 0xc6, 0x7f, 0xab,
+	0x01, 0xbc, 0x88, 0x5c, 0xea, 0x91, 0x13, 0x46, 0x22, 0x74, 0x09, 0xea, 0x91, 0xab, 0x5e, 0x8c,
+	0x7a, 0xe4, 0x72, 0x9a, 0x46, 0xca, 0x6a, 0x9d, 0x46, 0xba, 0x75, 0x68, 0xa4, 0xad, 0x03, 0xbf,
+	0x69, 0x43, 0x27, 0x79, 0x40, 0x13, 0x99, 0x04, 0x4d, 0x5b, 0x93, 0x1c, 0x0b, 0x8f, 0x44, 0xec,
+	0x54, 0x63, 0x21, 0x08, 0xf5, 0x71, 0xe0, 0x9e, 0xf5, 0x5a, 0xf2, 0xa6, 0x0b, 0x82, 0xb7, 0x64,
+	0x23, 0x89, 0xea, 0x82, 0xb8, 0x6c, 0x8a, 0xe2, 0xda, 0x24, 0x8e, 0x69, 0xdc, 0x6b, 0x0b, 0x17,
+	0x24, 0x81, 0xff, 0x5b, 0x83, 0xce, 0x03, 0x27, 0x08, 0x1e, 0xc7, 0xbc, 0xd1, 0x40, 0xd0, 0xe4,
+	0x10, 0xe9, 0xf6, 0x8c, 0xff, 0xe6, 0x3c, 0x5e, 0x3c, 0x95, 0xe7, 0xe2, 0x37, 0x8f, 0x85, 0x51,
+	0xe1, 0x7a, 0xc7, 0xae, 0x33, 0x9a, 0x55, 0xa2, 0xa6, 0xb4, 0x2d, 0x08, 0x1d, 0xe1, 0x7c, 0x31,
+	0xc2, 0x57, 0x09, 0xf1, 0x7a, 0xad, 0x34, 0x42, 0x4e, 0xca, 0x8b, 0x13, 0x8d, 0x99, 0x72, 0x5a,
+	0x12, 0x3c, 0x16, 0x3a, 0x66, 0x9c, 0xdd, 0x96, 0xb1, 0x48, 0x2a, 0x8b, 0xa5, 0x63, 0xc4, 0xc2,
+	0x9f, 0x5c, 0x7e, 0x5a, 0x49, 0x0f, 0xcc, 0x77, 0x8d, 0x27, 0x6d, 0x1a, 0xa1, 0x2d, 0x35, 0xf0,
+	0xbf, 0x6a, 0xb0, 0x9a, 0xbb, 0x1c, 0x6f, 0xd5, 0x41, 0xa3, 0x5d, 0x58, 0xa0, 0xe2, 0xc4, 0xf5,
+	0xa3, 0x67, 0x3c, 0x4c, 0x59, 0x2a, 0xd8, 0x5a, 0x09, 0xbd, 0x0f, 0x4d, 0xbe, 0x7f, 0xaf, 0x61,
+	0xda, 0xce, 0x3b, 0x28, 0x14, 0x78, 0xf5, 0x8a, 0xc9, 0x39, 0x89, 0xd9, 0x43, 0xde, 0xc4, 0xc9,
+	0xaa, 0x69, 0x70, 0x44, 0xcb, 0x15, 0x8f, 0x43, 0xd7, 0x61, 0xc4, 0x13, 0x00, 0xb7, 0xed, 0x8c,
+	0x81, 0xaf, 0xaa, 0x5a, 0xcf, 0x8e, 0x29, 0x0d, 0x78, 0x5f, 0x38, 0xd6, 0x2f, 0x29, 0xfe, 0x53,
+	0x0d, 0x7a, 0xd3, 0x32, 0x15, 0x7d, 0x0f, 0x16, 0x22, 0x35, 0xc9, 0x90, 0x79, 0xab, 0x49, 0x7e,
+	0x10, 0x5f, 0x8f, 0xc9, 0x98, 0x78, 0xba, 0xcf, 0x97, 0x14, 0xbf, 0x0f, 0xae, 0x13, 0x39, 0xae,
+	0xcf, 0x26, 0xfa, 0x3e, 0x68, 0x9a, 0xcb, 0xd4, 0x53, 0x90, 0xa8, 0x7c, 0x4e, 0x69, 0x7c, 0xd7,
+	0xf4, 0x82, 0x7f, 0x25, 0x90, 0xef, 0x30, 0xd2, 0xc3, 0xfb, 0xd0, 0x7e, 0x4e, 0x43, 0x97, 0x1c,
+	0x3a, 0x51, 0xc5, 0x97, 0xf1, 0x32, 0x34, 0x48, 0xa8, 0x9d, 0xe4, 0x3f, 0xf1, 0xb7, 0x35, 0xb8,
+	0xa4, 0xf6, 0x51, 0x8f, 0xdf, 0x8c, 0x57, 0x19, 0x43, 0x57, 0x45, 0x2c, 0xf6, 0x51, 0x76, 0x72,
+	0x3c, 0xde, 0x6e, 0x69, 0x90, 0x1a, 0xd5, 0xed, 0x96, 0x06, 0xee, 0x76, 0x0a, 0x5c, 0xb3, 0x52,
+	0x59, 0x83, 0x79, 0x0b, 0x9a, 0x43, 0x27, 0xd2, 0x0f, 0x20, 0xca, 0xb2, 0x43, 0x07, 0x6d, 0x0b,
+	0x39, 0xfe, 0x42, 0x7c, 0x41, 0x16, 0xc1, 0x53, 0x67, 0x78, 0xd7, 0x40, 0x5d, 0x76, 0x2d, 0xbd,
+	0xcc, 0x50, 0x1e, 0x08, 0xe3, 0x3c, 0xbe, 0xcd, 0xa5, 0x45, 0x61, 0x94, 0x79, 0x13, 0x96, 0x12,
+	0x12, 0x7a, 0x59, 0x69, 0x94, 0xa8, 0xe5, 0x99, 0xbc, 0xea, 0x8f, 0xfc, 0x50, 0x7f, 0xa8, 0xa9,
+	0x72, 0x61, 0xb2, 0xb2, 0x27, 0xf9, 0x25, 0xaf, 0x31, 0x0d, 0xf3, 0x49, 0xe6, 0x1c, 0x6e, 0x41,
+	0xc1, 0xf6, 0x22, 0x0c, 0xf4, 0xa3, 0x60, 0xb2, 0xb2, 0x43, 0x9f, 0x2f, 0x1d, 0x87, 0xb4, 0xcc,
+	0x71, 0xc8, 0x97, 0x70, 0xb5, 0x24, 0xa2, 0xb7, 0x99, 0x24, 0xc9, 0x36, 0x95, 0x39, 0x81, 0xfe,
+	0x6c, 0x10, 0x04, 0xfe, 0x6b, 0x0d, 0xae, 0xc9, 0xd6, 0x5f, 0x8d, 0xfa, 0x0a, 0xb0, 0xed, 0xc0,
+	0xe5, 0x1c, 0x42, 0x44, 0xb7, 0xcc, 0x45, 0x36, 0xda, 0x05, 0x14, 0x13, 0xd7, 0x8f, 0x7c, 0x12,
+	0xb2, 0x4c, 0xb9, 0x2e, 0x94, 0x4b, 0x24, 0xe8, 0x0e, 0xac, 0xb8, 0xea, 0xcb, 0x3a, 0x53, 0x6f,
+	0x08, 0xf5, 0x69, 0x01, 0xfe, 0x7b, 0x0d, 0x36, 0xcb, 0xfd, 0x54, 0x60, 0xec, 0xc3, 0x3c, 0x39,
+	0x27, 0xa1, 0xbc, 0x4a, 0x97, 0xf6, 0x37, 0xb3, 0x7c, 0xc9, 0x2d, 0x78, 0xc4, 0x75, 0x6c, 0xa9,
+	0x6a, 0x7c, 0x44, 0xd7, 0x2f, 0x1c, 0x12, 0xe4, 0x5b, 0xb1, 0xc6, 0xd4, 0x14, 0x75, 0x03, 0x5a,
+	0x31, 0x71, 0x12, 0x1a, 0xaa, 0xe7, 0x43, 0x51, 0xb7, 0xff, 0x51, 0x03, 0x34, 0xed, 0x01, 0xba,
+	0x0a, 0xeb, 0x39, 0xee, 0x81, 0xeb, 0x92, 0x88, 0x11, 0x6f, 0x79, 0x0e, 0x59, 0xb0, 0x91, 0x13,
+	0x3d, 0xd0, 0xf3, 0xd0, 0xe5, 0xda, 0xd4, 0x32, 0x9b, 0x44, 0x81, 0xe3, 0x12, 0x6f, 0xb9, 0x8e,
+	0x7a, 0xb0, 0x56, 0xd8, 0xc7, 0x77, 0xb9, 0xc1, 0xc6, 0xb4, 0xe4, 0x4d, 0xe4, 0xc7, 0xc4, 0x5b,
+	0x6e, 0xa2, 0x4d, 0xe8, 0xe5, 0x24, 0x47, 0xe1, 0xb9, 0x13, 0xf8, 0x1e, 0xaf, 0xbf, 0xcb, 0xf3,
+	0xfb, 0xdf, 0x5c, 0x02, 0x38, 0x38, 0x3e, 0xe2, 0x93, 0x25, 0x9e, 0xf9, 0x47, 0x00, 0x59, 0xf3,
+	0x8d, 0xae, 0x15, 0xc6, 0xdd, 0xe6, 0x3f, 0x34, 0xac, 0xcd, 0x72, 0xa1, 0x1a, 0x1e, 0xcd, 0xa5,
+	0xa6, 0x64, 0x66, 0x5e, 0x2b, 0x9b, 0x9c, 0x57, 0x99, 0xca, 0x9d, 0x3a, 0x9e, 0x43, 0x36, 0x2c,
+	0xe5, 0x26, 0x7f, 0x68, 0xab, 0x62, 0x0e, 0xaa, 0x0d, 0x5e, 0xaf, 0x94, 0xa7, 0x36, 0x5f, 0x40,
+	0xd7, 0x1c, 0xf5, 0xa1, 0x77, 0x72, 0x4b, 0x8a, 0x93, 0x41, 0x6b, 0xab, 0x4a, 0x5c, 0x70, 0x32,
+	0x1b, 0xd1, 0x15, 0x9c, 0x9c, 0x9a, 0x03, 0x5a, 0xd7, 0x2b, 0xe5, 0x26, 0x86, 0xd9, 0x60, 0xce,
+	0xc4, 0x70, 0x6a, 0xde, 0x67, 0x6d, 0x96, 0x0b, 0x53, 0x53, 0x8e, 0x18, 0x54, 0x17, 0x06, 0x72,
+	0x28, 0x3f, 0x0e, 0x2e, 0x9f, 0xf5, 0x59, 0x37, 0x67, 0x2b, 0x99, 0x90, 0x9a, 0xb3, 0x34, 0x13,
+	0xd2, 0x92, 0xf1, 0x9d, 0xb5, 0x55, 0x25, 0x4e, 0x0d, 0xfe, 0x1a, 0x2e, 0x17, 0xc6, 0x6a, 0xc8,
+	0xf8, 0xef, 0x52, 0xf9, 0x2c, 0xce, 0x7a, 0x77, 0x86, 0x46, 0x6a, 0x79, 0x08, 0x6b, 0x65, 0xe3,
+	0x32, 0x64, 0x0c, 0xd8, 0x67, 0x4c, 0xe6, 0xac, 0x5b, 0x17, 0xa9, 0xa5, 0x1b, 0x3d, 0x86, 0x4e,
+	0x3a, 0xf3, 0x42, 0x56, 0x3e, 0x62, 0x73, 0xf4, 0x66, 0x5d, 0x2b, 0x95, 0x99, 0x50, 0x14, 0x66,
+	0x4f, 0x68, 0x7b, 0xc6, 0x58, 0x6a, 0x0a, 0x8a, 0x8a, 0xc1, 0x15, 0x9e, 0x43, 0x5f, 0x40, 0x57,
+	0x16, 0x5d, 0x39, 0x17, 0x32, 0x4f, 0xad, 0x64, 0xd0, 0x64, 0x6d, 0x55, 0x89, 0xb5, 0xc1, 0x0f,
+	0x6b, 0xe8, 0x97, 0xb0, 0xa0, 0xe6, 0x13, 0xa8, 0x97, 0x73, 0xc1, 0x18, 0x95, 0x58, 0x57, 0x4b,
+	0x24, 0x85, 0x70, 0x0f, 0x72, 0x1f, 0xe8, 0x65, 0xf5, 0xc6, 0xfc, 0x92, 0xb6, 0xde, 0x9d, 0xa1,
+	0x91, 0x5a, 0x7e, 0x25, 0x66, 0x36, 0xc6, 0xd7, 0x34, 0xba, 0x5e, 0x52, 0x7d, 0x72, 0x76, 0xb7,
+	0xab, 0x15, 0x52, 0xb3, 0xcf, 0x60, 0xd1, 0x68, 0xd3, 0xd1, 0x66, 0xe1, 0x3b, 0x34, 0x9f, 0x3e,
+	0xef, 0x54, 0x48, 0x53, 0x6b, 0xbf, 0x83, 0xe5, 0x62, 0xef, 0x8b, 0x8a, 0xd1, 0x4d, 0xf7, 0xcc,
+	0x16, 0x9e, 0xa5, 0x92, 0x1a, 0xff, 0x03, 0xac, 0x64, 0x52, 0xd5, 0x95, 0xa1, 0xd2, 0xa5, 0xf9,
+	0x7e, 0xd7, 0xba, 0x31, 0x53, 0xa7, 0xdc, 0xbe, 0xae, 0xff, 0xa5, 0xf6, 0x0b, 0xcf, 0xc0, 0x8d,
+	0x99, 0x3a, 0xa9, 0x7d, 0x5f, 0x0f, 0x32, 0xf3, 0x5d, 0x82, 0x79, 0x77, 0x67, 0x74, 0x3b, 0xd6,
+	0xad, 0x8b, 0xd4, 0xb2, 0x44, 0xee, 0xdf, 0xfb, 0xed, 0xdd, 0xa1, 0xcf, 0x4e, 0xc7, 0x83, 0x5d,
+	0x97, 0x8e, 0xf6, 0xc4, 0xba, 0x28, 0xa6, 0x7f, 0x24, 0x2e, 0x93, 0xc4, 0x07, 0x2e, 0x8d, 0xc9,
+	0x9e, 0x18, 0x90, 0x0f, 0x49, 0xb8, 0xa7, 0x0d, 0x0f, 0x5a, 0x82, 0xf5, 0xd1, 0xff, 0x07, 0x00,
+	0x86, 0xae, 0xd5, 0xd7, 0x55, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// APIServiceClient is the client API for APIService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type APIServiceClient interface {
+	// get the address detail of an address
+	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	// get action(s) by:
+	// 1. start index and action count
+	// 2. action hash
+	// 3. address with start index and action count
+	// 4. get unconfirmed actions by address with start index and action count
+	// 5. block hash with start index and action count
+	GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error)
+	// get block metadata(s) by:
+	// 1. start index and block count
+	// 2. block hash
+	GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error)
+	// get chain metadata
+	GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error)
+	// get server version
+	GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error)
+	// sendAction
+	SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error)
+	// get receipt by action Hash
+	GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error)
+	// TODO: read contract
+	ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error)
+	// suggest gas price
+	SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error)
+	// estimate gas for action
+	EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error)
+	// read state from blockchain
+	ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error)
+	// get block producers' productivity metrics
+	GetProductivity(ctx context.Context, in *GetProductivityRequest, opts ...grpc.CallOption) (*GetProductivityResponse, error)
+	// stream blocks, starting from the given height and following the chain tip
+	StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (APIService_StreamBlocksClient, error)
+	// get the receipt logs which match the filter within a block range
+	GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error)
+	// get the merkle proof of an account, and of the storage slots if it is a contract
+	GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*GetAccountProofResponse, error)
+	// get the merkle proofs of an action and its receipt against the tx root and the receipt root of the block
+	GetActionProof(ctx context.Context, in *GetActionProofRequest, opts ...grpc.CallOption) (*GetActionProofResponse, error)
+	// trace an execution with the evm tracer, either a committed one or an unsigned one on top of the tip state
+	TraceAction(ctx context.Context, in *TraceActionRequest, opts ...grpc.CallOption) (*TraceActionResponse, error)
+	// get the numbers of pending and queued actions and of accounts in actpool
+	GetActPoolStatus(ctx context.Context, in *GetActPoolStatusRequest, opts ...grpc.CallOption) (*GetActPoolStatusResponse, error)
+	// get the actions in actpool grouped by sender, with the gaps of nonce blocking the queued actions
+	GetActPoolContent(ctx context.Context, in *GetActPoolContentRequest, opts ...grpc.CallOption) (*GetActPoolContentResponse, error)
+	// get the actions in actpool which match the filter
+	GetActPoolActions(ctx context.Context, in *GetActPoolActionsRequest, opts ...grpc.CallOption) (*GetActPoolActionsResponse, error)
+	// stream the actions accepted by and removed from actpool, which match the filter
+	StreamPendingActions(ctx context.Context, in *StreamPendingActionsRequest, opts ...grpc.CallOption) (APIService_StreamPendingActionsClient, error)
+}
+
+type aPIServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAPIServiceClient(cc *grpc.ClientConn) APIServiceClient {
+	return &aPIServiceClient{cc}
+}
+
+func (c *aPIServiceClient) GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActions(ctx context.Context, in *GetActionsRequest, opts ...grpc.CallOption) (*GetActionsResponse, error) {
+	out := new(GetActionsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetBlockMetas(ctx context.Context, in *GetBlockMetasRequest, opts ...grpc.CallOption) (*GetBlockMetasResponse, error) {
+	out := new(GetBlockMetasResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetBlockMetas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetChainMeta(ctx context.Context, in *GetChainMetaRequest, opts ...grpc.CallOption) (*GetChainMetaResponse, error) {
+	out := new(GetChainMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetChainMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetServerMeta(ctx context.Context, in *GetServerMetaRequest, opts ...grpc.CallOption) (*GetServerMetaResponse, error) {
+	out := new(GetServerMetaResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetServerMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SendAction(ctx context.Context, in *SendActionRequest, opts ...grpc.CallOption) (*SendActionResponse, error) {
+	out := new(SendActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SendAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetReceiptByAction(ctx context.Context, in *GetReceiptByActionRequest, opts ...grpc.CallOption) (*GetReceiptByActionResponse, error) {
+	out := new(GetReceiptByActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetReceiptByAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReadContract(ctx context.Context, in *ReadContractRequest, opts ...grpc.CallOption) (*ReadContractResponse, error) {
+	out := new(ReadContractResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) SuggestGasPrice(ctx context.Context, in *SuggestGasPriceRequest, opts ...grpc.CallOption) (*SuggestGasPriceResponse, error) {
+	out := new(SuggestGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/SuggestGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) EstimateGasForAction(ctx context.Context, in *EstimateGasForActionRequest, opts ...grpc.CallOption) (*EstimateGasForActionResponse, error) {
+	out := new(EstimateGasForActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/EstimateGasForAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) ReadState(ctx context.Context, in *ReadStateRequest, opts ...grpc.CallOption) (*ReadStateResponse, error) {
+	out := new(ReadStateResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/ReadState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetProductivity(ctx context.Context, in *GetProductivityRequest, opts ...grpc.CallOption) (*GetProductivityResponse, error) {
+	out := new(GetProductivityResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetProductivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) StreamBlocks(ctx context.Context, in *StreamBlocksRequest, opts ...grpc.CallOption) (APIService_StreamBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[0], "/iotexapi.APIService/StreamBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamBlocksClient interface {
+	Recv() (*StreamBlocksResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamBlocksClient) Recv() (*StreamBlocksResponse, error) {
+	m := new(StreamBlocksResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aPIServiceClient) GetLogs(ctx context.Context, in *GetLogsRequest, opts ...grpc.CallOption) (*GetLogsResponse, error) {
+	out := new(GetLogsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetLogs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetAccountProof(ctx context.Context, in *GetAccountProofRequest, opts ...grpc.CallOption) (*GetAccountProofResponse, error) {
+	out := new(GetAccountProofResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetAccountProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActionProof(ctx context.Context, in *GetActionProofRequest, opts ...grpc.CallOption) (*GetActionProofResponse, error) {
+	out := new(GetActionProofResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) TraceAction(ctx context.Context, in *TraceActionRequest, opts ...grpc.CallOption) (*TraceActionResponse, error) {
+	out := new(TraceActionResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/TraceAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActPoolStatus(ctx context.Context, in *GetActPoolStatusRequest, opts ...grpc.CallOption) (*GetActPoolStatusResponse, error) {
+	out := new(GetActPoolStatusResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActPoolStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActPoolContent(ctx context.Context, in *GetActPoolContentRequest, opts ...grpc.CallOption) (*GetActPoolContentResponse, error) {
+	out := new(GetActPoolContentResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActPoolContent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) GetActPoolActions(ctx context.Context, in *GetActPoolActionsRequest, opts ...grpc.CallOption) (*GetActPoolActionsResponse, error) {
+	out := new(GetActPoolActionsResponse)
+	err := c.cc.Invoke(ctx, "/iotexapi.APIService/GetActPoolActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aPIServiceClient) StreamPendingActions(ctx context.Context, in *StreamPendingActionsRequest, opts ...grpc.CallOption) (APIService_StreamPendingActionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_APIService_serviceDesc.Streams[1], "/iotexapi.APIService/StreamPendingActions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aPIServiceStreamPendingActionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type APIService_StreamPendingActionsClient interface {
+	Recv() (*StreamPendingActionsResponse, error)
+	grpc.ClientStream
+}
+
+type aPIServiceStreamPendingActionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *aPIServiceStreamPendingActionsClient) Recv() (*StreamPendingActionsResponse, error) {
+	m := new(StreamPendingActionsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// APIServiceServer is the server API for APIService service.
+type APIServiceServer interface {
+	// get the address detail of an address
+	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	// get action(s) by:
+	// 1. start index and action count
+	// 2. action hash
+	// 3. address with start index and action count
+	// 4. get unconfirmed actions by address with start index and action count
+	// 5. block hash with start index and action count
+	GetActions(context.Context, *GetActionsRequest) (*GetActionsResponse, error)
+	// get block metadata(s) by:
+	// 1. start index and block count
+	// 2. block hash
+	GetBlockMetas(context.Context, *GetBlockMetasRequest) (*GetBlockMetasResponse, error)
+	// get chain metadata
 	GetChainMeta(context.Context, *GetChainMetaRequest) (*GetChainMetaResponse, error)
 	// get server version
 	GetServerMeta(context.Context, *GetServerMetaRequest) (*GetServerMetaResponse, error)
@@ -1731,6 +3634,24 @@ type APIServiceServer interface {
 	ReadState(context.Context, *ReadStateRequest) (*ReadStateResponse, error)
 	// get block producers' productivity metrics
 	GetProductivity(context.Context, *GetProductivityRequest) (*GetProductivityResponse, error)
+	// stream blocks, starting from the given height and following the chain tip
+	StreamBlocks(*StreamBlocksRequest, APIService_StreamBlocksServer) error
+	// get the receipt logs which match the filter within a block range
+	GetLogs(context.Context, *GetLogsRequest) (*GetLogsResponse, error)
+	// get the merkle proof of an account, and of the storage slots if it is a contract
+	GetAccountProof(context.Context, *GetAccountProofRequest) (*GetAccountProofResponse, error)
+	// get the merkle proofs of an action and its receipt against the tx root and the receipt root of the block
+	GetActionProof(context.Context, *GetActionProofRequest) (*GetActionProofResponse, error)
+	// trace an execution with the evm tracer, either a committed one or an unsigned one on top of the tip state
+	TraceAction(context.Context, *TraceActionRequest) (*TraceActionResponse, error)
+	// get the numbers of pending and queued actions and of accounts in actpool
+	GetActPoolStatus(context.Context, *GetActPoolStatusRequest) (*GetActPoolStatusResponse, error)
+	// get the actions in actpool grouped by sender, with the gaps of nonce blocking the queued actions
+	GetActPoolContent(context.Context, *GetActPoolContentRequest) (*GetActPoolContentResponse, error)
+	// get the actions in actpool which match the filter
+	GetActPoolActions(context.Context, *GetActPoolActionsRequest) (*GetActPoolActionsResponse, error)
+	// stream the actions accepted by and removed from actpool, which match the filter
+	StreamPendingActions(*StreamPendingActionsRequest, APIService_StreamPendingActionsServer) error
 }
 
 func RegisterAPIServiceServer(s *grpc.Server, srv APIServiceServer) {
@@ -1953,6 +3874,174 @@ func _APIService_GetProductivity_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _APIService_StreamBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamBlocks(m, &aPIServiceStreamBlocksServer{stream})
+}
+
+type APIService_StreamBlocksServer interface {
+	Send(*StreamBlocksResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamBlocksServer) Send(m *StreamBlocksResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _APIService_GetLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetLogs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetLogs(ctx, req.(*GetLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetAccountProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetAccountProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetAccountProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetAccountProof(ctx, req.(*GetAccountProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActionProof(ctx, req.(*GetActionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_TraceAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TraceActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).TraceAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/TraceAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).TraceAction(ctx, req.(*TraceActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActPoolStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActPoolStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActPoolStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActPoolStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActPoolStatus(ctx, req.(*GetActPoolStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActPoolContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActPoolContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActPoolContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActPoolContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActPoolContent(ctx, req.(*GetActPoolContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_GetActPoolActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetActPoolActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(APIServiceServer).GetActPoolActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/iotexapi.APIService/GetActPoolActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(APIServiceServer).GetActPoolActions(ctx, req.(*GetActPoolActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _APIService_StreamPendingActions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamPendingActionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(APIServiceServer).StreamPendingActions(m, &aPIServiceStreamPendingActionsServer{stream})
+}
+
+type APIService_StreamPendingActionsServer interface {
+	Send(*StreamPendingActionsResponse) error
+	grpc.ServerStream
+}
+
+type aPIServiceStreamPendingActionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *aPIServiceStreamPendingActionsServer) Send(m *StreamPendingActionsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _APIService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "iotexapi.APIService",
 	HandlerType: (*APIServiceServer)(nil),
@@ -2005,7 +4094,46 @@ var _APIService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProductivity",
 			Handler:    _APIService_GetProductivity_Handler,
 		},
+		{
+			MethodName: "GetLogs",
+			Handler:    _APIService_GetLogs_Handler,
+		},
+		{
+			MethodName: "GetAccountProof",
+			Handler:    _APIService_GetAccountProof_Handler,
+		},
+		{
+			MethodName: "GetActionProof",
+			Handler:    _APIService_GetActionProof_Handler,
+		},
+		{
+			MethodName: "TraceAction",
+			Handler:    _APIService_TraceAction_Handler,
+		},
+		{
+			MethodName: "GetActPoolStatus",
+			Handler:    _APIService_GetActPoolStatus_Handler,
+		},
+		{
+			MethodName: "GetActPoolContent",
+			Handler:    _APIService_GetActPoolContent_Handler,
+		},
+		{
+			MethodName: "GetActPoolActions",
+			Handler:    _APIService_GetActPoolActions_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlocks",
+			Handler:       _APIService_StreamBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StreamPendingActions",
+			Handler:       _APIService_StreamPendingActions_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/api/api.proto",
 }
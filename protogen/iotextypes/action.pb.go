@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// source: proto/types/action.proto
+// source: action.proto
 
 package iotextypes
 
@@ -21,6 +21,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type ExecutionErrorClass int32
+
+const (
+	ExecutionErrorClass_ExecutionNoError             ExecutionErrorClass = 0
+	ExecutionErrorClass_ExecutionReverted            ExecutionErrorClass = 1
+	ExecutionErrorClass_ExecutionOutOfGas            ExecutionErrorClass = 2
+	ExecutionErrorClass_ExecutionInvalidOpcode       ExecutionErrorClass = 3
+	ExecutionErrorClass_ExecutionInsufficientBalance ExecutionErrorClass = 4
+	ExecutionErrorClass_ExecutionOtherError          ExecutionErrorClass = 5
+)
+
+var ExecutionErrorClass_name = map[int32]string{
+	0: "ExecutionNoError",
+	1: "ExecutionReverted",
+	2: "ExecutionOutOfGas",
+	3: "ExecutionInvalidOpcode",
+	4: "ExecutionInsufficientBalance",
+	5: "ExecutionOtherError",
+}
+
+var ExecutionErrorClass_value = map[string]int32{
+	"ExecutionNoError":             0,
+	"ExecutionReverted":            1,
+	"ExecutionOutOfGas":            2,
+	"ExecutionInvalidOpcode":       3,
+	"ExecutionInsufficientBalance": 4,
+	"ExecutionOtherError":          5,
+}
+
+func (x ExecutionErrorClass) String() string {
+	return proto.EnumName(ExecutionErrorClass_name, int32(x))
+}
+
+func (ExecutionErrorClass) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_59885c909ad4dfd3, []int{0}
+}
+
 type RewardType int32
 
 const (
@@ -43,7 +80,7 @@ func (x RewardType) String() string {
 }
 
 func (RewardType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{0}
+	return fileDescriptor_59885c909ad4dfd3, []int{1}
 }
 
 type Transfer struct {
@@ -60,7 +97,7 @@ func (m *Transfer) Reset()         { *m = Transfer{} }
 func (m *Transfer) String() string { return proto.CompactTextString(m) }
 func (*Transfer) ProtoMessage()    {}
 func (*Transfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{0}
+	return fileDescriptor_59885c909ad4dfd3, []int{0}
 }
 
 func (m *Transfer) XXX_Unmarshal(b []byte) error {
@@ -102,6 +139,46 @@ func (m *Transfer) GetPayload() []byte {
 	return nil
 }
 
+// MultiTransfer transfers tokens from the sender to many recipients in one action
+type MultiTransfer struct {
+	Transfers            []*Transfer `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *MultiTransfer) Reset()         { *m = MultiTransfer{} }
+func (m *MultiTransfer) String() string { return proto.CompactTextString(m) }
+func (*MultiTransfer) ProtoMessage()    {}
+func (*MultiTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59885c909ad4dfd3, []int{1}
+}
+
+func (m *MultiTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiTransfer.Unmarshal(m, b)
+}
+func (m *MultiTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiTransfer.Marshal(b, m, deterministic)
+}
+func (m *MultiTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiTransfer.Merge(m, src)
+}
+func (m *MultiTransfer) XXX_Size() int {
+	return xxx_messageInfo_MultiTransfer.Size(m)
+}
+func (m *MultiTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiTransfer proto.InternalMessageInfo
+
+func (m *MultiTransfer) GetTransfers() []*Transfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
 type Vote struct {
 	Timestamp            *timestamp.Timestamp `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
 	VoteeAddress         string               `protobuf:"bytes,2,opt,name=voteeAddress,proto3" json:"voteeAddress,omitempty"`
@@ -114,7 +191,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{1}
+	return fileDescriptor_59885c909ad4dfd3, []int{2}
 }
 
 func (m *Vote) XXX_Unmarshal(b []byte) error {
@@ -166,7 +243,7 @@ func (m *Candidate) Reset()         { *m = Candidate{} }
 func (m *Candidate) String() string { return proto.CompactTextString(m) }
 func (*Candidate) ProtoMessage()    {}
 func (*Candidate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{2}
+	return fileDescriptor_59885c909ad4dfd3, []int{3}
 }
 
 func (m *Candidate) XXX_Unmarshal(b []byte) error {
@@ -240,7 +317,7 @@ func (m *CandidateList) Reset()         { *m = CandidateList{} }
 func (m *CandidateList) String() string { return proto.CompactTextString(m) }
 func (*CandidateList) ProtoMessage()    {}
 func (*CandidateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{3}
+	return fileDescriptor_59885c909ad4dfd3, []int{4}
 }
 
 func (m *CandidateList) XXX_Unmarshal(b []byte) error {
@@ -280,7 +357,7 @@ func (m *PutPollResult) Reset()         { *m = PutPollResult{} }
 func (m *PutPollResult) String() string { return proto.CompactTextString(m) }
 func (*PutPollResult) ProtoMessage()    {}
 func (*PutPollResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{4}
+	return fileDescriptor_59885c909ad4dfd3, []int{5}
 }
 
 func (m *PutPollResult) XXX_Unmarshal(b []byte) error {
@@ -328,7 +405,7 @@ func (m *Execution) Reset()         { *m = Execution{} }
 func (m *Execution) String() string { return proto.CompactTextString(m) }
 func (*Execution) ProtoMessage()    {}
 func (*Execution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{5}
+	return fileDescriptor_59885c909ad4dfd3, []int{6}
 }
 
 func (m *Execution) XXX_Unmarshal(b []byte) error {
@@ -386,7 +463,7 @@ func (m *StartSubChain) Reset()         { *m = StartSubChain{} }
 func (m *StartSubChain) String() string { return proto.CompactTextString(m) }
 func (*StartSubChain) ProtoMessage()    {}
 func (*StartSubChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{6}
+	return fileDescriptor_59885c909ad4dfd3, []int{7}
 }
 
 func (m *StartSubChain) XXX_Unmarshal(b []byte) error {
@@ -455,7 +532,7 @@ func (m *StopSubChain) Reset()         { *m = StopSubChain{} }
 func (m *StopSubChain) String() string { return proto.CompactTextString(m) }
 func (*StopSubChain) ProtoMessage()    {}
 func (*StopSubChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{7}
+	return fileDescriptor_59885c909ad4dfd3, []int{8}
 }
 
 func (m *StopSubChain) XXX_Unmarshal(b []byte) error {
@@ -509,7 +586,7 @@ func (m *MerkleRoot) Reset()         { *m = MerkleRoot{} }
 func (m *MerkleRoot) String() string { return proto.CompactTextString(m) }
 func (*MerkleRoot) ProtoMessage()    {}
 func (*MerkleRoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{8}
+	return fileDescriptor_59885c909ad4dfd3, []int{9}
 }
 
 func (m *MerkleRoot) XXX_Unmarshal(b []byte) error {
@@ -557,7 +634,7 @@ func (m *PutBlock) Reset()         { *m = PutBlock{} }
 func (m *PutBlock) String() string { return proto.CompactTextString(m) }
 func (*PutBlock) ProtoMessage()    {}
 func (*PutBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{9}
+	return fileDescriptor_59885c909ad4dfd3, []int{10}
 }
 
 func (m *PutBlock) XXX_Unmarshal(b []byte) error {
@@ -612,7 +689,7 @@ func (m *CreateDeposit) Reset()         { *m = CreateDeposit{} }
 func (m *CreateDeposit) String() string { return proto.CompactTextString(m) }
 func (*CreateDeposit) ProtoMessage()    {}
 func (*CreateDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{10}
+	return fileDescriptor_59885c909ad4dfd3, []int{11}
 }
 
 func (m *CreateDeposit) XXX_Unmarshal(b []byte) error {
@@ -667,7 +744,7 @@ func (m *SettleDeposit) Reset()         { *m = SettleDeposit{} }
 func (m *SettleDeposit) String() string { return proto.CompactTextString(m) }
 func (*SettleDeposit) ProtoMessage()    {}
 func (*SettleDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{11}
+	return fileDescriptor_59885c909ad4dfd3, []int{12}
 }
 
 func (m *SettleDeposit) XXX_Unmarshal(b []byte) error {
@@ -720,7 +797,7 @@ func (m *CreatePlumChain) Reset()         { *m = CreatePlumChain{} }
 func (m *CreatePlumChain) String() string { return proto.CompactTextString(m) }
 func (*CreatePlumChain) ProtoMessage()    {}
 func (*CreatePlumChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{12}
+	return fileDescriptor_59885c909ad4dfd3, []int{13}
 }
 
 func (m *CreatePlumChain) XXX_Unmarshal(b []byte) error {
@@ -752,7 +829,7 @@ func (m *TerminatePlumChain) Reset()         { *m = TerminatePlumChain{} }
 func (m *TerminatePlumChain) String() string { return proto.CompactTextString(m) }
 func (*TerminatePlumChain) ProtoMessage()    {}
 func (*TerminatePlumChain) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{13}
+	return fileDescriptor_59885c909ad4dfd3, []int{14}
 }
 
 func (m *TerminatePlumChain) XXX_Unmarshal(b []byte) error {
@@ -793,7 +870,7 @@ func (m *PlumPutBlock) Reset()         { *m = PlumPutBlock{} }
 func (m *PlumPutBlock) String() string { return proto.CompactTextString(m) }
 func (*PlumPutBlock) ProtoMessage()    {}
 func (*PlumPutBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{14}
+	return fileDescriptor_59885c909ad4dfd3, []int{15}
 }
 
 func (m *PlumPutBlock) XXX_Unmarshal(b []byte) error {
@@ -848,7 +925,7 @@ func (m *PlumCreateDeposit) Reset()         { *m = PlumCreateDeposit{} }
 func (m *PlumCreateDeposit) String() string { return proto.CompactTextString(m) }
 func (*PlumCreateDeposit) ProtoMessage()    {}
 func (*PlumCreateDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{15}
+	return fileDescriptor_59885c909ad4dfd3, []int{16}
 }
 
 func (m *PlumCreateDeposit) XXX_Unmarshal(b []byte) error {
@@ -907,7 +984,7 @@ func (m *PlumStartExit) Reset()         { *m = PlumStartExit{} }
 func (m *PlumStartExit) String() string { return proto.CompactTextString(m) }
 func (*PlumStartExit) ProtoMessage()    {}
 func (*PlumStartExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{16}
+	return fileDescriptor_59885c909ad4dfd3, []int{17}
 }
 
 func (m *PlumStartExit) XXX_Unmarshal(b []byte) error {
@@ -992,7 +1069,7 @@ func (m *PlumChallengeExit) Reset()         { *m = PlumChallengeExit{} }
 func (m *PlumChallengeExit) String() string { return proto.CompactTextString(m) }
 func (*PlumChallengeExit) ProtoMessage()    {}
 func (*PlumChallengeExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{17}
+	return fileDescriptor_59885c909ad4dfd3, []int{18}
 }
 
 func (m *PlumChallengeExit) XXX_Unmarshal(b []byte) error {
@@ -1064,7 +1141,7 @@ func (m *PlumResponseChallengeExit) Reset()         { *m = PlumResponseChallenge
 func (m *PlumResponseChallengeExit) String() string { return proto.CompactTextString(m) }
 func (*PlumResponseChallengeExit) ProtoMessage()    {}
 func (*PlumResponseChallengeExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{18}
+	return fileDescriptor_59885c909ad4dfd3, []int{19}
 }
 
 func (m *PlumResponseChallengeExit) XXX_Unmarshal(b []byte) error {
@@ -1139,7 +1216,7 @@ func (m *PlumFinalizeExit) Reset()         { *m = PlumFinalizeExit{} }
 func (m *PlumFinalizeExit) String() string { return proto.CompactTextString(m) }
 func (*PlumFinalizeExit) ProtoMessage()    {}
 func (*PlumFinalizeExit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{19}
+	return fileDescriptor_59885c909ad4dfd3, []int{20}
 }
 
 func (m *PlumFinalizeExit) XXX_Unmarshal(b []byte) error {
@@ -1186,7 +1263,7 @@ func (m *PlumSettleDeposit) Reset()         { *m = PlumSettleDeposit{} }
 func (m *PlumSettleDeposit) String() string { return proto.CompactTextString(m) }
 func (*PlumSettleDeposit) ProtoMessage()    {}
 func (*PlumSettleDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{20}
+	return fileDescriptor_59885c909ad4dfd3, []int{21}
 }
 
 func (m *PlumSettleDeposit) XXX_Unmarshal(b []byte) error {
@@ -1228,7 +1305,7 @@ func (m *PlumTransfer) Reset()         { *m = PlumTransfer{} }
 func (m *PlumTransfer) String() string { return proto.CompactTextString(m) }
 func (*PlumTransfer) ProtoMessage()    {}
 func (*PlumTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{21}
+	return fileDescriptor_59885c909ad4dfd3, []int{22}
 }
 
 func (m *PlumTransfer) XXX_Unmarshal(b []byte) error {
@@ -1277,11 +1354,54 @@ func (m *PlumTransfer) GetRecipient() string {
 	return ""
 }
 
+// Bundle is an ordered list of actions from the same sender with consecutive nonces, which take effect all together or
+// not at all
+type Bundle struct {
+	Actions              []*ActionCore `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *Bundle) Reset()         { *m = Bundle{} }
+func (m *Bundle) String() string { return proto.CompactTextString(m) }
+func (*Bundle) ProtoMessage()    {}
+func (*Bundle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59885c909ad4dfd3, []int{23}
+}
+
+func (m *Bundle) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Bundle.Unmarshal(m, b)
+}
+func (m *Bundle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Bundle.Marshal(b, m, deterministic)
+}
+func (m *Bundle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Bundle.Merge(m, src)
+}
+func (m *Bundle) XXX_Size() int {
+	return xxx_messageInfo_Bundle.Size(m)
+}
+func (m *Bundle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Bundle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Bundle proto.InternalMessageInfo
+
+func (m *Bundle) GetActions() []*ActionCore {
+	if m != nil {
+		return m.Actions
+	}
+	return nil
+}
+
 type ActionCore struct {
 	Version  uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	Nonce    uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 	GasLimit uint64 `protobuf:"varint,3,opt,name=gasLimit,proto3" json:"gasLimit,omitempty"`
 	GasPrice string `protobuf:"bytes,4,opt,name=gasPrice,proto3" json:"gasPrice,omitempty"`
+	// the height of the last block the action can be included in, or 0 if the action never expires
+	ValidUntilHeight uint64 `protobuf:"varint,5,opt,name=validUntilHeight,proto3" json:"validUntilHeight,omitempty"`
 	// Types that are valid to be assigned to Action:
 	//	*ActionCore_Transfer
 	//	*ActionCore_Vote
@@ -1305,6 +1425,8 @@ type ActionCore struct {
 	//	*ActionCore_ClaimFromRewardingFund
 	//	*ActionCore_GrantReward
 	//	*ActionCore_PutPollResult
+	//	*ActionCore_Bundle
+	//	*ActionCore_MultiTransfer
 	Action               isActionCore_Action `protobuf_oneof:"action"`
 	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
 	XXX_unrecognized     []byte              `json:"-"`
@@ -1315,7 +1437,7 @@ func (m *ActionCore) Reset()         { *m = ActionCore{} }
 func (m *ActionCore) String() string { return proto.CompactTextString(m) }
 func (*ActionCore) ProtoMessage()    {}
 func (*ActionCore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{22}
+	return fileDescriptor_59885c909ad4dfd3, []int{24}
 }
 
 func (m *ActionCore) XXX_Unmarshal(b []byte) error {
@@ -1364,6 +1486,13 @@ func (m *ActionCore) GetGasPrice() string {
 	return ""
 }
 
+func (m *ActionCore) GetValidUntilHeight() uint64 {
+	if m != nil {
+		return m.ValidUntilHeight
+	}
+	return 0
+}
+
 type isActionCore_Action interface {
 	isActionCore_Action()
 }
@@ -1456,6 +1585,14 @@ type ActionCore_PutPollResult struct {
 	PutPollResult *PutPollResult `protobuf:"bytes,50,opt,name=putPollResult,proto3,oneof"`
 }
 
+type ActionCore_Bundle struct {
+	Bundle *Bundle `protobuf:"bytes,60,opt,name=bundle,proto3,oneof"`
+}
+
+type ActionCore_MultiTransfer struct {
+	MultiTransfer *MultiTransfer `protobuf:"bytes,61,opt,name=multiTransfer,proto3,oneof"`
+}
+
 func (*ActionCore_Transfer) isActionCore_Action() {}
 
 func (*ActionCore_Vote) isActionCore_Action() {}
@@ -1500,6 +1637,10 @@ func (*ActionCore_GrantReward) isActionCore_Action() {}
 
 func (*ActionCore_PutPollResult) isActionCore_Action() {}
 
+func (*ActionCore_Bundle) isActionCore_Action() {}
+
+func (*ActionCore_MultiTransfer) isActionCore_Action() {}
+
 func (m *ActionCore) GetAction() isActionCore_Action {
 	if m != nil {
 		return m.Action
@@ -1661,6 +1802,20 @@ func (m *ActionCore) GetPutPollResult() *PutPollResult {
 	return nil
 }
 
+func (m *ActionCore) GetBundle() *Bundle {
+	if x, ok := m.GetAction().(*ActionCore_Bundle); ok {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (m *ActionCore) GetMultiTransfer() *MultiTransfer {
+	if x, ok := m.GetAction().(*ActionCore_MultiTransfer); ok {
+		return x.MultiTransfer
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ActionCore) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1686,23 +1841,27 @@ func (*ActionCore) XXX_OneofWrappers() []interface{} {
 		(*ActionCore_ClaimFromRewardingFund)(nil),
 		(*ActionCore_GrantReward)(nil),
 		(*ActionCore_PutPollResult)(nil),
+		(*ActionCore_Bundle)(nil),
+		(*ActionCore_MultiTransfer)(nil),
 	}
 }
 
 type Action struct {
-	Core                 *ActionCore `protobuf:"bytes,1,opt,name=core,proto3" json:"core,omitempty"`
-	SenderPubKey         []byte      `protobuf:"bytes,2,opt,name=senderPubKey,proto3" json:"senderPubKey,omitempty"`
-	Signature            []byte      `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Core         *ActionCore `protobuf:"bytes,1,opt,name=core,proto3" json:"core,omitempty"`
+	SenderPubKey []byte      `protobuf:"bytes,2,opt,name=senderPubKey,proto3" json:"senderPubKey,omitempty"`
+	Signature    []byte      `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// the ID of the chain the action is signed for as an Ethereum transaction, or 0 if the action core is signed
+	EthChainID           uint32   `protobuf:"varint,4,opt,name=ethChainID,proto3" json:"ethChainID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Action) Reset()         { *m = Action{} }
 func (m *Action) String() string { return proto.CompactTextString(m) }
 func (*Action) ProtoMessage()    {}
 func (*Action) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{23}
+	return fileDescriptor_59885c909ad4dfd3, []int{25}
 }
 
 func (m *Action) XXX_Unmarshal(b []byte) error {
@@ -1744,23 +1903,36 @@ func (m *Action) GetSignature() []byte {
 	return nil
 }
 
+func (m *Action) GetEthChainID() uint32 {
+	if m != nil {
+		return m.EthChainID
+	}
+	return 0
+}
+
 type Receipt struct {
-	ReturnValue          []byte   `protobuf:"bytes,1,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
-	Status               uint64   `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	ActHash              []byte   `protobuf:"bytes,3,opt,name=actHash,proto3" json:"actHash,omitempty"`
-	GasConsumed          uint64   `protobuf:"varint,4,opt,name=gasConsumed,proto3" json:"gasConsumed,omitempty"`
-	ContractAddress      string   `protobuf:"bytes,5,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
-	Logs                 []*Log   `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	ReturnValue     []byte `protobuf:"bytes,1,opt,name=returnValue,proto3" json:"returnValue,omitempty"`
+	Status          uint64 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	ActHash         []byte `protobuf:"bytes,3,opt,name=actHash,proto3" json:"actHash,omitempty"`
+	GasConsumed     uint64 `protobuf:"varint,4,opt,name=gasConsumed,proto3" json:"gasConsumed,omitempty"`
+	ContractAddress string `protobuf:"bytes,5,opt,name=contractAddress,proto3" json:"contractAddress,omitempty"`
+	Logs            []*Log `protobuf:"bytes,6,rep,name=logs,proto3" json:"logs,omitempty"`
+	// the class of the evm error if the execution fails
+	ErrorClass ExecutionErrorClass `protobuf:"varint,7,opt,name=errorClass,proto3,enum=iotextypes.ExecutionErrorClass" json:"errorClass,omitempty"`
+	// the reason decoded from the revert data in the form of Error(string)
+	RevertReason string `protobuf:"bytes,8,opt,name=revertReason,proto3" json:"revertReason,omitempty"`
+	// the results of the transfers to the recipients of a multi-transfer
+	TransferResults      []*TransferResult `protobuf:"bytes,9,rep,name=transferResults,proto3" json:"transferResults,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *Receipt) Reset()         { *m = Receipt{} }
 func (m *Receipt) String() string { return proto.CompactTextString(m) }
 func (*Receipt) ProtoMessage()    {}
 func (*Receipt) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{24}
+	return fileDescriptor_59885c909ad4dfd3, []int{26}
 }
 
 func (m *Receipt) XXX_Unmarshal(b []byte) error {
@@ -1823,6 +1995,82 @@ func (m *Receipt) GetLogs() []*Log {
 	return nil
 }
 
+func (m *Receipt) GetErrorClass() ExecutionErrorClass {
+	if m != nil {
+		return m.ErrorClass
+	}
+	return ExecutionErrorClass_ExecutionNoError
+}
+
+func (m *Receipt) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
+func (m *Receipt) GetTransferResults() []*TransferResult {
+	if m != nil {
+		return m.TransferResults
+	}
+	return nil
+}
+
+type TransferResult struct {
+	Recipient            string   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount               string   `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Status               uint64   `protobuf:"varint,3,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferResult) Reset()         { *m = TransferResult{} }
+func (m *TransferResult) String() string { return proto.CompactTextString(m) }
+func (*TransferResult) ProtoMessage()    {}
+func (*TransferResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59885c909ad4dfd3, []int{27}
+}
+
+func (m *TransferResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferResult.Unmarshal(m, b)
+}
+func (m *TransferResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferResult.Marshal(b, m, deterministic)
+}
+func (m *TransferResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferResult.Merge(m, src)
+}
+func (m *TransferResult) XXX_Size() int {
+	return xxx_messageInfo_TransferResult.Size(m)
+}
+func (m *TransferResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferResult proto.InternalMessageInfo
+
+func (m *TransferResult) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *TransferResult) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *TransferResult) GetStatus() uint64 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
 type Log struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Topics               [][]byte `protobuf:"bytes,2,rep,name=topics,proto3" json:"topics,omitempty"`
@@ -1839,7 +2087,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{25}
+	return fileDescriptor_59885c909ad4dfd3, []int{28}
 }
 
 func (m *Log) XXX_Unmarshal(b []byte) error {
@@ -1914,7 +2162,7 @@ func (m *DepositToRewardingFund) Reset()         { *m = DepositToRewardingFund{}
 func (m *DepositToRewardingFund) String() string { return proto.CompactTextString(m) }
 func (*DepositToRewardingFund) ProtoMessage()    {}
 func (*DepositToRewardingFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{26}
+	return fileDescriptor_59885c909ad4dfd3, []int{29}
 }
 
 func (m *DepositToRewardingFund) XXX_Unmarshal(b []byte) error {
@@ -1961,7 +2209,7 @@ func (m *ClaimFromRewardingFund) Reset()         { *m = ClaimFromRewardingFund{}
 func (m *ClaimFromRewardingFund) String() string { return proto.CompactTextString(m) }
 func (*ClaimFromRewardingFund) ProtoMessage()    {}
 func (*ClaimFromRewardingFund) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{27}
+	return fileDescriptor_59885c909ad4dfd3, []int{30}
 }
 
 func (m *ClaimFromRewardingFund) XXX_Unmarshal(b []byte) error {
@@ -2007,7 +2255,7 @@ func (m *GrantReward) Reset()         { *m = GrantReward{} }
 func (m *GrantReward) String() string { return proto.CompactTextString(m) }
 func (*GrantReward) ProtoMessage()    {}
 func (*GrantReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4dd5ed50f883f28, []int{28}
+	return fileDescriptor_59885c909ad4dfd3, []int{31}
 }
 
 func (m *GrantReward) XXX_Unmarshal(b []byte) error {
@@ -2036,8 +2284,10 @@ func (m *GrantReward) GetType() RewardType {
 }
 
 func init() {
+	proto.RegisterEnum("iotextypes.ExecutionErrorClass", ExecutionErrorClass_name, ExecutionErrorClass_value)
 	proto.RegisterEnum("iotextypes.RewardType", RewardType_name, RewardType_value)
 	proto.RegisterType((*Transfer)(nil), "iotextypes.Transfer")
+	proto.RegisterType((*MultiTransfer)(nil), "iotextypes.MultiTransfer")
 	proto.RegisterType((*Vote)(nil), "iotextypes.Vote")
 	proto.RegisterType((*Candidate)(nil), "iotextypes.Candidate")
 	proto.RegisterType((*CandidateList)(nil), "iotextypes.CandidateList")
@@ -2060,125 +2310,145 @@ func init() {
 	proto.RegisterType((*PlumFinalizeExit)(nil), "iotextypes.PlumFinalizeExit")
 	proto.RegisterType((*PlumSettleDeposit)(nil), "iotextypes.PlumSettleDeposit")
 	proto.RegisterType((*PlumTransfer)(nil), "iotextypes.PlumTransfer")
+	proto.RegisterType((*Bundle)(nil), "iotextypes.Bundle")
 	proto.RegisterType((*ActionCore)(nil), "iotextypes.ActionCore")
 	proto.RegisterType((*Action)(nil), "iotextypes.Action")
 	proto.RegisterType((*Receipt)(nil), "iotextypes.Receipt")
+	proto.RegisterType((*TransferResult)(nil), "iotextypes.TransferResult")
 	proto.RegisterType((*Log)(nil), "iotextypes.Log")
 	proto.RegisterType((*DepositToRewardingFund)(nil), "iotextypes.DepositToRewardingFund")
 	proto.RegisterType((*ClaimFromRewardingFund)(nil), "iotextypes.ClaimFromRewardingFund")
 	proto.RegisterType((*GrantReward)(nil), "iotextypes.GrantReward")
 }
 
-func init() { proto.RegisterFile("proto/types/action.proto", fileDescriptor_d4dd5ed50f883f28) }
-
-var fileDescriptor_d4dd5ed50f883f28 = []byte{
-	// 1728 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xdd, 0x6e, 0xdc, 0x36,
-	0x16, 0x9e, 0x3f, 0x4f, 0xec, 0xe3, 0x99, 0x78, 0xcc, 0x75, 0x26, 0xb2, 0x93, 0x4d, 0x0c, 0x65,
-	0x37, 0x30, 0xbc, 0xd9, 0x31, 0xe0, 0x45, 0x02, 0x67, 0x17, 0x08, 0x36, 0xfe, 0xcb, 0xec, 0xd6,
-	0x69, 0x07, 0xb4, 0x9b, 0x8b, 0xb4, 0x40, 0xa1, 0xd1, 0xd0, 0x63, 0x35, 0x1a, 0x51, 0xa0, 0x28,
-	0xc7, 0xce, 0x45, 0xaf, 0xdb, 0x87, 0xe8, 0x83, 0xf4, 0x01, 0x7a, 0xd9, 0x02, 0x7d, 0xa0, 0x02,
-	0x05, 0x29, 0x4a, 0x43, 0x4a, 0x1a, 0x27, 0x0e, 0x02, 0xf4, 0x4e, 0xe7, 0xf0, 0xe3, 0xf9, 0x17,
-	0xcf, 0x21, 0xc1, 0x0a, 0x19, 0xe5, 0x74, 0x8b, 0x5f, 0x86, 0x24, 0xda, 0x72, 0x5c, 0xee, 0xd1,
-	0xa0, 0x27, 0x59, 0x08, 0x3c, 0xca, 0xc9, 0x85, 0x5c, 0x58, 0xbb, 0x3f, 0xa6, 0x74, 0xec, 0x93,
-	0x2d, 0xb9, 0x32, 0x8c, 0x4f, 0xb7, 0xb8, 0x37, 0x21, 0x11, 0x77, 0x26, 0x61, 0x02, 0xb6, 0x5f,
-	0xc3, 0xfc, 0x09, 0x73, 0x82, 0xe8, 0x94, 0x30, 0xd4, 0x85, 0xa6, 0x33, 0xa1, 0x71, 0xc0, 0xad,
-	0xea, 0x7a, 0x75, 0x63, 0x01, 0x2b, 0x0a, 0xdd, 0x85, 0x05, 0x46, 0x5c, 0x2f, 0xf4, 0x48, 0xc0,
-	0xad, 0x9a, 0x5c, 0x9a, 0x32, 0x90, 0x05, 0x37, 0x42, 0xe7, 0xd2, 0xa7, 0xce, 0xc8, 0xaa, 0xaf,
-	0x57, 0x37, 0x5a, 0x38, 0x25, 0xed, 0x11, 0x34, 0x5e, 0x51, 0x4e, 0xd0, 0x0e, 0x2c, 0x64, 0x6a,
-	0xa5, 0xe8, 0xc5, 0xed, 0xb5, 0x5e, 0x62, 0x58, 0x2f, 0x35, 0xac, 0x77, 0x92, 0x22, 0xf0, 0x14,
-	0x8c, 0x6c, 0x68, 0x9d, 0x53, 0x4e, 0xc8, 0xf3, 0xd1, 0x88, 0x91, 0x28, 0x52, 0xca, 0x0d, 0x9e,
-	0xfd, 0x4b, 0x15, 0x16, 0xf6, 0x9c, 0x60, 0xe4, 0x8d, 0x1c, 0x4e, 0x84, 0x35, 0x8e, 0x02, 0x27,
-	0x4e, 0xa4, 0x24, 0x5a, 0x81, 0x39, 0xb1, 0x2f, 0x11, 0xd2, 0xc2, 0x09, 0x21, 0x7c, 0x0e, 0xe3,
-	0xe1, 0x67, 0xe4, 0x52, 0x19, 0xaf, 0x28, 0xf4, 0x10, 0x6e, 0xba, 0x8c, 0x38, 0x22, 0xac, 0x7d,
-	0xe2, 0x8d, 0xcf, 0xb8, 0xd5, 0x58, 0xaf, 0x6e, 0x34, 0x70, 0x8e, 0x8b, 0x36, 0xa1, 0xe3, 0x3b,
-	0x11, 0xff, 0x32, 0x14, 0xda, 0x15, 0x72, 0x4e, 0x22, 0x0b, 0x7c, 0xf4, 0x37, 0x68, 0x33, 0xf2,
-	0xd6, 0x61, 0xa3, 0xd4, 0x9d, 0xa6, 0xb4, 0xd0, 0x64, 0xda, 0x87, 0xd0, 0xce, 0xdc, 0x39, 0xf2,
-	0x22, 0x8e, 0x1e, 0x03, 0xb8, 0x29, 0x43, 0x78, 0x55, 0xdf, 0x58, 0xdc, 0xbe, 0xd5, 0x9b, 0x26,
-	0xb9, 0x97, 0xc1, 0xb1, 0x06, 0xb4, 0x87, 0xd0, 0x1e, 0xc4, 0x7c, 0x40, 0x7d, 0x1f, 0x93, 0x28,
-	0xf6, 0xb9, 0x70, 0xf5, 0x2c, 0x31, 0xb0, 0x2a, 0x0d, 0x54, 0x14, 0x7a, 0x6a, 0xc8, 0xaf, 0xc9,
-	0xfc, 0xac, 0x96, 0xca, 0x17, 0xe6, 0x18, 0x3a, 0x8e, 0x61, 0xe1, 0xe0, 0x82, 0xb8, 0xb1, 0x08,
-	0xc8, 0xcc, 0xf2, 0x59, 0x83, 0x79, 0x97, 0x06, 0x9c, 0x39, 0x6e, 0x5a, 0x3d, 0x19, 0x8d, 0x10,
-	0x34, 0x46, 0x0e, 0x77, 0x54, 0xf0, 0xe5, 0xb7, 0xfd, 0x5b, 0x15, 0xda, 0xc7, 0xdc, 0x61, 0xfc,
-	0x38, 0x1e, 0xee, 0x9d, 0x39, 0x5e, 0x20, 0x92, 0xea, 0x8a, 0x8f, 0xff, 0xed, 0x4b, 0xd1, 0x6d,
-	0x9c, 0x92, 0x68, 0x03, 0x96, 0x22, 0xe2, 0xc6, 0xcc, 0xe3, 0x97, 0xfb, 0x24, 0xa4, 0x91, 0x97,
-	0xaa, 0xc8, 0xb3, 0x45, 0xa2, 0x68, 0x48, 0x98, 0xcc, 0x5d, 0x0a, 0xad, 0x4b, 0x68, 0x81, 0x8f,
-	0xd6, 0x61, 0x31, 0x12, 0x06, 0x18, 0x99, 0xd7, 0x59, 0xa8, 0x07, 0x28, 0x74, 0x18, 0x09, 0x14,
-	0xfd, 0xc5, 0xe9, 0x69, 0x44, 0xd2, 0xc4, 0x97, 0xac, 0xd8, 0x0c, 0x5a, 0xc7, 0x9c, 0x86, 0x1f,
-	0xe0, 0xd1, 0x3d, 0x80, 0x88, 0xd3, 0x50, 0xa9, 0xae, 0x49, 0x89, 0x1a, 0x47, 0x7a, 0xac, 0xa4,
-	0xa4, 0x65, 0x54, 0x57, 0x1e, 0x9b, 0x6c, 0xfb, 0x09, 0xc0, 0x4b, 0xc2, 0xde, 0xf8, 0x04, 0x53,
-	0x2a, 0x23, 0x1d, 0x38, 0x13, 0xa2, 0x72, 0x23, 0xbf, 0xe5, 0x2f, 0xe1, 0xf8, 0x31, 0xc9, 0x7e,
-	0x09, 0x41, 0xd8, 0xef, 0x60, 0x7e, 0x10, 0xf3, 0x5d, 0x9f, 0xba, 0x6f, 0xca, 0xb4, 0x55, 0x4b,
-	0xb5, 0x69, 0xd5, 0x55, 0x33, 0xaa, 0xeb, 0x11, 0xcc, 0x31, 0x4a, 0xb9, 0xb0, 0x52, 0x14, 0x6e,
-	0x57, 0x2f, 0xac, 0xa9, 0x79, 0x38, 0x01, 0xd9, 0xdf, 0x40, 0x7b, 0x4f, 0xfc, 0x60, 0x24, 0x4d,
-	0xc5, 0xec, 0x40, 0x4d, 0xcb, 0xad, 0x36, 0xfb, 0xb4, 0xaa, 0xe7, 0x4e, 0x2b, 0xfb, 0x2b, 0x68,
-	0x1f, 0x13, 0xce, 0xfd, 0x4c, 0xc1, 0xc7, 0x1d, 0x7a, 0x2b, 0x30, 0xe7, 0x05, 0x23, 0x72, 0x21,
-	0x15, 0x34, 0x70, 0x42, 0xd8, 0xcb, 0xb0, 0x94, 0x58, 0x3f, 0xf0, 0xe3, 0x89, 0x8c, 0x8e, 0xfd,
-	0x0c, 0xd0, 0x09, 0x61, 0x13, 0x2f, 0xd0, 0xb9, 0x1f, 0x1e, 0x56, 0xfb, 0xe7, 0x2a, 0xb4, 0xc4,
-	0xbe, 0x4f, 0x98, 0x91, 0xa7, 0x66, 0x46, 0x1e, 0xe8, 0x19, 0xd1, 0x55, 0xf5, 0x44, 0x62, 0xa2,
-	0x83, 0x80, 0xb3, 0x4b, 0x95, 0x9e, 0xb5, 0x1d, 0x80, 0x29, 0x13, 0x75, 0xa0, 0xfe, 0x86, 0x5c,
-	0x2a, 0xf5, 0xe2, 0xb3, 0xbc, 0xa0, 0xfe, 0x5d, 0xdb, 0xa9, 0xda, 0x11, 0x2c, 0x4b, 0xf7, 0x8d,
-	0xe4, 0x5e, 0xcb, 0x97, 0x8f, 0x48, 0xf6, 0xef, 0x35, 0x68, 0x0b, 0xad, 0xf2, 0x34, 0x39, 0xb8,
-	0xb8, 0x96, 0xc6, 0x4d, 0xe8, 0x84, 0x8c, 0x9c, 0x7b, 0x34, 0x8e, 0xd2, 0x06, 0xa9, 0xbc, 0x2a,
-	0xf0, 0xd1, 0x33, 0x58, 0xcb, 0xf3, 0x64, 0x04, 0x07, 0x8c, 0xd2, 0x53, 0x75, 0xb6, 0x5d, 0x81,
-	0x40, 0xff, 0x85, 0x3b, 0xa5, 0xab, 0xc6, 0xf9, 0x73, 0x15, 0x44, 0x34, 0x4a, 0x72, 0xe1, 0xf1,
-	0xcc, 0xd2, 0x39, 0xa9, 0xd3, 0xe0, 0xa1, 0x27, 0xd0, 0xd5, 0x69, 0xcd, 0xc2, 0xa6, 0x44, 0xcf,
-	0x58, 0x45, 0x3b, 0x70, 0xbb, 0xb0, 0xa2, 0x2c, 0xbb, 0x21, 0x2d, 0x9b, 0xb5, 0x6c, 0xff, 0x50,
-	0x53, 0x59, 0x3f, 0x73, 0x7c, 0x9f, 0x04, 0x63, 0x72, 0xcd, 0x1c, 0x74, 0xa1, 0xe9, 0x52, 0xf9,
-	0xef, 0xab, 0x0a, 0x4e, 0x28, 0xf4, 0x08, 0x96, 0xdd, 0x54, 0x64, 0xe6, 0x72, 0x12, 0xe6, 0xe2,
-	0x82, 0x88, 0x6e, 0x81, 0xa9, 0x39, 0xdf, 0x90, 0xfb, 0xae, 0x82, 0xa0, 0x5d, 0xb8, 0x5b, 0xbe,
-	0x6c, 0x34, 0xfc, 0x2b, 0x31, 0xf6, 0x4f, 0x35, 0x58, 0x15, 0xb1, 0xc0, 0x24, 0x0a, 0x69, 0x10,
-	0x91, 0x3f, 0x37, 0x26, 0x9b, 0xd0, 0x61, 0xca, 0x90, 0x0c, 0x9c, 0x04, 0xa2, 0xc0, 0x17, 0xd5,
-	0x9d, 0xe7, 0x69, 0xe1, 0x4b, 0x2a, 0xed, 0x0a, 0xc4, 0xfb, 0xaa, 0xbb, 0xf9, 0xde, 0xea, 0xb6,
-	0x4f, 0xa0, 0x23, 0x42, 0x77, 0xe8, 0x05, 0x8e, 0xef, 0xbd, 0xfb, 0x44, 0x11, 0xb3, 0xff, 0x91,
-	0x14, 0x67, 0xa1, 0x1d, 0x28, 0x70, 0xd5, 0x00, 0x7f, 0x97, 0x1c, 0xc3, 0xfa, 0xac, 0x5c, 0x86,
-	0x13, 0x3f, 0xe2, 0x88, 0x04, 0x54, 0x1e, 0xf8, 0x1e, 0x0d, 0xd4, 0x91, 0x61, 0xf0, 0xc4, 0x29,
-	0x49, 0xdf, 0x06, 0x2a, 0x3d, 0x0b, 0x38, 0x21, 0xcc, 0xa3, 0xac, 0x91, 0x3f, 0xca, 0xbe, 0x6f,
-	0x03, 0x3c, 0x97, 0x53, 0xfe, 0x1e, 0x65, 0x72, 0xcc, 0x3d, 0x27, 0x2c, 0x12, 0x1a, 0x54, 0x5b,
-	0x54, 0xa4, 0x10, 0x1e, 0xd0, 0xc0, 0x25, 0xca, 0xd9, 0x84, 0x10, 0x33, 0xd8, 0xd8, 0x89, 0x8e,
-	0xbc, 0x89, 0x9a, 0x7a, 0x1a, 0x38, 0xa3, 0xd5, 0xda, 0x80, 0x79, 0x2e, 0x51, 0x7a, 0x33, 0x1a,
-	0x6d, 0xc3, 0x3c, 0x4f, 0xeb, 0x03, 0xe4, 0x64, 0xb8, 0xa2, 0xb7, 0x8b, 0x34, 0x1c, 0xfd, 0x0a,
-	0xce, 0x70, 0xe8, 0x21, 0x34, 0xc4, 0x6c, 0x6d, 0x2d, 0x4a, 0x7c, 0x47, 0xc7, 0x8b, 0xeb, 0x40,
-	0xbf, 0x82, 0xe5, 0x3a, 0x7a, 0x0c, 0x0b, 0x24, 0x1d, 0x1e, 0xad, 0x96, 0x04, 0x1b, 0x63, 0x6d,
-	0x36, 0x59, 0xf6, 0x2b, 0x78, 0x8a, 0x44, 0xcf, 0xa1, 0x1d, 0xe9, 0xd3, 0xa1, 0xd5, 0x2e, 0x4e,
-	0xac, 0xc6, 0xf8, 0xd8, 0xaf, 0x60, 0x73, 0x07, 0x7a, 0x06, 0xad, 0x48, 0x9b, 0xc6, 0xac, 0x9b,
-	0x52, 0x82, 0x65, 0x4a, 0x98, 0xae, 0xf7, 0x2b, 0xd8, 0xc0, 0x8b, 0xa8, 0x84, 0xaa, 0x49, 0x5a,
-	0x4b, 0xc5, 0xa8, 0xa4, 0x0d, 0x54, 0x44, 0x25, 0xc5, 0x09, 0xb3, 0x5d, 0xbd, 0xf9, 0x59, 0x9d,
-	0x92, 0x41, 0x5b, 0x07, 0x08, 0xb3, 0x8d, 0x1d, 0xd2, 0x73, 0xbd, 0x58, 0xad, 0xe5, 0x12, 0xcf,
-	0x75, 0x80, 0xf4, 0xdc, 0x28, 0xef, 0x17, 0xb0, 0xe4, 0x9a, 0x13, 0x8a, 0x85, 0xa4, 0x90, 0x3b,
-	0x45, 0x3b, 0x32, 0x48, 0xbf, 0x82, 0xf3, 0xbb, 0xd0, 0x00, 0x10, 0x2f, 0xcc, 0x35, 0xd6, 0x5f,
-	0xa4, 0xac, 0x7b, 0x46, 0x89, 0x14, 0x50, 0xfd, 0x0a, 0x2e, 0xd9, 0x2b, 0x92, 0x12, 0x6a, 0xd3,
-	0x87, 0xb5, 0x52, 0x4c, 0x8a, 0x3e, 0x9d, 0x88, 0xa4, 0xe8, 0x78, 0xf4, 0x12, 0x96, 0xc3, 0xfc,
-	0x84, 0x61, 0xdd, 0x92, 0x42, 0xfe, 0x9a, 0x17, 0x92, 0x0f, 0x74, 0x71, 0xa7, 0x08, 0x76, 0xa8,
-	0x8f, 0x0e, 0x56, 0xb7, 0x18, 0x6c, 0x63, 0xb6, 0x10, 0xc1, 0x36, 0x76, 0x64, 0x16, 0xe9, 0x27,
-	0xbd, 0x75, 0x7b, 0x86, 0x45, 0x3a, 0x28, 0xb3, 0xc8, 0xe8, 0x11, 0x04, 0x56, 0xc3, 0x59, 0x0d,
-	0xc4, 0xb2, 0xa4, 0xd8, 0xbf, 0xe7, 0xc5, 0x96, 0x82, 0xfb, 0x15, 0x3c, 0x5b, 0x12, 0xfa, 0x3f,
-	0x74, 0xc2, 0xdc, 0x61, 0x6b, 0xad, 0x4a, 0xe9, 0x77, 0xf3, 0xd2, 0x75, 0x4c, 0xbf, 0x82, 0x0b,
-	0xfb, 0xd2, 0x08, 0x18, 0x45, 0x69, 0xad, 0x95, 0x47, 0x20, 0x5f, 0xb9, 0xc5, 0x9d, 0x69, 0x89,
-	0x64, 0x1d, 0xeb, 0x4e, 0x79, 0x89, 0x68, 0xa7, 0x92, 0x81, 0x47, 0x5f, 0x43, 0x77, 0x94, 0x88,
-	0x3a, 0xa1, 0x58, 0x5e, 0xba, 0xbd, 0x60, 0x7c, 0x18, 0x07, 0x23, 0xeb, 0x9e, 0x94, 0x64, 0xeb,
-	0x92, 0xf6, 0x4b, 0x91, 0xfd, 0x0a, 0x9e, 0x21, 0x43, 0x48, 0x77, 0x7d, 0xc7, 0x9b, 0x1c, 0x32,
-	0x3a, 0x31, 0xa5, 0xdf, 0x2f, 0x4a, 0xdf, 0x2b, 0x45, 0x0a, 0xe9, 0xe5, 0x32, 0xd0, 0x7f, 0x60,
-	0x71, 0xcc, 0x9c, 0x80, 0x27, 0x5c, 0x6b, 0x5d, 0x8a, 0xbc, 0xad, 0x8b, 0x7c, 0x31, 0x5d, 0xee,
-	0x57, 0xb0, 0x8e, 0x96, 0xc5, 0xac, 0xbf, 0x05, 0x58, 0xdb, 0x25, 0xc5, 0xac, 0x03, 0x64, 0x31,
-	0xeb, 0x8c, 0xdd, 0x79, 0x68, 0x26, 0xaf, 0x4c, 0xf6, 0x39, 0x34, 0x93, 0x4e, 0x84, 0x36, 0xa1,
-	0xe1, 0x52, 0x46, 0xd4, 0x9b, 0x8e, 0x71, 0xb5, 0x9b, 0xf6, 0x2a, 0x2c, 0x31, 0xa2, 0x31, 0x46,
-	0x24, 0x18, 0x11, 0x36, 0x48, 0x9e, 0x5b, 0x54, 0x63, 0xd4, 0x79, 0xa2, 0x05, 0x46, 0xde, 0x38,
-	0x70, 0x78, 0xcc, 0x88, 0x9a, 0x5d, 0xa6, 0x0c, 0xfb, 0xd7, 0x2a, 0xdc, 0xc0, 0xc4, 0x25, 0x5e,
-	0x28, 0x6f, 0xe8, 0x8c, 0xf0, 0x98, 0x05, 0xaf, 0xe4, 0x75, 0xa3, 0x2a, 0xb1, 0x3a, 0x4b, 0x34,
-	0xe8, 0x88, 0x3b, 0x3c, 0x8e, 0xd2, 0xae, 0x9f, 0x50, 0xf2, 0x81, 0xc8, 0xe5, 0x7d, 0x27, 0x3a,
-	0x4b, 0x9f, 0xab, 0x14, 0x29, 0x64, 0x8e, 0x9d, 0x68, 0x8f, 0x06, 0x51, 0x3c, 0x21, 0xa3, 0xf4,
-	0xd6, 0xaf, 0xb1, 0xc4, 0xcc, 0x91, 0xbe, 0x5c, 0xa4, 0x33, 0xc7, 0x5c, 0x32, 0x73, 0xe4, 0xd8,
-	0xe8, 0x01, 0x34, 0x7c, 0x3a, 0x8e, 0xac, 0xa6, 0xbc, 0x62, 0x2d, 0xe9, 0x91, 0x39, 0xa2, 0x63,
-	0x2c, 0x17, 0xed, 0x1f, 0xab, 0x50, 0x3f, 0xa2, 0xe3, 0x2b, 0xde, 0xac, 0xba, 0xd0, 0xe4, 0x34,
-	0xf4, 0x5c, 0xe1, 0x44, 0x7d, 0xa3, 0x85, 0x15, 0x55, 0xf6, 0x6c, 0x22, 0xcc, 0x1f, 0x8a, 0x83,
-	0xf0, 0xf3, 0x78, 0x32, 0x54, 0xd3, 0x5c, 0x03, 0xeb, 0x2c, 0xa1, 0x87, 0x5f, 0x04, 0xd2, 0xf5,
-	0x64, 0x6a, 0x4b, 0xc9, 0xe9, 0x75, 0xb6, 0x29, 0x87, 0x09, 0x75, 0x9d, 0xdd, 0x87, 0x6e, 0xf9,
-	0x4f, 0x30, 0xf3, 0xd2, 0x9c, 0xda, 0x55, 0xd3, 0x9e, 0x73, 0xf6, 0xa1, 0x5b, 0x5e, 0xec, 0xd7,
-	0x92, 0xf2, 0x14, 0x16, 0xb5, 0xfa, 0x16, 0x95, 0x27, 0xa2, 0x29, 0x37, 0xde, 0x34, 0x2b, 0x2f,
-	0x41, 0x9c, 0x5c, 0x86, 0x04, 0x4b, 0xcc, 0x66, 0x0f, 0x60, 0xca, 0x43, 0x4b, 0xb0, 0x28, 0xfb,
-	0x45, 0xc2, 0xea, 0x54, 0x04, 0xe3, 0x20, 0xa4, 0xee, 0x99, 0x62, 0x54, 0x77, 0x77, 0x5e, 0x3f,
-	0x19, 0x7b, 0xfc, 0x2c, 0x1e, 0xf6, 0x5c, 0x3a, 0xd9, 0x92, 0x92, 0x43, 0x46, 0xbf, 0x25, 0x2e,
-	0x4f, 0x88, 0x7f, 0x8a, 0x7a, 0x4e, 0x5e, 0x54, 0xc7, 0x24, 0xd8, 0x9a, 0xaa, 0x1e, 0x36, 0x25,
-	0xf3, 0x5f, 0x7f, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa6, 0xe8, 0xeb, 0xd9, 0x9c, 0x15, 0x00, 0x00,
+func init() { proto.RegisterFile("action.proto", fileDescriptor_59885c909ad4dfd3) }
+
+var fileDescriptor_59885c909ad4dfd3 = []byte{
+	// 2004 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x73, 0x1b, 0xc7,
+	0xd1, 0xc6, 0x02, 0x20, 0x44, 0x36, 0x09, 0x12, 0x1c, 0x53, 0xd0, 0x8a, 0xd2, 0x2b, 0xb1, 0xd6,
+	0x6f, 0x5c, 0x2c, 0x46, 0x81, 0x52, 0x4c, 0x59, 0x45, 0x39, 0x89, 0x12, 0xf1, 0x43, 0x82, 0x13,
+	0xc9, 0x64, 0x0d, 0x69, 0x1f, 0x9c, 0x54, 0x52, 0xcb, 0xdd, 0x21, 0xb8, 0xd1, 0x62, 0x67, 0x6b,
+	0x66, 0x96, 0x26, 0x7d, 0xc8, 0x3d, 0x7f, 0x20, 0xb7, 0x1c, 0x73, 0xcf, 0x35, 0x3f, 0x20, 0xc7,
+	0x1c, 0xf2, 0x73, 0x72, 0x48, 0x55, 0x6a, 0x3e, 0x76, 0x31, 0xfb, 0x01, 0xda, 0x72, 0xb9, 0x2a,
+	0x37, 0xf4, 0x33, 0xcf, 0xf4, 0xf4, 0x74, 0xf7, 0x4e, 0xf7, 0x0c, 0x60, 0xc5, 0x0f, 0x44, 0x44,
+	0x93, 0x51, 0xca, 0xa8, 0xa0, 0x08, 0x22, 0x2a, 0xc8, 0xb5, 0xb8, 0x49, 0x09, 0xdf, 0x7c, 0x3c,
+	0xa1, 0x74, 0x12, 0x93, 0xa7, 0x6a, 0xe4, 0x3c, 0xbb, 0x78, 0x2a, 0xa2, 0x29, 0xe1, 0xc2, 0x9f,
+	0xa6, 0x9a, 0xec, 0x7d, 0x09, 0x8b, 0x67, 0xcc, 0x4f, 0xf8, 0x05, 0x61, 0x68, 0x08, 0x3d, 0x7f,
+	0x4a, 0xb3, 0x44, 0xb8, 0xce, 0x96, 0xb3, 0xbd, 0x84, 0x8d, 0x84, 0x1e, 0xc2, 0x12, 0x23, 0x41,
+	0x94, 0x46, 0x24, 0x11, 0x6e, 0x5b, 0x0d, 0xcd, 0x00, 0xe4, 0xc2, 0x9d, 0xd4, 0xbf, 0x89, 0xa9,
+	0x1f, 0xba, 0x9d, 0x2d, 0x67, 0x7b, 0x05, 0xe7, 0xa2, 0x77, 0x00, 0xfd, 0xb7, 0x59, 0x2c, 0xa2,
+	0x62, 0x81, 0x5d, 0x58, 0x12, 0xe6, 0x37, 0x77, 0x9d, 0xad, 0xce, 0xf6, 0xf2, 0xee, 0xc6, 0x68,
+	0x66, 0xed, 0x28, 0x27, 0xe2, 0x19, 0xcd, 0x0b, 0xa1, 0xfb, 0x05, 0x15, 0x04, 0xed, 0xc1, 0x52,
+	0x61, 0xbb, 0xb2, 0x6f, 0x79, 0x77, 0x73, 0xa4, 0x77, 0x37, 0xca, 0x77, 0x37, 0x3a, 0xcb, 0x19,
+	0x78, 0x46, 0x46, 0x1e, 0xac, 0x5c, 0x51, 0x41, 0xc8, 0xcb, 0x30, 0x64, 0x84, 0x73, 0xb3, 0x83,
+	0x12, 0xe6, 0xfd, 0xd3, 0x81, 0xa5, 0x03, 0x3f, 0x09, 0xa3, 0xd0, 0x17, 0x44, 0x6e, 0xc9, 0x37,
+	0x64, 0xed, 0x89, 0x5c, 0x44, 0x1b, 0xb0, 0x20, 0xe7, 0x69, 0x25, 0x2b, 0x58, 0x0b, 0xd2, 0x71,
+	0x69, 0x76, 0xfe, 0x6b, 0x72, 0x63, 0x3c, 0x60, 0x24, 0xf4, 0x11, 0xac, 0x06, 0x8c, 0xf8, 0x32,
+	0x36, 0x63, 0x12, 0x4d, 0x2e, 0x85, 0xdb, 0xdd, 0x72, 0xb6, 0xbb, 0xb8, 0x82, 0xa2, 0x1d, 0x18,
+	0xc4, 0x3e, 0x17, 0x9f, 0xa7, 0x72, 0x75, 0xc3, 0x5c, 0x50, 0xcc, 0x1a, 0x8e, 0xfe, 0x1f, 0xfa,
+	0x8c, 0x7c, 0xe5, 0xb3, 0x30, 0xdf, 0x4e, 0x4f, 0x59, 0x58, 0x06, 0xbd, 0x57, 0xd0, 0x2f, 0xb6,
+	0xf3, 0x26, 0xe2, 0x02, 0x7d, 0x0c, 0x10, 0xe4, 0x40, 0xee, 0xfb, 0xbb, 0xb6, 0xef, 0x0b, 0x3a,
+	0xb6, 0x88, 0xde, 0x39, 0xf4, 0x4f, 0x32, 0x71, 0x42, 0xe3, 0x18, 0x13, 0x9e, 0xc5, 0x42, 0x6e,
+	0xf5, 0x52, 0x1b, 0xe8, 0x28, 0x03, 0x8d, 0x84, 0x9e, 0x97, 0xf4, 0xb7, 0x55, 0x7c, 0xee, 0x37,
+	0xea, 0x97, 0xe6, 0x94, 0xd6, 0x38, 0x85, 0xa5, 0xa3, 0x6b, 0x12, 0x64, 0xd2, 0x21, 0x73, 0x73,
+	0x70, 0x13, 0x16, 0x03, 0x9a, 0x08, 0xe6, 0x07, 0x79, 0x0a, 0x16, 0x32, 0x42, 0xd0, 0x0d, 0x7d,
+	0xe1, 0x1b, 0xe7, 0xab, 0xdf, 0xde, 0xbf, 0x1c, 0xe8, 0x9f, 0x0a, 0x9f, 0x89, 0xd3, 0xec, 0xfc,
+	0xe0, 0xd2, 0x8f, 0x12, 0x19, 0xd4, 0x40, 0xfe, 0xf8, 0xf4, 0x50, 0xa9, 0xee, 0xe3, 0x5c, 0x44,
+	0xdb, 0xb0, 0xc6, 0x49, 0x90, 0xb1, 0x48, 0xdc, 0x1c, 0x92, 0x94, 0xf2, 0x28, 0x5f, 0xa2, 0x0a,
+	0xcb, 0x40, 0xd1, 0x94, 0x30, 0x15, 0xbb, 0x9c, 0xda, 0x51, 0xd4, 0x1a, 0x8e, 0xb6, 0x60, 0x99,
+	0x4b, 0x03, 0x4a, 0x91, 0xb7, 0x21, 0x34, 0x02, 0x94, 0xfa, 0x8c, 0x24, 0x46, 0x3e, 0xbe, 0xb8,
+	0xe0, 0x24, 0x0f, 0x7c, 0xc3, 0x88, 0xc7, 0x60, 0xe5, 0x54, 0xd0, 0xf4, 0x5b, 0xec, 0xe8, 0x11,
+	0x00, 0x17, 0x34, 0x35, 0x4b, 0xb7, 0x95, 0x46, 0x0b, 0x51, 0x3b, 0x36, 0x5a, 0xf2, 0x34, 0xea,
+	0x98, 0x1d, 0x97, 0x61, 0xef, 0x19, 0xc0, 0x5b, 0xc2, 0xde, 0xc5, 0x04, 0x53, 0xaa, 0x3c, 0x9d,
+	0xf8, 0x53, 0x62, 0x62, 0xa3, 0x7e, 0xab, 0x4f, 0xc2, 0x8f, 0x33, 0x52, 0x7c, 0x12, 0x52, 0xf0,
+	0xbe, 0x86, 0xc5, 0x93, 0x4c, 0xec, 0xc7, 0x34, 0x78, 0xd7, 0xb4, 0x9a, 0xd3, 0xb8, 0x9a, 0x95,
+	0x5d, 0xed, 0x52, 0x76, 0x3d, 0x81, 0x05, 0x46, 0xa9, 0x90, 0x56, 0xca, 0xc4, 0x1d, 0xda, 0x89,
+	0x35, 0x33, 0x0f, 0x6b, 0x92, 0xf7, 0x7b, 0xe8, 0x1f, 0xc8, 0x0f, 0x8c, 0xe4, 0xa1, 0x98, 0xef,
+	0xa8, 0x59, 0xba, 0xb5, 0xe7, 0x1f, 0x79, 0x9d, 0xca, 0x91, 0xe7, 0xfd, 0x06, 0xfa, 0xa7, 0x44,
+	0x88, 0xb8, 0x58, 0xe0, 0xbb, 0x9d, 0x9c, 0x1b, 0xb0, 0x10, 0x25, 0x21, 0xb9, 0x56, 0x0b, 0x74,
+	0xb1, 0x16, 0xbc, 0x75, 0x58, 0xd3, 0xd6, 0x9f, 0xc4, 0xd9, 0x54, 0x79, 0xc7, 0x7b, 0x01, 0xe8,
+	0x8c, 0xb0, 0x69, 0x94, 0xd8, 0xe8, 0xb7, 0x77, 0xab, 0xf7, 0x0f, 0x07, 0x56, 0xe4, 0xbc, 0xef,
+	0x31, 0x22, 0xcf, 0xcb, 0x11, 0xf9, 0xd0, 0x8e, 0x88, 0xbd, 0xd4, 0x48, 0x06, 0x86, 0x1f, 0x25,
+	0x82, 0xdd, 0x98, 0xf0, 0x6c, 0xee, 0x01, 0xcc, 0x40, 0x34, 0x80, 0xce, 0x3b, 0x72, 0x63, 0x96,
+	0x97, 0x3f, 0x9b, 0x13, 0xea, 0x93, 0xf6, 0x9e, 0xe3, 0x71, 0x58, 0x57, 0xdb, 0x2f, 0x05, 0xf7,
+	0xbd, 0xf6, 0xf2, 0x1d, 0x82, 0xfd, 0x9f, 0x36, 0xf4, 0xe5, 0xaa, 0xea, 0x34, 0x39, 0xba, 0x7e,
+	0xaf, 0x15, 0x77, 0x60, 0x90, 0x32, 0x72, 0x15, 0xd1, 0x8c, 0xe7, 0xb5, 0xcd, 0xec, 0xaa, 0x86,
+	0xa3, 0x17, 0xb0, 0x59, 0xc5, 0x94, 0x07, 0x4f, 0x18, 0xa5, 0x17, 0xe6, 0x6c, 0xbb, 0x85, 0x81,
+	0x7e, 0x09, 0x0f, 0x1a, 0x47, 0x4b, 0xe7, 0xcf, 0x6d, 0x14, 0x59, 0x28, 0xc9, 0x75, 0x24, 0x0a,
+	0x4b, 0x17, 0xd4, 0x9a, 0x25, 0x0c, 0x3d, 0x83, 0xa1, 0x2d, 0x5b, 0x16, 0xf6, 0x14, 0x7b, 0xce,
+	0x28, 0xda, 0x83, 0x7b, 0xb5, 0x11, 0x63, 0xd9, 0x1d, 0x65, 0xd9, 0xbc, 0x61, 0xef, 0x4f, 0x6d,
+	0x13, 0xf5, 0x4b, 0x3f, 0x8e, 0x49, 0x32, 0x21, 0xef, 0x19, 0x83, 0x21, 0xf4, 0x02, 0xaa, 0xbe,
+	0x7d, 0x93, 0xc1, 0x5a, 0x42, 0x4f, 0x60, 0x3d, 0xc8, 0x55, 0x16, 0x5b, 0xd6, 0x6e, 0xae, 0x0f,
+	0x48, 0xef, 0xd6, 0x40, 0x6b, 0xf3, 0x5d, 0x35, 0xef, 0x36, 0x0a, 0xda, 0x87, 0x87, 0xcd, 0xc3,
+	0xa5, 0x82, 0x7f, 0x2b, 0xc7, 0xfb, 0x7b, 0x1b, 0xee, 0x4b, 0x5f, 0x60, 0xc2, 0x53, 0x9a, 0x70,
+	0xf2, 0xbf, 0xf5, 0xc9, 0x0e, 0x0c, 0x98, 0x31, 0xa4, 0x20, 0x6b, 0x47, 0xd4, 0x70, 0x99, 0xdd,
+	0x55, 0xcc, 0x72, 0x9f, 0xce, 0xb4, 0x5b, 0x18, 0xdf, 0x94, 0xdd, 0xbd, 0x6f, 0xcc, 0x6e, 0xef,
+	0x0c, 0x06, 0xd2, 0x75, 0xaf, 0xa2, 0xc4, 0x8f, 0xa3, 0xaf, 0xbf, 0x27, 0x8f, 0x79, 0x3f, 0xd4,
+	0xc9, 0x59, 0x2b, 0x07, 0x86, 0xec, 0x94, 0xc8, 0x7f, 0xd4, 0xc7, 0xb0, 0xdd, 0x70, 0x37, 0xf1,
+	0xe4, 0x87, 0x18, 0x92, 0x84, 0xaa, 0x03, 0x3f, 0xa2, 0x89, 0x39, 0x32, 0x4a, 0x98, 0x3c, 0x25,
+	0xe9, 0x57, 0x89, 0x09, 0xcf, 0x12, 0xd6, 0x42, 0xf9, 0x28, 0xeb, 0x56, 0x8f, 0xb2, 0x4f, 0xa0,
+	0xb7, 0x9f, 0x25, 0x61, 0x4c, 0xd0, 0x8f, 0xe1, 0x8e, 0xbe, 0x33, 0xe4, 0xbd, 0x60, 0xa9, 0xa4,
+	0xbe, 0x54, 0x43, 0x07, 0x94, 0x11, 0x9c, 0xd3, 0xbc, 0xbf, 0xae, 0x02, 0xcc, 0x70, 0x59, 0x52,
+	0xaf, 0x08, 0xe3, 0xd2, 0x3a, 0x53, 0x52, 0x8d, 0x28, 0x0d, 0x4b, 0x68, 0x12, 0x10, 0xe3, 0x28,
+	0x2d, 0xc8, 0xfe, 0x6d, 0xe2, 0xf3, 0x37, 0xd1, 0xd4, 0x74, 0x4c, 0x5d, 0x5c, 0xc8, 0x66, 0xec,
+	0x84, 0x45, 0x01, 0x31, 0x36, 0x17, 0xb2, 0xcc, 0xb1, 0x2b, 0x3f, 0x8e, 0xc2, 0xcf, 0x13, 0x11,
+	0xc5, 0xe5, 0xd6, 0xb8, 0x8a, 0xa3, 0x5d, 0x58, 0xcc, 0xef, 0x0d, 0x2e, 0x6c, 0x39, 0xf3, 0x6e,
+	0x17, 0xe3, 0x16, 0x2e, 0x78, 0xe8, 0x23, 0xe8, 0xca, 0x1e, 0xde, 0x5d, 0x56, 0xfc, 0x81, 0xcd,
+	0x97, 0xd7, 0x8e, 0x71, 0x0b, 0xab, 0x71, 0xf4, 0x31, 0x2c, 0x91, 0xbc, 0x49, 0x75, 0x57, 0xb6,
+	0x9c, 0x6a, 0xfb, 0x5c, 0x74, 0xb0, 0xe3, 0x16, 0x9e, 0x31, 0xd1, 0x4b, 0xe8, 0x73, 0xbb, 0x0b,
+	0x75, 0xfb, 0xf5, 0xce, 0xb8, 0xd4, 0xa6, 0x8e, 0x5b, 0xb8, 0x3c, 0x03, 0xbd, 0x80, 0x15, 0x6e,
+	0x75, 0x7d, 0xee, 0xaa, 0xd2, 0xe0, 0x96, 0x35, 0xcc, 0xc6, 0xc7, 0x2d, 0x5c, 0xe2, 0x4b, 0xaf,
+	0xa4, 0xa6, 0x18, 0xbb, 0x6b, 0x75, 0xaf, 0xe4, 0x85, 0x5a, 0x7a, 0x25, 0xe7, 0x49, 0xb3, 0x03,
+	0xbb, 0xc8, 0xba, 0x83, 0x86, 0x86, 0xde, 0x26, 0x48, 0xb3, 0x4b, 0x33, 0xd4, 0xce, 0xed, 0x8f,
+	0xc2, 0x5d, 0x6f, 0xd8, 0xb9, 0x4d, 0x50, 0x3b, 0xb7, 0x01, 0xf4, 0x1a, 0xd6, 0x82, 0x72, 0x27,
+	0xe4, 0x22, 0xa5, 0xe4, 0x41, 0xdd, 0x8e, 0x82, 0x32, 0x6e, 0xe1, 0xea, 0x2c, 0x74, 0x02, 0x48,
+	0xd4, 0xfa, 0x27, 0xf7, 0x03, 0xa5, 0xeb, 0x51, 0x29, 0x45, 0x6a, 0xac, 0x71, 0x0b, 0x37, 0xcc,
+	0x95, 0x41, 0x49, 0xad, 0x2e, 0xc7, 0xdd, 0xa8, 0x07, 0xc5, 0xee, 0x82, 0x64, 0x50, 0x6c, 0x3e,
+	0x7a, 0x0b, 0xeb, 0x69, 0xb5, 0x93, 0x71, 0xef, 0x2a, 0x25, 0xff, 0x57, 0x55, 0x52, 0x75, 0x74,
+	0x7d, 0xa6, 0x74, 0x76, 0x6a, 0xb7, 0x28, 0xee, 0xb0, 0xee, 0xec, 0x52, 0x0f, 0x23, 0x9d, 0x5d,
+	0x9a, 0x51, 0x58, 0x64, 0x57, 0x14, 0xf7, 0xde, 0x1c, 0x8b, 0x6c, 0x52, 0x61, 0x91, 0x0d, 0x22,
+	0x02, 0xf7, 0xd3, 0x79, 0x85, 0xca, 0x75, 0x95, 0xda, 0x1f, 0x54, 0xd5, 0x36, 0x92, 0xc7, 0x2d,
+	0x3c, 0x5f, 0x13, 0xfa, 0x15, 0x0c, 0xd2, 0xca, 0xa1, 0xee, 0xde, 0x57, 0xda, 0x1f, 0x56, 0xb5,
+	0xdb, 0x9c, 0x71, 0x0b, 0xd7, 0xe6, 0xe5, 0x1e, 0x28, 0x25, 0xa5, 0xbb, 0xd9, 0xec, 0x81, 0x6a,
+	0xe6, 0xd6, 0x67, 0xe6, 0x29, 0x52, 0x54, 0xc6, 0x07, 0xcd, 0x29, 0x62, 0x9d, 0x4a, 0x25, 0x3e,
+	0xfa, 0x2d, 0x0c, 0x43, 0xad, 0xea, 0x8c, 0x62, 0x75, 0xb9, 0x8f, 0x92, 0xc9, 0xab, 0x2c, 0x09,
+	0xdd, 0x47, 0x4a, 0x93, 0x67, 0x6b, 0x3a, 0x6c, 0x64, 0x8e, 0x5b, 0x78, 0x8e, 0x0e, 0xa9, 0x3d,
+	0x88, 0xfd, 0x68, 0xfa, 0x8a, 0xd1, 0x69, 0x59, 0xfb, 0xe3, 0xba, 0xf6, 0x83, 0x46, 0xa6, 0xd4,
+	0xde, 0xac, 0x03, 0xfd, 0x14, 0x96, 0x27, 0xcc, 0x4f, 0x84, 0x46, 0xdd, 0x2d, 0xa5, 0xf2, 0x9e,
+	0xad, 0xf2, 0xf5, 0x6c, 0x78, 0xdc, 0xc2, 0x36, 0x5b, 0x25, 0xb3, 0xfd, 0xe6, 0xe0, 0xee, 0x36,
+	0x24, 0xb3, 0x4d, 0x50, 0xc9, 0x6c, 0x03, 0xe8, 0x09, 0xf4, 0xce, 0x55, 0xa1, 0x73, 0x7f, 0xa6,
+	0xe6, 0x22, 0x7b, 0xae, 0x2e, 0x81, 0xe3, 0x16, 0x36, 0x1c, 0xb9, 0xe0, 0xd4, 0x7e, 0xa7, 0x72,
+	0x7f, 0x5e, 0x5f, 0xb0, 0xf4, 0x90, 0x25, 0x17, 0x2c, 0xcd, 0xd8, 0x5f, 0x84, 0x9e, 0x2e, 0x94,
+	0xde, 0x9f, 0x1d, 0xe8, 0xe9, 0x3a, 0x89, 0x76, 0xa0, 0x1b, 0x50, 0x46, 0xcc, 0x6b, 0xd5, 0xbc,
+	0x0a, 0xab, 0x38, 0xb2, 0xe4, 0x73, 0x92, 0x84, 0x84, 0x9d, 0xe8, 0x87, 0x24, 0x53, 0xf2, 0x6d,
+	0x4c, 0x16, 0x77, 0x1e, 0x4d, 0x12, 0x5f, 0x64, 0x8c, 0x98, 0xae, 0x6c, 0x06, 0xc8, 0x3b, 0x3f,
+	0x11, 0x97, 0x07, 0xe6, 0x9e, 0xdb, 0x55, 0x45, 0xd9, 0x42, 0xbc, 0x7f, 0xb7, 0xe1, 0x0e, 0x26,
+	0x01, 0x89, 0x52, 0xf5, 0x36, 0xc1, 0x88, 0xc8, 0x58, 0xf2, 0x85, 0xba, 0x68, 0x39, 0x4a, 0x97,
+	0x0d, 0xc9, 0xd6, 0x84, 0x0b, 0x5f, 0x64, 0x3c, 0xef, 0x77, 0xb4, 0xa4, 0x9e, 0xc6, 0x02, 0x31,
+	0xf6, 0xf9, 0x65, 0xfe, 0xda, 0x67, 0x44, 0xa9, 0x73, 0xe2, 0xf3, 0x03, 0x9a, 0xf0, 0x6c, 0x4a,
+	0xc2, 0xfc, 0xbd, 0xc3, 0x82, 0x64, 0xb7, 0x95, 0xbf, 0xd9, 0xe4, 0xdd, 0xd6, 0x82, 0xee, 0xb6,
+	0x2a, 0x30, 0xfa, 0x10, 0xba, 0x31, 0x9d, 0xc8, 0xb7, 0x2d, 0xd9, 0x9b, 0xac, 0xd9, 0x9e, 0x7b,
+	0x43, 0x27, 0x58, 0x0d, 0xa2, 0x5f, 0x00, 0x10, 0xc6, 0x28, 0x3b, 0x88, 0x7d, 0xce, 0xd5, 0x2d,
+	0x62, 0x75, 0xf7, 0x71, 0x63, 0x4d, 0x3e, 0x2a, 0x68, 0xd8, 0x9a, 0x22, 0x7d, 0xce, 0xc8, 0x15,
+	0x61, 0x02, 0x13, 0x9f, 0xd3, 0xc4, 0x5d, 0xd4, 0x0f, 0x83, 0x36, 0x86, 0x0e, 0x61, 0x2d, 0xef,
+	0x15, 0x74, 0x6e, 0x71, 0x77, 0x49, 0x19, 0xb5, 0xd9, 0xf8, 0x70, 0xa9, 0x28, 0xb8, 0x3a, 0xc5,
+	0xfb, 0x1d, 0xac, 0x96, 0x29, 0xe5, 0x46, 0xcd, 0xa9, 0xbe, 0x0c, 0xcc, 0xbb, 0xa9, 0xce, 0xa2,
+	0xd2, 0xb1, 0xa3, 0xe2, 0xfd, 0xc5, 0x81, 0xce, 0x1b, 0x3a, 0xb9, 0xe5, 0xe1, 0x72, 0x08, 0x3d,
+	0x41, 0xd3, 0x28, 0x90, 0xf1, 0xec, 0xc8, 0x27, 0x4a, 0x2d, 0x35, 0xbd, 0x9d, 0xc9, 0x48, 0x9e,
+	0xcb, 0x2a, 0xf5, 0x59, 0x36, 0x3d, 0x37, 0x2d, 0x7d, 0x17, 0xdb, 0x90, 0x5c, 0x47, 0x5c, 0x27,
+	0x2a, 0x0b, 0x74, 0xeb, 0x9e, 0x8b, 0xb3, 0x37, 0x8d, 0x9e, 0x4a, 0x40, 0x2d, 0x78, 0x87, 0x30,
+	0x6c, 0x3e, 0xa1, 0xe6, 0xbe, 0x9c, 0xe4, 0x76, 0xb5, 0xad, 0x37, 0xbd, 0x43, 0x18, 0x36, 0x9f,
+	0x44, 0xef, 0xa5, 0xe5, 0x39, 0x2c, 0x5b, 0x87, 0x8f, 0xfc, 0x48, 0x65, 0x0c, 0xd5, 0xc4, 0xd5,
+	0xf2, 0x47, 0xaa, 0x19, 0x67, 0x37, 0x29, 0xc1, 0x8a, 0xb3, 0xf3, 0x37, 0x07, 0x3e, 0x68, 0x48,
+	0x2a, 0xb4, 0x01, 0x83, 0x02, 0xfe, 0x8c, 0xaa, 0x81, 0x41, 0x0b, 0xdd, 0x85, 0xf5, 0x02, 0xc5,
+	0x2a, 0xa7, 0x48, 0x38, 0x70, 0x4a, 0xf0, 0x71, 0x26, 0x8e, 0x2f, 0x5e, 0xfb, 0x7c, 0xd0, 0x46,
+	0x9b, 0x30, 0x2c, 0xe0, 0x4f, 0x13, 0xd5, 0xdb, 0x1e, 0xa7, 0x01, 0x0d, 0xc9, 0xa0, 0x83, 0xb6,
+	0xe0, 0xa1, 0x35, 0xc6, 0xb3, 0x8b, 0x8b, 0x28, 0x90, 0x79, 0xb2, 0xef, 0xc7, 0x7e, 0x12, 0x90,
+	0x41, 0x17, 0xdd, 0xb3, 0x0c, 0x3b, 0x16, 0x97, 0x84, 0x69, 0x23, 0x16, 0x76, 0x46, 0x00, 0xb3,
+	0x6d, 0xa0, 0x35, 0x58, 0x56, 0xfd, 0x87, 0x86, 0x06, 0x2d, 0x09, 0x1c, 0xa5, 0x34, 0xb8, 0x34,
+	0x80, 0xb3, 0xbf, 0xf7, 0xe5, 0xb3, 0x49, 0x24, 0x2e, 0xb3, 0xf3, 0x51, 0x40, 0xa7, 0x4f, 0x95,
+	0x33, 0x52, 0x46, 0xff, 0x40, 0x02, 0xa1, 0x85, 0x1f, 0xc9, 0xd3, 0x4a, 0xff, 0x9d, 0x30, 0x21,
+	0xc9, 0xd3, 0x99, 0xb7, 0xce, 0x7b, 0x0a, 0xfc, 0xc9, 0x7f, 0x07, 0x00, 0xe7, 0xc7, 0x78, 0xa3,
+	0x8d, 0x18, 0x00, 0x00,
 }
@@ -85,6 +85,7 @@ type BlockHeaderCore struct {
 	TxRoot               []byte               `protobuf:"bytes,5,opt,name=txRoot,proto3" json:"txRoot,omitempty"`
 	DeltaStateDigest     []byte               `protobuf:"bytes,6,opt,name=deltaStateDigest,proto3" json:"deltaStateDigest,omitempty"`
 	ReceiptRoot          []byte               `protobuf:"bytes,7,opt,name=receiptRoot,proto3" json:"receiptRoot,omitempty"`
+	LogsBloom            []byte               `protobuf:"bytes,8,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
 	XXX_unrecognized     []byte               `json:"-"`
 	XXX_sizecache        int32                `json:"-"`
@@ -164,6 +165,13 @@ func (m *BlockHeaderCore) GetReceiptRoot() []byte {
 	return nil
 }
 
+func (m *BlockHeaderCore) GetLogsBloom() []byte {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return nil
+}
+
 // footer of a block
 type BlockFooter struct {
 	CommitTimestamp      int64           `protobuf:"varint,1,opt,name=CommitTimestamp,proto3" json:"CommitTimestamp,omitempty"`
@@ -431,6 +439,7 @@ type BlockMeta struct {
 	TxRoot               string   `protobuf:"bytes,7,opt,name=txRoot,proto3" json:"txRoot,omitempty"`
 	ReceiptRoot          string   `protobuf:"bytes,8,opt,name=receiptRoot,proto3" json:"receiptRoot,omitempty"`
 	DeltaStateDigest     string   `protobuf:"bytes,9,opt,name=deltaStateDigest,proto3" json:"deltaStateDigest,omitempty"`
+	LogsBloom            string   `protobuf:"bytes,10,opt,name=logsBloom,proto3" json:"logsBloom,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -524,6 +533,13 @@ func (m *BlockMeta) GetDeltaStateDigest() string {
 	return ""
 }
 
+func (m *BlockMeta) GetLogsBloom() string {
+	if m != nil {
+		return m.LogsBloom
+	}
+	return ""
+}
+
 // Account Metadata
 type AccountMeta struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
@@ -603,49 +619,50 @@ func init() {
 func init() { proto.RegisterFile("proto/types/blockchain.proto", fileDescriptor_0e828f5966a7c29d) }
 
 var fileDescriptor_0e828f5966a7c29d = []byte{
-	// 696 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x95, 0x9b, 0xa4, 0xa9, 0x27, 0x29, 0x2d, 0xcb, 0x97, 0x55, 0x2a, 0xa8, 0x2c, 0x84, 0x22,
-	0x3e, 0x62, 0x29, 0x08, 0x54, 0x09, 0x09, 0x29, 0xfd, 0x40, 0x5c, 0x40, 0x68, 0xcb, 0x89, 0xdb,
-	0xc6, 0x99, 0x3a, 0xa6, 0xf1, 0xae, 0xb5, 0x5e, 0x97, 0x56, 0xdc, 0x10, 0x17, 0x7e, 0x01, 0xbf,
-	0x82, 0xff, 0x88, 0x76, 0x6c, 0x27, 0x8e, 0x43, 0x6f, 0x9e, 0x37, 0x6f, 0x77, 0x66, 0xdf, 0xbc,
-	0x31, 0xec, 0xa7, 0x5a, 0x19, 0x15, 0x98, 0xeb, 0x14, 0xb3, 0x60, 0x32, 0x57, 0xe1, 0x45, 0x38,
-	0x13, 0xb1, 0x1c, 0x12, 0xcc, 0x20, 0x56, 0x06, 0xaf, 0x28, 0xb9, 0xd7, 0x17, 0xa1, 0x89, 0x55,
-	0x99, 0xd9, 0xbb, 0x8d, 0x72, 0xaa, 0x74, 0x86, 0x09, 0x4a, 0x53, 0x42, 0x8f, 0x23, 0xa5, 0xa2,
-	0x39, 0x06, 0x14, 0x4d, 0xf2, 0xf3, 0xc0, 0xc4, 0x09, 0x66, 0x46, 0x24, 0x69, 0x41, 0xf0, 0x7f,
-	0x39, 0xd0, 0x3b, 0xb2, 0x25, 0x3e, 0xa0, 0x98, 0xa2, 0x66, 0x01, 0xb4, 0x43, 0xa5, 0xd1, 0x73,
-	0x0e, 0x9c, 0x41, 0x6f, 0xf4, 0x70, 0xb8, 0x2c, 0x36, 0xac, 0xd1, 0x8e, 0x95, 0x46, 0x4e, 0x44,
-	0xf6, 0x14, 0x6e, 0xa5, 0x5a, 0x4d, 0xf3, 0x10, 0xf5, 0xe7, 0x7c, 0x72, 0x81, 0xd7, 0xde, 0xc6,
-	0x81, 0x33, 0xe8, 0xf3, 0x06, 0xca, 0xf6, 0xc1, 0xcd, 0xe2, 0x48, 0x0a, 0x93, 0x6b, 0xf4, 0x5a,
-	0x44, 0x59, 0x02, 0xfe, 0xef, 0x0d, 0xd8, 0x69, 0xdc, 0xcf, 0x3c, 0xe8, 0x5e, 0xa2, 0xce, 0x62,
-	0x25, 0xa9, 0x9b, 0x6d, 0x5e, 0x85, 0xec, 0x3e, 0x6c, 0xce, 0x30, 0x8e, 0x66, 0x86, 0x6a, 0xb5,
-	0x79, 0x19, 0xb1, 0x43, 0x70, 0x17, 0xef, 0xa3, 0x1a, 0xbd, 0xd1, 0xde, 0xb0, 0x50, 0x60, 0x58,
-	0x29, 0x30, 0xfc, 0x52, 0x31, 0xf8, 0x92, 0xcc, 0x9e, 0xc0, 0x76, 0xaa, 0xf1, 0xb2, 0x68, 0x41,
-	0x64, 0x33, 0xaf, 0x4d, 0x1d, 0xae, 0x82, 0xb6, 0xae, 0xb9, 0xe2, 0x4a, 0x19, 0xaf, 0x43, 0xe9,
-	0x32, 0x62, 0xcf, 0x60, 0x77, 0x8a, 0x73, 0x23, 0xce, 0x8c, 0x30, 0x78, 0x12, 0x47, 0x98, 0x19,
-	0x6f, 0x93, 0x18, 0x6b, 0x38, 0x3b, 0x80, 0x9e, 0xc6, 0x10, 0xe3, 0xd4, 0xd0, 0x45, 0x5d, 0xa2,
-	0xd5, 0x21, 0xff, 0x7b, 0x39, 0x91, 0xf7, 0x4a, 0x19, 0xd4, 0x6c, 0x00, 0x3b, 0xc7, 0x2a, 0x49,
-	0x62, 0xb3, 0x68, 0x9c, 0xe4, 0x68, 0xf1, 0x26, 0xcc, 0xde, 0x41, 0xbf, 0xe6, 0x80, 0x8c, 0xc4,
-	0xb1, 0x0a, 0xd4, 0x66, 0x78, 0xba, 0xcc, 0x9f, 0xa1, 0xe1, 0x2b, 0x7c, 0xff, 0x8f, 0x03, 0x1d,
-	0xaa, 0xcc, 0x02, 0x2b, 0xb0, 0x1d, 0x44, 0xe9, 0x83, 0x07, 0x37, 0xf8, 0x80, 0x97, 0x34, 0xf6,
-	0x02, 0xba, 0x85, 0x15, 0x6d, 0xd5, 0xd6, 0xa0, 0x37, 0x62, 0xf5, 0x13, 0x63, 0x4a, 0xf1, 0x8a,
-	0x62, 0xaf, 0x3f, 0xa7, 0xc7, 0x95, 0x43, 0x5a, 0xbf, 0xbe, 0x78, 0x3b, 0x2f, 0x69, 0xfe, 0x5b,
-	0xd8, 0xe2, 0x85, 0x42, 0xf6, 0xf0, 0x56, 0xa9, 0x56, 0xe6, 0x39, 0x54, 0xeb, 0x4e, 0xfd, 0x78,
-	0xc9, 0xe3, 0x0b, 0x92, 0xff, 0x1a, 0xdc, 0xd3, 0x54, 0x85, 0xb3, 0x13, 0x61, 0x04, 0xdb, 0x85,
-	0x96, 0xcc, 0x13, 0x7a, 0x56, 0x9b, 0xdb, 0xcf, 0x9b, 0xcc, 0xe4, 0xff, 0x74, 0xc0, 0x3d, 0xb6,
-	0x7b, 0xf7, 0x11, 0x8d, 0xa8, 0xb1, 0x9c, 0x15, 0xcb, 0x3d, 0x02, 0x90, 0x79, 0x32, 0x5e, 0xbc,
-	0xdd, 0x0e, 0xa6, 0x86, 0xd8, 0x7a, 0x26, 0xcd, 0xe8, 0x9d, 0x2d, 0x6e, 0x3f, 0xd9, 0x73, 0xe8,
-	0xa0, 0x6d, 0x87, 0x2c, 0xd6, 0x1b, 0xdd, 0x5b, 0x19, 0x4f, 0xd5, 0x27, 0x2f, 0x38, 0xfe, 0xdf,
-	0x0d, 0x70, 0x49, 0x10, 0x6a, 0x82, 0x41, 0x7b, 0x66, 0xcd, 0x69, 0x5b, 0x70, 0x39, 0x7d, 0xdf,
-	0xb8, 0x0b, 0xfb, 0xcd, 0x5d, 0x68, 0xd5, 0xfd, 0xbe, 0xda, 0x76, 0x7b, 0xad, 0xed, 0x01, 0xec,
-	0x54, 0xfb, 0x3b, 0x9e, 0x4e, 0x35, 0x66, 0x19, 0x59, 0xde, 0xe5, 0x4d, 0xd8, 0xee, 0xbf, 0xd1,
-	0x42, 0x66, 0xe7, 0xa8, 0xc7, 0x89, 0xca, 0x65, 0xe1, 0x7c, 0x97, 0x37, 0xd0, 0xda, 0xee, 0x74,
-	0x29, 0x5f, 0xed, 0x4e, 0x63, 0x1f, 0xb6, 0x28, 0x59, 0x87, 0xfe, 0xbb, 0x5d, 0x2e, 0xd1, 0xd6,
-	0x70, 0xff, 0x07, 0xf4, 0xc6, 0x61, 0x68, 0x0b, 0x92, 0x60, 0x1e, 0x74, 0x45, 0xd9, 0x7e, 0xa1,
-	0x59, 0x15, 0xda, 0xcc, 0x44, 0xcc, 0x85, 0x0c, 0x91, 0x74, 0x73, 0x79, 0x15, 0xb2, 0xbb, 0xd0,
-	0x91, 0xca, 0xe2, 0x2d, 0xd2, 0xb3, 0x08, 0x98, 0x0f, 0xfd, 0x14, 0xe5, 0x34, 0x96, 0xd1, 0x27,
-	0x4a, 0xb6, 0x29, 0xb9, 0x82, 0x1d, 0x1d, 0x7e, 0x7d, 0x13, 0xc5, 0x66, 0x96, 0x4f, 0x86, 0xa1,
-	0x4a, 0x02, 0x1a, 0x6b, 0xaa, 0xd5, 0x37, 0x0c, 0x4d, 0x11, 0xbc, 0xb4, 0x3f, 0xcc, 0xe2, 0x57,
-	0x1c, 0xa1, 0x0c, 0x96, 0x73, 0x9f, 0x6c, 0x12, 0xf8, 0xea, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xef, 0xb0, 0x8a, 0x90, 0xfa, 0x05, 0x00, 0x00,
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xdb, 0x6e, 0xd3, 0x40,
+	0x10, 0x95, 0xe3, 0xa4, 0x89, 0x27, 0x29, 0x2d, 0xcb, 0xcd, 0x2a, 0x15, 0x54, 0x16, 0x42, 0x11,
+	0x97, 0x58, 0x0a, 0x02, 0x55, 0x42, 0x42, 0x4a, 0x2f, 0x88, 0x17, 0x10, 0xda, 0xf2, 0xc4, 0xdb,
+	0xc6, 0x99, 0x3a, 0xa6, 0xb1, 0xd7, 0x5a, 0xaf, 0x4b, 0x2b, 0xde, 0x10, 0xff, 0xc0, 0x27, 0xf0,
+	0x31, 0xfc, 0x14, 0xda, 0xb1, 0x9d, 0x38, 0x2e, 0x79, 0xf3, 0x9c, 0x39, 0xde, 0x99, 0x39, 0x3b,
+	0x67, 0x61, 0x3f, 0x55, 0x52, 0x4b, 0x5f, 0x5f, 0xa7, 0x98, 0xf9, 0xd3, 0x85, 0x0c, 0x2e, 0x82,
+	0xb9, 0x88, 0x92, 0x11, 0xc1, 0x0c, 0x22, 0xa9, 0xf1, 0x8a, 0x92, 0x7b, 0x03, 0x11, 0xe8, 0x48,
+	0x96, 0x99, 0xbd, 0xdb, 0x98, 0xcc, 0xa4, 0xca, 0x30, 0xc6, 0x44, 0x97, 0xd0, 0xe3, 0x50, 0xca,
+	0x70, 0x81, 0x3e, 0x45, 0xd3, 0xfc, 0xdc, 0xd7, 0x51, 0x8c, 0x99, 0x16, 0x71, 0x5a, 0x10, 0xbc,
+	0x5f, 0x16, 0xf4, 0x8f, 0x4c, 0x89, 0x0f, 0x28, 0x66, 0xa8, 0x98, 0x0f, 0xed, 0x40, 0x2a, 0x74,
+	0xad, 0x03, 0x6b, 0xd8, 0x1f, 0x3f, 0x1c, 0xad, 0x8a, 0x8d, 0x6a, 0xb4, 0x63, 0xa9, 0x90, 0x13,
+	0x91, 0x3d, 0x85, 0x5b, 0xa9, 0x92, 0xb3, 0x3c, 0x40, 0xf5, 0x39, 0x9f, 0x5e, 0xe0, 0xb5, 0xdb,
+	0x3a, 0xb0, 0x86, 0x03, 0xde, 0x40, 0xd9, 0x3e, 0x38, 0x59, 0x14, 0x26, 0x42, 0xe7, 0x0a, 0x5d,
+	0x9b, 0x28, 0x2b, 0xc0, 0xfb, 0xd3, 0x82, 0x9d, 0xc6, 0xf9, 0xcc, 0x85, 0xee, 0x25, 0xaa, 0x2c,
+	0x92, 0x09, 0x75, 0xb3, 0xcd, 0xab, 0x90, 0xdd, 0x87, 0xad, 0x39, 0x46, 0xe1, 0x5c, 0x53, 0xad,
+	0x36, 0x2f, 0x23, 0x76, 0x08, 0xce, 0x72, 0x3e, 0xaa, 0xd1, 0x1f, 0xef, 0x8d, 0x0a, 0x05, 0x46,
+	0x95, 0x02, 0xa3, 0x2f, 0x15, 0x83, 0xaf, 0xc8, 0xec, 0x09, 0x6c, 0xa7, 0x0a, 0x2f, 0x8b, 0x16,
+	0x44, 0x36, 0x77, 0xdb, 0xd4, 0xe1, 0x3a, 0x68, 0xea, 0xea, 0x2b, 0x2e, 0xa5, 0x76, 0x3b, 0x94,
+	0x2e, 0x23, 0xf6, 0x0c, 0x76, 0x67, 0xb8, 0xd0, 0xe2, 0x4c, 0x0b, 0x8d, 0x27, 0x51, 0x88, 0x99,
+	0x76, 0xb7, 0x88, 0x71, 0x03, 0x67, 0x07, 0xd0, 0x57, 0x18, 0x60, 0x94, 0x6a, 0x3a, 0xa8, 0x4b,
+	0xb4, 0x3a, 0x64, 0x94, 0x5a, 0xc8, 0x30, 0x3b, 0x5a, 0x48, 0x19, 0xbb, 0xbd, 0x42, 0xa9, 0x25,
+	0xe0, 0x7d, 0x2f, 0xef, 0xeb, 0xbd, 0x94, 0x1a, 0x15, 0x1b, 0xc2, 0xce, 0xb1, 0x8c, 0xe3, 0x48,
+	0x2f, 0xc7, 0x22, 0xb1, 0x6c, 0xde, 0x84, 0xd9, 0x3b, 0x18, 0xd4, 0xf6, 0x23, 0x73, 0x5b, 0xa5,
+	0x3e, 0xb5, 0x1b, 0x3e, 0x5d, 0xe5, 0xcf, 0x50, 0xf3, 0x35, 0xbe, 0xf7, 0xdb, 0x82, 0x0e, 0x55,
+	0x66, 0xbe, 0x91, 0xdf, 0x5c, 0x53, 0xb9, 0x25, 0x0f, 0x36, 0x6c, 0x09, 0x2f, 0x69, 0xec, 0x05,
+	0x74, 0x8b, 0x45, 0x35, 0x55, 0xed, 0x61, 0x7f, 0xcc, 0xea, 0x7f, 0x4c, 0x28, 0xc5, 0x2b, 0x8a,
+	0x39, 0xfe, 0x9c, 0x86, 0x73, 0xed, 0x0d, 0xc7, 0x17, 0xb3, 0xf3, 0x92, 0xe6, 0xbd, 0x85, 0x1e,
+	0x2f, 0xf4, 0x33, 0x3f, 0xf7, 0x4a, 0x2d, 0x33, 0xd7, 0xa2, 0x5a, 0x77, 0xea, 0xbf, 0x97, 0x3c,
+	0xbe, 0x24, 0x79, 0xaf, 0xc1, 0x39, 0x4d, 0x65, 0x30, 0x3f, 0x11, 0x5a, 0xb0, 0x5d, 0xb0, 0x93,
+	0x3c, 0xa6, 0xb1, 0xda, 0xdc, 0x7c, 0x6e, 0x5a, 0x35, 0xef, 0xa7, 0x05, 0xce, 0xb1, 0x71, 0xe5,
+	0x47, 0xd4, 0xa2, 0xc6, 0xb2, 0xd6, 0x16, 0xf2, 0x11, 0x40, 0x92, 0xc7, 0x93, 0xe5, 0xec, 0xe6,
+	0x62, 0x6a, 0x88, 0xa9, 0xa7, 0xd3, 0x8c, 0xe6, 0xb4, 0xb9, 0xf9, 0x64, 0xcf, 0xa1, 0x83, 0xa6,
+	0x1d, 0x5a, 0xc0, 0xfe, 0xf8, 0xde, 0xda, 0xf5, 0x54, 0x7d, 0xf2, 0x82, 0xe3, 0xfd, 0x6d, 0x81,
+	0x43, 0x82, 0x50, 0x13, 0x0c, 0xda, 0x73, 0xb3, 0xba, 0xa6, 0x05, 0x87, 0xd3, 0xf7, 0x46, 0xa7,
+	0xec, 0x37, 0x9d, 0x62, 0xd7, 0xdd, 0xb0, 0xde, 0x76, 0xfb, 0x46, 0xdb, 0x43, 0xd8, 0xa9, 0xdc,
+	0x3d, 0x99, 0xcd, 0x14, 0x66, 0x19, 0x19, 0xc2, 0xe1, 0x4d, 0xd8, 0xbc, 0x0e, 0x5a, 0x89, 0x24,
+	0x3b, 0x47, 0x35, 0x89, 0x65, 0x9e, 0x14, 0xbe, 0x70, 0x78, 0x03, 0xad, 0x39, 0xab, 0x4b, 0xf9,
+	0x32, 0x6a, 0xba, 0xa5, 0x47, 0xc9, 0x3a, 0xf4, 0x5f, 0xef, 0x39, 0x44, 0xbb, 0x81, 0xaf, 0x3b,
+	0x0b, 0x88, 0x54, 0x73, 0xd6, 0x0f, 0xe8, 0x4f, 0x82, 0xc0, 0xb4, 0x43, 0x72, 0xba, 0xd0, 0x15,
+	0xe5, 0x70, 0x85, 0xa2, 0x55, 0x68, 0x32, 0x53, 0xb1, 0x10, 0x49, 0x80, 0xa4, 0xaa, 0xc3, 0xab,
+	0x90, 0xdd, 0x85, 0x4e, 0x22, 0x0d, 0x6e, 0x93, 0xda, 0x45, 0xc0, 0x3c, 0x18, 0xa4, 0x98, 0xcc,
+	0xa2, 0x24, 0xfc, 0x44, 0xc9, 0x36, 0x25, 0xd7, 0xb0, 0xa3, 0xc3, 0xaf, 0x6f, 0xc2, 0x48, 0xcf,
+	0xf3, 0xe9, 0x28, 0x90, 0xb1, 0x4f, 0x97, 0x9e, 0x2a, 0xf9, 0x0d, 0x03, 0x5d, 0x04, 0x2f, 0xcd,
+	0x63, 0x5b, 0x3c, 0xe3, 0x21, 0x26, 0xfe, 0x6a, 0x2b, 0xa6, 0x5b, 0x04, 0xbe, 0xfa, 0x37, 0x00,
+	0x0a, 0x3e, 0xe2, 0x3a, 0x36, 0x06, 0x00, 0x00,
 }
@@ -0,0 +1,74 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package itx
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+// BackupHandler is the admin http handler to back up the databases of a chain while the node keeps running. It takes
+// a POST request with the query parameters dir, the directory to write the backup into, and optionally chainID,
+// which is the root chain by default, and responds with the manifest of the backup. The dbs kept in memory cannot be
+// backed up, for which it responds with 501
+type BackupHandler struct {
+	s *Server
+}
+
+// NewBackupHandler instantiates a BackupHandler instance
+func NewBackupHandler(s *Server) *BackupHandler {
+	return &BackupHandler{s: s}
+}
+
+// ServeHTTP backs up the databases of the chain
+func (h *BackupHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "backup only accepts POST", http.StatusMethodNotAllowed)
+		return
+	}
+	dir := r.URL.Query().Get("dir")
+	if dir == "" {
+		http.Error(w, "backup directory is not given", http.StatusBadRequest)
+		return
+	}
+	chainID := h.s.cfg.Chain.ID
+	if id := r.URL.Query().Get("chainID"); id != "" {
+		parsed, err := strconv.ParseUint(id, 10, 32)
+		if err != nil {
+			http.Error(w, "invalid chain ID "+id, http.StatusBadRequest)
+			return
+		}
+		chainID = uint32(parsed)
+	}
+	cs := h.s.ChainService(chainID)
+	if cs == nil {
+		http.Error(w, "chain ID does not match any existing chains", http.StatusNotFound)
+		return
+	}
+
+	manifest, err := cs.Backup(dir)
+	if err != nil {
+		log.L().Error("Failed to back up chain.", zap.Uint32("chainID", chainID), zap.Error(err))
+		code := http.StatusInternalServerError
+		if errors.Cause(err) == db.ErrBackupNotSupported {
+			code = http.StatusNotImplemented
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(manifest); err != nil {
+		log.L().Error("Failed to write backup manifest.", zap.Error(err))
+	}
+}
@@ -249,6 +249,7 @@ func StartServer(ctx context.Context, svr *Server, probeSvr *probe.Server, cfg c
 		mux := http.NewServeMux()
 		log.RegisterLevelConfigMux(mux)
 		mux.Handle("/debug/pprof", http.HandlerFunc(pprof.Index))
+		mux.Handle("/backup", NewBackupHandler(svr))
 
 		port := fmt.Sprintf(":%d", cfg.System.HTTPAdminPort)
 		adminserv = http.Server{Addr: port, Handler: mux}
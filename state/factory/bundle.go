@@ -0,0 +1,86 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+)
+
+// bundleRunner runs actions on pending changes which can be reverted to a snapshot
+type bundleRunner interface {
+	RunAction(protocol.RunActionsCtx, action.SealedEnvelope) (*action.Receipt, error)
+	Snapshot() int
+	Revert(int) error
+}
+
+// runBundle runs the actions in the bundle one by one under a snapshot, and reverts all of them if any one of them
+// returns an error or a failed execution receipt. The receipt of the bundle sums up the ones of the actions, with the
+// logs carrying the hash of the bundle, as the actions in it are not on chain by themselves. The return value and the
+// contract address are the ones of the last action which has them
+func runBundle(
+	r bundleRunner,
+	raCtx protocol.RunActionsCtx,
+	selp action.SealedEnvelope,
+	bundle *action.Bundle,
+) (*action.Receipt, error) {
+	if raCtx.BlockHeight < raCtx.BundleHeight {
+		return nil, errors.Wrapf(action.ErrBundle, "bundle %x is not enabled yet", selp.Hash())
+	}
+	snapshot := r.Snapshot()
+	revert := func(cause error) error {
+		if err := r.Revert(snapshot); err != nil {
+			return errors.Wrapf(err, "failed to revert bundle %x", selp.Hash())
+		}
+		return cause
+	}
+	bundleReceipt := &action.Receipt{
+		Status:  action.SuccessReceiptStatus,
+		ActHash: selp.Hash(),
+		Logs:    []*action.Log{},
+	}
+	for _, act := range bundle.Actions() {
+		receipt, err := r.RunAction(raCtx, act)
+		if err != nil {
+			return nil, revert(errors.Wrapf(action.ErrBundleReverted, "action %d failed: %v", act.Nonce(), err))
+		}
+		if receipt == nil {
+			continue
+		}
+		if hasStatus(act) && receipt.Status != action.SuccessReceiptStatus {
+			return nil, revert(errors.Wrapf(
+				action.ErrBundleReverted,
+				"action %d failed with error class %d: %s",
+				act.Nonce(),
+				receipt.ErrorClass,
+				receipt.RevertReason,
+			))
+		}
+		raCtx.GasLimit -= receipt.GasConsumed
+		bundleReceipt.GasConsumed += receipt.GasConsumed
+		if receipt.ReturnValue != nil {
+			bundleReceipt.ReturnValue = receipt.ReturnValue
+		}
+		if receipt.ContractAddress != "" {
+			bundleReceipt.ContractAddress = receipt.ContractAddress
+		}
+		for _, l := range receipt.Logs {
+			l.TxnHash = bundleReceipt.ActHash
+			bundleReceipt.Logs = append(bundleReceipt.Logs, l)
+		}
+		bundleReceipt.TransferResults = append(bundleReceipt.TransferResults, receipt.TransferResults...)
+	}
+	return bundleReceipt, nil
+}
+
+// hasStatus tells if the receipt of the action carries the status, as only executions may fail without an error
+func hasStatus(selp action.SealedEnvelope) bool {
+	_, ok := selp.Action().(*action.Execution)
+	return ok
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package factory
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/iotex-core/action"
+	"github.com/iotexproject/iotex-core/action/protocol"
+	"github.com/iotexproject/iotex-core/action/protocol/account"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/test/testaddress"
+	"github.com/iotexproject/iotex-core/testutil"
+)
+
+func TestRunBundle(t *testing.T) {
+	sf, err := NewFactory(config.Default, InMemTrieOption())
+	require.NoError(t, err)
+	sf.AddActionHandlers(account.NewProtocol())
+	ws, err := sf.NewWorkingSet()
+	require.NoError(t, err)
+	testRunBundle(ws, t)
+}
+
+func TestSTXRunBundle(t *testing.T) {
+	ws := newStateTX(0, db.NewMemKVStore(), []protocol.ActionHandler{account.NewProtocol()})
+	testRunBundle(ws, t)
+}
+
+func testRunBundle(ws WorkingSet, t *testing.T) {
+	require := require.New(t)
+	require.NoError(ws.GetDB().Start(context.Background()))
+	a := testaddress.Addrinfo["alfa"].String()
+	priKeyA := testaddress.Keyinfo["alfa"].PriKey
+	b := testaddress.Addrinfo["bravo"].String()
+	_, err := accountutil.LoadOrCreateAccount(ws, a, big.NewInt(100))
+	require.NoError(err)
+	_, err = accountutil.LoadOrCreateAccount(ws, b, big.NewInt(0))
+	require.NoError(err)
+
+	bundle := func(amounts ...int64) action.SealedEnvelope {
+		elps := make([]action.Envelope, 0, len(amounts))
+		for i, amount := range amounts {
+			tsf, err := testutil.SignedTransfer(b, priKeyA, uint64(i+1), big.NewInt(amount), nil, uint64(10000), big.NewInt(0))
+			require.NoError(err)
+			elps = append(elps, tsf.Envelope)
+		}
+		selp, err := testutil.SignedBundle(priKeyA, elps)
+		require.NoError(err)
+		return selp
+	}
+	balance := func(addr string) *big.Int {
+		acct, err := accountutil.LoadOrCreateAccount(ws, addr, big.NewInt(0))
+		require.NoError(err)
+		return acct.Balance
+	}
+	raCtx := protocol.RunActionsCtx{
+		Producer: testaddress.Addrinfo["producer"],
+		GasLimit: uint64(1000000),
+	}
+
+	// the bundle cannot run before it is enabled
+	_, err = ws.RunAction(protocol.RunActionsCtx{BlockHeight: 1, BundleHeight: 2}, bundle(30, 40, 20))
+	require.Equal(action.ErrBundle, errors.Cause(err))
+
+	// the last transfer exceeds the balance, so that none of the transfers takes effect
+	_, err = ws.RunAction(raCtx, bundle(30, 40, 50))
+	require.Equal(action.ErrBundleReverted, errors.Cause(err))
+	require.Equal(big.NewInt(100), balance(a))
+	require.Equal(big.NewInt(0), balance(b))
+
+	selp := bundle(30, 40, 20)
+	receipt, err := ws.RunAction(raCtx, selp)
+	require.NoError(err)
+	require.Equal(action.SuccessReceiptStatus, receipt.Status)
+	require.Equal(selp.Hash(), receipt.ActHash)
+	require.Equal(3*action.TransferBaseIntrinsicGas, receipt.GasConsumed)
+	require.Equal(big.NewInt(10), balance(a))
+	require.Equal(big.NewInt(90), balance(b))
+}
+
+type logRunner struct{}
+
+func (logRunner) RunAction(_ protocol.RunActionsCtx, selp action.SealedEnvelope) (*action.Receipt, error) {
+	tsf, ok := selp.Action().(*action.Transfer)
+	if !ok {
+		return nil, errors.New("not a transfer")
+	}
+	return &action.Receipt{
+		Status:      action.SuccessReceiptStatus,
+		ActHash:     selp.Hash(),
+		ReturnValue: tsf.Payload(),
+		Logs:        []*action.Log{{Address: tsf.Recipient(), TxnHash: selp.Hash()}},
+	}, nil
+}
+
+func (logRunner) Snapshot() int { return 0 }
+
+func (logRunner) Revert(int) error { return nil }
+
+func TestRunBundleLogs(t *testing.T) {
+	require := require.New(t)
+
+	priKey := testaddress.Keyinfo["alfa"].PriKey
+	b := testaddress.Addrinfo["bravo"].String()
+	elps := make([]action.Envelope, 0, 2)
+	for i, payload := range [][]byte{{1}, nil} {
+		tsf, err := testutil.SignedTransfer(b, priKey, uint64(i+1), big.NewInt(1), payload, uint64(10000), big.NewInt(0))
+		require.NoError(err)
+		elps = append(elps, tsf.Envelope)
+	}
+	selp, err := testutil.SignedBundle(priKey, elps)
+	require.NoError(err)
+	bundle, ok := selp.Action().(*action.Bundle)
+	require.True(ok)
+
+	receipt, err := runBundle(logRunner{}, protocol.RunActionsCtx{GasLimit: uint64(1000000)}, selp, bundle)
+	require.NoError(err)
+	require.Equal(2, len(receipt.Logs))
+	for _, l := range receipt.Logs {
+		require.Equal(selp.Hash(), l.TxnHash)
+	}
+	require.Equal([]byte{1}, receipt.ReturnValue)
+}
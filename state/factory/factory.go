@@ -17,6 +17,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/iotexproject/iotex-core/action/protocol"
+	accountutil "github.com/iotexproject/iotex-core/action/protocol/account/util"
 	"github.com/iotexproject/iotex-core/action/protocol/vote/candidatesutil"
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/config"
@@ -41,6 +42,29 @@ const (
 	CurrentHeightKey = "currentHeight"
 	// AccountTrieRootKey indicates the key of accountTrie root hash in underlying DB
 	AccountTrieRootKey = "accountTrieRoot"
+	// ArchiveStartHeightKey indicates the key of the height since which the history states are kept in underlying DB
+	ArchiveStartHeightKey = "archiveStartHeight"
+)
+
+var (
+	// trieNamespaces are the namespaces of trie.db, including the ones of the contracts named in package evm, which
+	// cannot be imported here as its tests import this package
+	trieNamespaces = []string{AccountKVNameSpace, CandidateKVNameSpace, "Code", "Contract", "Preimage"}
+	// trieSchema is the schema of trie.db kept by the state factory, where the migrations upgrading it are registered
+	// in order
+	trieSchema = db.NewSchema("trie", db.KeyLayoutMigration(trieNamespaces...))
+	// stateDBSchema is the schema of trie.db kept by the state DB, where the migrations upgrading it are registered
+	// in order
+	stateDBSchema = db.NewSchema("statedb", db.KeyLayoutMigration(trieNamespaces...))
+)
+
+var (
+	// ErrNotArchiveMode indicates the error that the history states are not kept by the state factory
+	ErrNotArchiveMode = errors.New("history state is only available in archive mode")
+	// ErrStatePruned indicates the error that the state at the height has been pruned
+	ErrStatePruned = errors.New("state at the height has been pruned")
+	// ErrNoTrie indicates the error that the state is not kept in a trie, so there is no proof of it
+	ErrNoTrie = errors.New("state is not kept in a trie")
 )
 
 type (
@@ -51,16 +75,21 @@ type (
 		Balance(string) (*big.Int, error)
 		Nonce(string) (uint64, error) // Note that Nonce starts with 1.
 		AccountState(string) (*state.Account, error)
+		AccountStateAtHeight(string, uint64) (*state.Account, error)
 		RootHash() hash.Hash256
 		RootHashByHeight(uint64) (hash.Hash256, error)
 		Height() (uint64, error)
 		NewWorkingSet() (WorkingSet, error)
+		NewWorkingSetAtHeight(uint64) (WorkingSet, error)
 		Commit(WorkingSet) error
 		// Candidate pool
 		CandidatesByHeight(uint64) ([]*state.Candidate, error)
 
 		State(hash.Hash160, interface{}) error
+		ProveState(hash.Hash160, func(db.KVStore, *state.Account) error) (hash.Hash256, [][]byte, error)
 		AddActionHandlers(...protocol.ActionHandler)
+		// TakeSnapshot takes a snapshot of the underlying DB, which stays unchanged by the commits afterwards
+		TakeSnapshot() (db.Snapshot, error)
 	}
 
 	// factory implements StateFactory interface, tracks changes to account/contract and batch-commits to DB
@@ -70,6 +99,7 @@ type (
 		currentChainHeight uint64
 		accountTrie        trie.Trie                // global state trie
 		dao                db.KVStore               // the underlying DB for account/contract storage
+		archiveMode        bool                     // keep the trie nodes of the history states
 		actionHandlers     []protocol.ActionHandler // the handlers to handle actions
 		timerFactory       *prometheustimer.TimerFactory
 	}
@@ -97,7 +127,7 @@ func DefaultTrieOption() Option {
 			return errors.New("Invalid empty trie db path")
 		}
 		cfg.DB.DbPath = dbPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
-		sf.dao = db.NewOnDiskDB(cfg.DB)
+		sf.dao = db.NewInstrumentedKVStore("trie", db.NewOnDiskDB(cfg.DB))
 		return nil
 	}
 }
@@ -122,6 +152,10 @@ func NewFactory(cfg config.Config, opts ...Option) (Factory, error) {
 			return nil, err
 		}
 	}
+	if cfg.Chain.EnableArchiveMode {
+		sf.archiveMode = true
+		sf.dao = db.NewArchiveKVStore(sf.dao)
+	}
 	dbForTrie, err := db.NewKVStoreForTrie(AccountKVNameSpace, sf.dao)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create db for trie")
@@ -153,6 +187,14 @@ func (sf *factory) Start(ctx context.Context) error {
 	if err := sf.dao.Start(ctx); err != nil {
 		return err
 	}
+	if err := openSchema(sf.dao, trieSchema); err != nil {
+		return err
+	}
+	if sf.archiveMode {
+		if err := sf.markArchiveStartHeight(); err != nil {
+			return err
+		}
+	}
 	return sf.lifecycle.OnStart(ctx)
 }
 
@@ -165,6 +207,13 @@ func (sf *factory) Stop(ctx context.Context) error {
 	return sf.lifecycle.OnStop(ctx)
 }
 
+// TakeSnapshot takes a snapshot of the underlying DB
+func (sf *factory) TakeSnapshot() (db.Snapshot, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+	return db.TakeSnapshot(sf.dao)
+}
+
 // AddActionHandlers adds action handlers to the state factory
 func (sf *factory) AddActionHandlers(actionHandlers ...protocol.ActionHandler) {
 	sf.mutex.Lock()
@@ -206,6 +255,19 @@ func (sf *factory) AccountState(addr string) (*state.Account, error) {
 	return sf.accountState(addr)
 }
 
+// AccountStateAtHeight returns the confirmed account state at a given height
+func (sf *factory) AccountStateAtHeight(addr string, height uint64) (*state.Account, error) {
+	ws, err := sf.NewWorkingSetAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	encodedAddr, err := address.FromString(addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "error when getting the pubkey hash")
+	}
+	return accountutil.LoadAccount(ws, hash.BytesToHash160(encodedAddr.Bytes()))
+}
+
 // RootHash returns the hash of the root node of the state trie
 func (sf *factory) RootHash() hash.Hash256 {
 	sf.mutex.RLock()
@@ -244,6 +306,38 @@ func (sf *factory) NewWorkingSet() (WorkingSet, error) {
 	return NewWorkingSet(sf.currentChainHeight, sf.dao, sf.rootHash(), sf.actionHandlers)
 }
 
+// NewWorkingSetAtHeight returns a working set on top of the state at a given height, which is only used to read the
+// history state and must not be committed
+func (sf *factory) NewWorkingSetAtHeight(height uint64) (WorkingSet, error) {
+	currentHeight, err := sf.Height()
+	if err != nil {
+		return nil, err
+	}
+	if height == currentHeight {
+		return sf.NewWorkingSet()
+	}
+	if height > currentHeight {
+		return nil, errors.Errorf("height %d is higher than current height %d", height, currentHeight)
+	}
+	if !sf.archiveMode {
+		return nil, errors.Wrapf(ErrNotArchiveMode, "failed to get state at height %d", height)
+	}
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+	startHeight, err := sf.dao.Get(AccountKVNameSpace, []byte(ArchiveStartHeightKey))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get archive start height from underlying DB")
+	}
+	if height < byteutil.BytesToUint64(startHeight) {
+		return nil, errors.Wrapf(ErrStatePruned, "failed to get state at height %d", height)
+	}
+	data, err := sf.dao.Get(AccountKVNameSpace, []byte(fmt.Sprintf("%s-%d", AccountTrieRootKey, height)))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get root hash at height %d", height)
+	}
+	return NewWorkingSet(height, sf.dao, hash.BytesToHash256(data), sf.actionHandlers)
+}
+
 // Commit persists all changes in RunActions() into the DB
 func (sf *factory) Commit(ws WorkingSet) error {
 	if ws == nil {
@@ -311,10 +405,89 @@ func (sf *factory) State(addr hash.Hash160, state interface{}) error {
 	return sf.state(addr, state)
 }
 
+// ProveState returns the root hash of the state trie and the proof of a confirmed state against it. If prove is given,
+// it is called with the trie db and the state under the same lock, so that the proofs of the data kept under the state,
+// like the storage of a contract, are against the same root.
+func (sf *factory) ProveState(
+	addr hash.Hash160,
+	prove func(db.KVStore, *state.Account) error,
+) (hash.Hash256, [][]byte, error) {
+	sf.mutex.RLock()
+	defer sf.mutex.RUnlock()
+
+	proof, err := sf.accountTrie.Prove(addr[:])
+	if err != nil {
+		if errors.Cause(err) == trie.ErrNotExist {
+			return hash.ZeroHash256, nil, errors.Wrapf(state.ErrStateNotExist, "state of %x doesn't exist", addr)
+		}
+		return hash.ZeroHash256, nil, errors.Wrapf(err, "error when proving the state of %x", addr)
+	}
+	if prove != nil {
+		var account state.Account
+		if err := sf.state(addr, &account); err != nil {
+			return hash.ZeroHash256, nil, errors.Wrapf(err, "error when getting the state of %x", addr)
+		}
+		if err := prove(sf.dao, &account); err != nil {
+			return hash.ZeroHash256, nil, err
+		}
+	}
+	return sf.rootHash(), proof, nil
+}
+
+// MigrateTrieDB migrates the trie db at cfg.Chain.TrieDBPath to the latest schema version, of the state DB if
+// cfg.Chain.EnableTrielessStateDB is set, otherwise of the state factory
+func MigrateTrieDB(ctx context.Context, cfg config.Config, dryRun bool) (err error) {
+	cfg.DB.DbPath = cfg.Chain.TrieDBPath
+	kvstore := db.NewOnDiskDB(cfg.DB)
+	if err := kvstore.Start(ctx); err != nil {
+		return errors.Wrap(err, "failed to open trie db")
+	}
+	defer func() {
+		if stopErr := kvstore.Stop(ctx); err == nil {
+			err = stopErr
+		}
+	}()
+	if cfg.Chain.EnableTrielessStateDB {
+		return stateDBSchema.Migrate(kvstore, dryRun)
+	}
+	return trieSchema.Migrate(kvstore, dryRun)
+}
+
 //======================================
 // private trie constructor functions
 //======================================
 
+// openSchema checks the schema of the trie db and migrates it, where the db without height is a fresh one
+func openSchema(kvstore db.KVStore, schema *db.Schema) error {
+	_, err := kvstore.Get(AccountKVNameSpace, []byte(CurrentHeightKey))
+	if err != nil && errors.Cause(err) != db.ErrNotExist {
+		return errors.Wrap(err, "failed to get factory's height from underlying DB")
+	}
+	if err := schema.Open(kvstore, err != nil); err != nil {
+		return errors.Wrap(err, "failed to open the schema of trie db")
+	}
+	return nil
+}
+
+// markArchiveStartHeight records the height since which the history states are kept, if it's not recorded yet
+func (sf *factory) markArchiveStartHeight() error {
+	if _, err := sf.dao.Get(AccountKVNameSpace, []byte(ArchiveStartHeightKey)); err == nil {
+		return nil
+	} else if errors.Cause(err) != db.ErrNotExist {
+		return errors.Wrap(err, "failed to get archive start height from underlying DB")
+	}
+	var startHeight uint64
+	height, err := sf.dao.Get(AccountKVNameSpace, []byte(CurrentHeightKey))
+	switch errors.Cause(err) {
+	case nil:
+		startHeight = byteutil.BytesToUint64(height)
+	case db.ErrNotExist:
+	default:
+		return errors.Wrap(err, "failed to get factory's height from underlying DB")
+	}
+	return sf.dao.Put(AccountKVNameSpace, []byte(ArchiveStartHeightKey), byteutil.Uint64ToBytes(startHeight))
+}
+
 func (sf *factory) rootHash() hash.Hash256 {
 	return hash.BytesToHash256(sf.accountTrie.RootHash())
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/iotexproject/iotex-core/address"
 	"github.com/iotexproject/iotex-core/config"
 	"github.com/iotexproject/iotex-core/db"
+	"github.com/iotexproject/iotex-core/db/trie"
 	"github.com/iotexproject/iotex-core/pkg/enc"
 	"github.com/iotexproject/iotex-core/pkg/hash"
 	"github.com/iotexproject/iotex-core/pkg/keypair"
@@ -541,6 +542,98 @@ func TestFactory_RootHashByHeight(t *testing.T) {
 	require.NotEqual(t, hash.ZeroHash256, rootHash)
 }
 
+func TestFactory_AccountStateAtHeight(t *testing.T) {
+	cfg := config.Default
+	cfg.Chain.EnableArchiveMode = true
+	sf, err := NewFactory(cfg, InMemTrieOption())
+	require.NoError(t, err)
+	testAccountStateAtHeight(sf, t)
+	a := testaddress.Addrinfo["alfa"].String()
+	for i := uint64(1); i <= 3; i++ {
+		account, err := sf.AccountStateAtHeight(a, i)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(int64(i*10)), account.Balance)
+	}
+	_, err = sf.AccountStateAtHeight(a, 4)
+	require.Error(t, err)
+
+	// only the current state is available if not in archive mode
+	kv := db.NewMemKVStore()
+	sf, err = NewFactory(config.Default, PrecreatedTrieDBOption(kv))
+	require.NoError(t, err)
+	testAccountStateAtHeight(sf, t)
+	_, err = sf.AccountStateAtHeight(a, 1)
+	require.Equal(t, ErrNotArchiveMode, errors.Cause(err))
+	account, err := sf.AccountStateAtHeight(a, 3)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30), account.Balance)
+
+	// the states before archive mode is turned on are pruned
+	sf, err = NewFactory(cfg, PrecreatedTrieDBOption(kv))
+	require.NoError(t, err)
+	require.NoError(t, sf.Start(context.Background()))
+	_, err = sf.AccountStateAtHeight(a, 2)
+	require.Equal(t, ErrStatePruned, errors.Cause(err))
+}
+
+func TestSDBAccountStateAtHeight(t *testing.T) {
+	sdb, err := NewStateDB(config.Default, InMemStateDBOption())
+	require.NoError(t, err)
+	testAccountStateAtHeight(sdb, t)
+	a := testaddress.Addrinfo["alfa"].String()
+	_, err = sdb.AccountStateAtHeight(a, 2)
+	require.Equal(t, ErrNotArchiveMode, errors.Cause(err))
+	account, err := sdb.AccountStateAtHeight(a, 3)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(30), account.Balance)
+}
+
+// testAccountStateAtHeight commits 3 heights, and the balance of alfa is 10 times the height
+func testAccountStateAtHeight(sf Factory, t *testing.T) {
+	ctx := context.Background()
+	require.NoError(t, sf.Start(ctx))
+	a := testaddress.Addrinfo["alfa"].String()
+	for i := uint64(1); i <= 3; i++ {
+		ws, err := sf.NewWorkingSet()
+		require.NoError(t, err)
+		account, err := accountutil.LoadOrCreateAccount(ws, a, big.NewInt(0))
+		require.NoError(t, err)
+		account.Balance = big.NewInt(int64(i * 10))
+		require.NoError(t, accountutil.StoreAccount(ws, a, account))
+		_, err = ws.RunActions(ctx, i, nil)
+		require.NoError(t, err)
+		require.NoError(t, sf.Commit(ws))
+	}
+}
+
+func TestFactory_ProveState(t *testing.T) {
+	sf, err := NewFactory(config.Default, InMemTrieOption())
+	require.NoError(t, err)
+	testAccountStateAtHeight(sf, t)
+	addrHash := hash.BytesToHash160(testaddress.Addrinfo["alfa"].Bytes())
+	root, proof, err := sf.ProveState(addrHash, nil)
+	require.NoError(t, err)
+	require.Equal(t, sf.RootHash(), root)
+	data, err := trie.VerifyProof(root[:], addrHash[:], proof)
+	require.NoError(t, err)
+	var account state.Account
+	require.NoError(t, account.Deserialize(data))
+	require.Equal(t, big.NewInt(30), account.Balance)
+	_, _, err = sf.ProveState(hash.BytesToHash160(testaddress.Addrinfo["bravo"].Bytes()), nil)
+	require.Equal(t, state.ErrStateNotExist, errors.Cause(err))
+
+	sdb, err := NewStateDB(config.Default, InMemStateDBOption())
+	require.NoError(t, err)
+	// the data under the state is proved under the same lock
+	_, _, err = sf.ProveState(addrHash, func(_ db.KVStore, account *state.Account) error {
+		require.Equal(t, big.NewInt(30), account.Balance)
+		return ErrNoTrie
+	})
+	require.Equal(t, ErrNoTrie, errors.Cause(err))
+	_, _, err = sdb.ProveState(addrHash, nil)
+	require.Equal(t, ErrNoTrie, errors.Cause(err))
+}
+
 func TestRunActions(t *testing.T) {
 	sf, err := NewFactory(config.Default, InMemTrieOption())
 	require.NoError(t, err)
@@ -48,7 +48,7 @@ func DefaultStateDBOption() StateDBOption {
 			return errors.New("Invalid empty trie db path")
 		}
 		cfg.DB.DbPath = dbPath // TODO: remove this after moving TrieDBPath from cfg.Chain to cfg.DB
-		sdb.dao = db.NewOnDiskDB(cfg.DB)
+		sdb.dao = db.NewInstrumentedKVStore("state", db.NewOnDiskDB(cfg.DB))
 		return nil
 	}
 }
@@ -89,7 +89,10 @@ func NewStateDB(cfg config.Config, opts ...StateDBOption) (Factory, error) {
 func (sdb *stateDB) Start(ctx context.Context) error {
 	sdb.mutex.Lock()
 	defer sdb.mutex.Unlock()
-	return sdb.dao.Start(ctx)
+	if err := sdb.dao.Start(ctx); err != nil {
+		return err
+	}
+	return openSchema(sdb.dao, stateDBSchema)
 }
 
 func (sdb *stateDB) Stop(ctx context.Context) error {
@@ -98,6 +101,13 @@ func (sdb *stateDB) Stop(ctx context.Context) error {
 	return sdb.dao.Stop(ctx)
 }
 
+// TakeSnapshot takes a snapshot of the underlying DB
+func (sdb *stateDB) TakeSnapshot() (db.Snapshot, error) {
+	sdb.mutex.RLock()
+	defer sdb.mutex.RUnlock()
+	return db.TakeSnapshot(sdb.dao)
+}
+
 // AddActionHandlers adds action handlers to the state factory
 func (sdb *stateDB) AddActionHandlers(actionHandlers ...protocol.ActionHandler) {
 	sdb.mutex.Lock()
@@ -137,6 +147,14 @@ func (sdb *stateDB) AccountState(addr string) (*state.Account, error) {
 	return sdb.accountState(addr)
 }
 
+// AccountStateAtHeight returns the confirmed account state at a given height, which has to be the current height
+func (sdb *stateDB) AccountStateAtHeight(addr string, height uint64) (*state.Account, error) {
+	if err := sdb.checkCurrentHeight(height); err != nil {
+		return nil, err
+	}
+	return sdb.AccountState(addr)
+}
+
 // RootHash returns the hash of the root node of the state trie
 func (sdb *stateDB) RootHash() hash.Hash256 { return hash.ZeroHash256 }
 
@@ -162,6 +180,14 @@ func (sdb *stateDB) NewWorkingSet() (WorkingSet, error) {
 	return newStateTX(sdb.currentChainHeight, sdb.dao, sdb.actionHandlers), nil
 }
 
+// NewWorkingSetAtHeight returns a working set at a given height, which has to be the current height
+func (sdb *stateDB) NewWorkingSetAtHeight(height uint64) (WorkingSet, error) {
+	if err := sdb.checkCurrentHeight(height); err != nil {
+		return nil, err
+	}
+	return sdb.NewWorkingSet()
+}
+
 // Commit persists all changes in RunActions() into the DB
 func (sdb *stateDB) Commit(ws WorkingSet) error {
 	if ws == nil {
@@ -225,10 +251,33 @@ func (sdb *stateDB) State(addr hash.Hash160, state interface{}) error {
 	return sdb.state(addr, state)
 }
 
+// ProveState is not supported by stateDB, which doesn't keep the states in a trie
+func (sdb *stateDB) ProveState(
+	addr hash.Hash160,
+	_ func(db.KVStore, *state.Account) error,
+) (hash.Hash256, [][]byte, error) {
+	return hash.ZeroHash256, nil, errors.Wrapf(ErrNoTrie, "failed to prove the state of %x", addr)
+}
+
 //======================================
 // private trie constructor functions
 //======================================
 
+// checkCurrentHeight makes sure the height is the current one, as stateDB doesn't keep the history states
+func (sdb *stateDB) checkCurrentHeight(height uint64) error {
+	currentHeight, err := sdb.Height()
+	if err != nil {
+		return err
+	}
+	if height > currentHeight {
+		return errors.Errorf("height %d is higher than current height %d", height, currentHeight)
+	}
+	if height < currentHeight {
+		return errors.Wrapf(ErrNotArchiveMode, "failed to get state at height %d", height)
+	}
+	return nil
+}
+
 func (sdb *stateDB) state(addr hash.Hash160, s interface{}) error {
 	data, err := sdb.dao.Get(AccountKVNameSpace, addr[:])
 	if err != nil {
@@ -85,6 +85,9 @@ func (stx *stateTX) RunAction(
 	raCtx protocol.RunActionsCtx,
 	elp action.SealedEnvelope,
 ) (*action.Receipt, error) {
+	if bundle, ok := elp.Action().(*action.Bundle); ok {
+		return runBundle(stx, raCtx, elp, bundle)
+	}
 	// Handle action
 	// Add caller address into the run action context
 	callerAddr, err := address.FromBytes(elp.SrcPubkey().Hash())
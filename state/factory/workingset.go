@@ -158,6 +158,9 @@ func (ws *workingSet) RunAction(
 	raCtx protocol.RunActionsCtx,
 	elp action.SealedEnvelope,
 ) (*action.Receipt, error) {
+	if bundle, ok := elp.Action().(*action.Bundle); ok {
+		return runBundle(ws, raCtx, elp, bundle)
+	}
 	// Handle action
 	// Add caller address into the run action context
 	caller, err := address.FromBytes(elp.SrcPubkey().Hash())
@@ -187,6 +190,10 @@ func (ws *workingSet) RunAction(
 			)
 		}
 		if receipt != nil {
+			// Since web3 is enabled, the receipt carries the hash of its action even if the handler leaves it out
+			if receipt.ActHash == hash.ZeroHash256 && raCtx.BlockHeight >= raCtx.Web3Height {
+				receipt.ActHash = raCtx.ActionHash
+			}
 			return receipt, nil
 		}
 	}
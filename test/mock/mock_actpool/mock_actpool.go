@@ -5,13 +5,58 @@
 package mock_actpool
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	action "github.com/iotexproject/iotex-core/action"
 	protocol "github.com/iotexproject/iotex-core/action/protocol"
+	actpool "github.com/iotexproject/iotex-core/actpool"
 	hash "github.com/iotexproject/iotex-core/pkg/hash"
 	reflect "reflect"
 )
 
+// MockSubscriber is a mock of Subscriber interface
+type MockSubscriber struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriberMockRecorder
+}
+
+// MockSubscriberMockRecorder is the mock recorder for MockSubscriber
+type MockSubscriberMockRecorder struct {
+	mock *MockSubscriber
+}
+
+// NewMockSubscriber creates a new mock instance
+func NewMockSubscriber(ctrl *gomock.Controller) *MockSubscriber {
+	mock := &MockSubscriber{ctrl: ctrl}
+	mock.recorder = &MockSubscriberMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockSubscriber) EXPECT() *MockSubscriberMockRecorder {
+	return m.recorder
+}
+
+// HandleAcceptedAction mocks base method
+func (m *MockSubscriber) HandleAcceptedAction(act action.SealedEnvelope) {
+	m.ctrl.Call(m, "HandleAcceptedAction", act)
+}
+
+// HandleAcceptedAction indicates an expected call of HandleAcceptedAction
+func (mr *MockSubscriberMockRecorder) HandleAcceptedAction(act interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleAcceptedAction", reflect.TypeOf((*MockSubscriber)(nil).HandleAcceptedAction), act)
+}
+
+// HandleDroppedAction mocks base method
+func (m *MockSubscriber) HandleDroppedAction(act action.SealedEnvelope, reason error) {
+	m.ctrl.Call(m, "HandleDroppedAction", act, reason)
+}
+
+// HandleDroppedAction indicates an expected call of HandleDroppedAction
+func (mr *MockSubscriberMockRecorder) HandleDroppedAction(act, reason interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleDroppedAction", reflect.TypeOf((*MockSubscriber)(nil).HandleDroppedAction), act, reason)
+}
+
 // MockActPool is a mock of ActPool interface
 type MockActPool struct {
 	ctrl     *gomock.Controller
@@ -35,6 +80,30 @@ func (m *MockActPool) EXPECT() *MockActPoolMockRecorder {
 	return m.recorder
 }
 
+// Start mocks base method
+func (m *MockActPool) Start(arg0 context.Context) error {
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start
+func (mr *MockActPoolMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockActPool)(nil).Start), arg0)
+}
+
+// Stop mocks base method
+func (m *MockActPool) Stop(arg0 context.Context) error {
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop
+func (mr *MockActPoolMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockActPool)(nil).Stop), arg0)
+}
+
 // Reset mocks base method
 func (m *MockActPool) Reset() {
 	m.ctrl.Call(m, "Reset")
@@ -69,6 +138,40 @@ func (mr *MockActPoolMockRecorder) Add(act interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockActPool)(nil).Add), act)
 }
 
+// AddLocal mocks base method
+func (m *MockActPool) AddLocal(act action.SealedEnvelope) error {
+	ret := m.ctrl.Call(m, "AddLocal", act)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddLocal indicates an expected call of AddLocal
+func (mr *MockActPoolMockRecorder) AddLocal(act interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLocal", reflect.TypeOf((*MockActPool)(nil).AddLocal), act)
+}
+
+// AddFromPeer mocks base method
+func (m *MockActPool) AddFromPeer(act action.SealedEnvelope, peer string) error {
+	ret := m.ctrl.Call(m, "AddFromPeer", act, peer)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFromPeer indicates an expected call of AddFromPeer
+func (mr *MockActPoolMockRecorder) AddFromPeer(act, peer interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFromPeer", reflect.TypeOf((*MockActPool)(nil).AddFromPeer), act, peer)
+}
+
+// DropAction mocks base method
+func (m *MockActPool) DropAction(act action.SealedEnvelope, reason error) {
+	m.ctrl.Call(m, "DropAction", act, reason)
+}
+
+// DropAction indicates an expected call of DropAction
+func (mr *MockActPoolMockRecorder) DropAction(act, reason interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropAction", reflect.TypeOf((*MockActPool)(nil).DropAction), act, reason)
+}
+
 // GetPendingNonce mocks base method
 func (m *MockActPool) GetPendingNonce(addr string) (uint64, error) {
 	ret := m.ctrl.Call(m, "GetPendingNonce", addr)
@@ -131,6 +234,30 @@ func (mr *MockActPoolMockRecorder) GetCapacity() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCapacity", reflect.TypeOf((*MockActPool)(nil).GetCapacity))
 }
 
+// GetStatus mocks base method
+func (m *MockActPool) GetStatus() actpool.Status {
+	ret := m.ctrl.Call(m, "GetStatus")
+	ret0, _ := ret[0].(actpool.Status)
+	return ret0
+}
+
+// GetStatus indicates an expected call of GetStatus
+func (mr *MockActPoolMockRecorder) GetStatus() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockActPool)(nil).GetStatus))
+}
+
+// GetContent mocks base method
+func (m *MockActPool) GetContent() map[string]actpool.AccountContent {
+	ret := m.ctrl.Call(m, "GetContent")
+	ret0, _ := ret[0].(map[string]actpool.AccountContent)
+	return ret0
+}
+
+// GetContent indicates an expected call of GetContent
+func (mr *MockActPoolMockRecorder) GetContent() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContent", reflect.TypeOf((*MockActPool)(nil).GetContent))
+}
+
 // AddActionValidators mocks base method
 func (m *MockActPool) AddActionValidators(arg0 ...protocol.ActionValidator) {
 	varargs := []interface{}{}
@@ -158,3 +285,27 @@ func (m *MockActPool) AddActionEnvelopeValidators(arg0 ...protocol.ActionEnvelop
 func (mr *MockActPoolMockRecorder) AddActionEnvelopeValidators(arg0 ...interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActionEnvelopeValidators", reflect.TypeOf((*MockActPool)(nil).AddActionEnvelopeValidators), arg0...)
 }
+
+// AddSubscriber mocks base method
+func (m *MockActPool) AddSubscriber(arg0 actpool.Subscriber) error {
+	ret := m.ctrl.Call(m, "AddSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddSubscriber indicates an expected call of AddSubscriber
+func (mr *MockActPoolMockRecorder) AddSubscriber(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSubscriber", reflect.TypeOf((*MockActPool)(nil).AddSubscriber), arg0)
+}
+
+// RemoveSubscriber mocks base method
+func (m *MockActPool) RemoveSubscriber(arg0 actpool.Subscriber) error {
+	ret := m.ctrl.Call(m, "RemoveSubscriber", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveSubscriber indicates an expected call of RemoveSubscriber
+func (mr *MockActPoolMockRecorder) RemoveSubscriber(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSubscriber", reflect.TypeOf((*MockActPool)(nil).RemoveSubscriber), arg0)
+}
@@ -6,11 +6,13 @@ package mock_blockchain
 
 import (
 	context "context"
+	vm "github.com/ethereum/go-ethereum/core/vm"
 	gomock "github.com/golang/mock/gomock"
 	action "github.com/iotexproject/iotex-core/action"
 	address "github.com/iotexproject/iotex-core/address"
 	blockchain "github.com/iotexproject/iotex-core/blockchain"
 	block "github.com/iotexproject/iotex-core/blockchain/block"
+	genesis "github.com/iotexproject/iotex-core/blockchain/genesis"
 	hash "github.com/iotexproject/iotex-core/pkg/hash"
 	state "github.com/iotexproject/iotex-core/state"
 	factory "github.com/iotexproject/iotex-core/state/factory"
@@ -195,6 +197,32 @@ func (mr *MockBlockchainMockRecorder) GetReceiptByActionHash(h interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptByActionHash", reflect.TypeOf((*MockBlockchain)(nil).GetReceiptByActionHash), h)
 }
 
+// GetReceiptsByHeight mocks base method
+func (m *MockBlockchain) GetReceiptsByHeight(height uint64) ([]*action.Receipt, error) {
+	ret := m.ctrl.Call(m, "GetReceiptsByHeight", height)
+	ret0, _ := ret[0].([]*action.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceiptsByHeight indicates an expected call of GetReceiptsByHeight
+func (mr *MockBlockchainMockRecorder) GetReceiptsByHeight(height interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceiptsByHeight", reflect.TypeOf((*MockBlockchain)(nil).GetReceiptsByHeight), height)
+}
+
+// GetLogs mocks base method
+func (m *MockBlockchain) GetLogs(filter *blockchain.LogFilter, start, end uint64) ([]*action.Log, error) {
+	ret := m.ctrl.Call(m, "GetLogs", filter, start, end)
+	ret0, _ := ret[0].([]*action.Log)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLogs indicates an expected call of GetLogs
+func (mr *MockBlockchainMockRecorder) GetLogs(filter, start, end interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLogs", reflect.TypeOf((*MockBlockchain)(nil).GetLogs), filter, start, end)
+}
+
 // GetActionsFromAddress mocks base method
 func (m *MockBlockchain) GetActionsFromAddress(address string) ([]hash.Hash256, error) {
 	ret := m.ctrl.Call(m, "GetActionsFromAddress", address)
@@ -271,6 +299,18 @@ func (mr *MockBlockchainMockRecorder) ChainID() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainID", reflect.TypeOf((*MockBlockchain)(nil).ChainID))
 }
 
+// Genesis mocks base method
+func (m *MockBlockchain) Genesis() genesis.Genesis {
+	ret := m.ctrl.Call(m, "Genesis")
+	ret0, _ := ret[0].(genesis.Genesis)
+	return ret0
+}
+
+// Genesis indicates an expected call of Genesis
+func (mr *MockBlockchainMockRecorder) Genesis() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Genesis", reflect.TypeOf((*MockBlockchain)(nil).Genesis))
+}
+
 // ChainAddress mocks base method
 func (m *MockBlockchain) ChainAddress() string {
 	ret := m.ctrl.Call(m, "ChainAddress")
@@ -320,6 +360,19 @@ func (mr *MockBlockchainMockRecorder) StateByAddr(address interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateByAddr", reflect.TypeOf((*MockBlockchain)(nil).StateByAddr), address)
 }
 
+// StateByAddrAtHeight mocks base method
+func (m *MockBlockchain) StateByAddrAtHeight(address string, height uint64) (*state.Account, error) {
+	ret := m.ctrl.Call(m, "StateByAddrAtHeight", address, height)
+	ret0, _ := ret[0].(*state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StateByAddrAtHeight indicates an expected call of StateByAddrAtHeight
+func (mr *MockBlockchainMockRecorder) StateByAddrAtHeight(address, height interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StateByAddrAtHeight", reflect.TypeOf((*MockBlockchain)(nil).StateByAddrAtHeight), address, height)
+}
+
 // RecoverChainAndState mocks base method
 func (m *MockBlockchain) RecoverChainAndState(targetHeight uint64) error {
 	ret := m.ctrl.Call(m, "RecoverChainAndState", targetHeight)
@@ -332,17 +385,35 @@ func (mr *MockBlockchainMockRecorder) RecoverChainAndState(targetHeight interfac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverChainAndState", reflect.TypeOf((*MockBlockchain)(nil).RecoverChainAndState), targetHeight)
 }
 
+// Backup mocks base method
+func (m *MockBlockchain) Backup(dir string) (*blockchain.BackupManifest, error) {
+	ret := m.ctrl.Call(m, "Backup", dir)
+	ret0, _ := ret[0].(*blockchain.BackupManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Backup indicates an expected call of Backup
+func (mr *MockBlockchainMockRecorder) Backup(dir interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Backup", reflect.TypeOf((*MockBlockchain)(nil).Backup), dir)
+}
+
 // MintNewBlock mocks base method
-func (m *MockBlockchain) MintNewBlock(actionMap map[string][]action.SealedEnvelope, timestamp int64) (*block.Block, error) {
-	ret := m.ctrl.Call(m, "MintNewBlock", actionMap, timestamp)
+func (m *MockBlockchain) MintNewBlock(actionMap map[string][]action.SealedEnvelope, timestamp int64, opts ...blockchain.MintOption) (*block.Block, error) {
+	varargs := []interface{}{actionMap, timestamp}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MintNewBlock", varargs...)
 	ret0, _ := ret[0].(*block.Block)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // MintNewBlock indicates an expected call of MintNewBlock
-func (mr *MockBlockchainMockRecorder) MintNewBlock(actionMap, timestamp interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintNewBlock", reflect.TypeOf((*MockBlockchain)(nil).MintNewBlock), actionMap, timestamp)
+func (mr *MockBlockchainMockRecorder) MintNewBlock(actionMap, timestamp interface{}, opts ...interface{}) *gomock.Call {
+	varargs := append([]interface{}{actionMap, timestamp}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MintNewBlock", reflect.TypeOf((*MockBlockchain)(nil).MintNewBlock), varargs...)
 }
 
 // CommitBlock mocks base method
@@ -404,6 +475,45 @@ func (mr *MockBlockchainMockRecorder) ExecuteContractRead(caller, ex interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteContractRead", reflect.TypeOf((*MockBlockchain)(nil).ExecuteContractRead), caller, ex)
 }
 
+// ExecuteContractReadAtHeight mocks base method
+func (m *MockBlockchain) ExecuteContractReadAtHeight(caller address.Address, ex *action.Execution, height uint64) (*action.Receipt, error) {
+	ret := m.ctrl.Call(m, "ExecuteContractReadAtHeight", caller, ex, height)
+	ret0, _ := ret[0].(*action.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteContractReadAtHeight indicates an expected call of ExecuteContractReadAtHeight
+func (mr *MockBlockchainMockRecorder) ExecuteContractReadAtHeight(caller, ex, height interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteContractReadAtHeight", reflect.TypeOf((*MockBlockchain)(nil).ExecuteContractReadAtHeight), caller, ex, height)
+}
+
+// TraceAction mocks base method
+func (m *MockBlockchain) TraceAction(actHash hash.Hash256, tracer vm.Tracer) (*action.Receipt, error) {
+	ret := m.ctrl.Call(m, "TraceAction", actHash, tracer)
+	ret0, _ := ret[0].(*action.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceAction indicates an expected call of TraceAction
+func (mr *MockBlockchainMockRecorder) TraceAction(actHash, tracer interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceAction", reflect.TypeOf((*MockBlockchain)(nil).TraceAction), actHash, tracer)
+}
+
+// TraceContractRead mocks base method
+func (m *MockBlockchain) TraceContractRead(caller address.Address, ex *action.Execution, tracer vm.Tracer) (*action.Receipt, error) {
+	ret := m.ctrl.Call(m, "TraceContractRead", caller, ex, tracer)
+	ret0, _ := ret[0].(*action.Receipt)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TraceContractRead indicates an expected call of TraceContractRead
+func (mr *MockBlockchainMockRecorder) TraceContractRead(caller, ex, tracer interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceContractRead", reflect.TypeOf((*MockBlockchain)(nil).TraceContractRead), caller, ex, tracer)
+}
+
 // AddSubscriber mocks base method
 func (m *MockBlockchain) AddSubscriber(arg0 blockchain.BlockCreationSubscriber) error {
 	ret := m.ctrl.Call(m, "AddSubscriber", arg0)
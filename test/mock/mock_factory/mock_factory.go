@@ -8,6 +8,7 @@ import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
 	protocol "github.com/iotexproject/iotex-core/action/protocol"
+	db "github.com/iotexproject/iotex-core/db"
 	hash "github.com/iotexproject/iotex-core/pkg/hash"
 	state "github.com/iotexproject/iotex-core/state"
 	factory "github.com/iotexproject/iotex-core/state/factory"
@@ -101,6 +102,19 @@ func (mr *MockFactoryMockRecorder) AccountState(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountState", reflect.TypeOf((*MockFactory)(nil).AccountState), arg0)
 }
 
+// AccountStateAtHeight mocks base method
+func (m *MockFactory) AccountStateAtHeight(arg0 string, arg1 uint64) (*state.Account, error) {
+	ret := m.ctrl.Call(m, "AccountStateAtHeight", arg0, arg1)
+	ret0, _ := ret[0].(*state.Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AccountStateAtHeight indicates an expected call of AccountStateAtHeight
+func (mr *MockFactoryMockRecorder) AccountStateAtHeight(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccountStateAtHeight", reflect.TypeOf((*MockFactory)(nil).AccountStateAtHeight), arg0, arg1)
+}
+
 // RootHash mocks base method
 func (m *MockFactory) RootHash() hash.Hash256 {
 	ret := m.ctrl.Call(m, "RootHash")
@@ -152,6 +166,19 @@ func (mr *MockFactoryMockRecorder) NewWorkingSet() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkingSet", reflect.TypeOf((*MockFactory)(nil).NewWorkingSet))
 }
 
+// NewWorkingSetAtHeight mocks base method
+func (m *MockFactory) NewWorkingSetAtHeight(arg0 uint64) (factory.WorkingSet, error) {
+	ret := m.ctrl.Call(m, "NewWorkingSetAtHeight", arg0)
+	ret0, _ := ret[0].(factory.WorkingSet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewWorkingSetAtHeight indicates an expected call of NewWorkingSetAtHeight
+func (mr *MockFactoryMockRecorder) NewWorkingSetAtHeight(arg0 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewWorkingSetAtHeight", reflect.TypeOf((*MockFactory)(nil).NewWorkingSetAtHeight), arg0)
+}
+
 // Commit mocks base method
 func (m *MockFactory) Commit(arg0 factory.WorkingSet) error {
 	ret := m.ctrl.Call(m, "Commit", arg0)
@@ -189,6 +216,20 @@ func (mr *MockFactoryMockRecorder) State(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "State", reflect.TypeOf((*MockFactory)(nil).State), arg0, arg1)
 }
 
+// ProveState mocks base method
+func (m *MockFactory) ProveState(arg0 hash.Hash160, arg1 func(db.KVStore, *state.Account) error) (hash.Hash256, [][]byte, error) {
+	ret := m.ctrl.Call(m, "ProveState", arg0, arg1)
+	ret0, _ := ret[0].(hash.Hash256)
+	ret1, _ := ret[1].([][]byte)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ProveState indicates an expected call of ProveState
+func (mr *MockFactoryMockRecorder) ProveState(arg0, arg1 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProveState", reflect.TypeOf((*MockFactory)(nil).ProveState), arg0, arg1)
+}
+
 // AddActionHandlers mocks base method
 func (m *MockFactory) AddActionHandlers(arg0 ...protocol.ActionHandler) {
 	varargs := []interface{}{}
@@ -202,3 +243,16 @@ func (m *MockFactory) AddActionHandlers(arg0 ...protocol.ActionHandler) {
 func (mr *MockFactoryMockRecorder) AddActionHandlers(arg0 ...interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActionHandlers", reflect.TypeOf((*MockFactory)(nil).AddActionHandlers), arg0...)
 }
+
+// TakeSnapshot mocks base method
+func (m *MockFactory) TakeSnapshot() (db.Snapshot, error) {
+	ret := m.ctrl.Call(m, "TakeSnapshot")
+	ret0, _ := ret[0].(db.Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TakeSnapshot indicates an expected call of TakeSnapshot
+func (mr *MockFactoryMockRecorder) TakeSnapshot() *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TakeSnapshot", reflect.TypeOf((*MockFactory)(nil).TakeSnapshot))
+}
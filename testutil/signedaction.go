@@ -68,3 +68,39 @@ func SignedExecution(contractAddr string, executorPriKey keypair.PrivateKey, non
 	}
 	return selp, nil
 }
+
+// SignedBundle return a signed bundle of the given actions
+func SignedBundle(senderPriKey keypair.PrivateKey, actions []action.Envelope) (action.SealedEnvelope, error) {
+	bundle, err := action.NewBundle(actions)
+	if err != nil {
+		return action.SealedEnvelope{}, err
+	}
+	bd := &action.EnvelopeBuilder{}
+	elp := bd.SetNonce(bundle.Nonce()).
+		SetGasPrice(bundle.GasPrice()).
+		SetGasLimit(bundle.GasLimit()).
+		SetAction(bundle).Build()
+	selp, err := action.Sign(elp, senderPriKey)
+	if err != nil {
+		return action.SealedEnvelope{}, errors.Wrapf(err, "failed to sign bundle %v", elp)
+	}
+	return selp, nil
+}
+
+// SignedMultiTransfer return a signed multi-transfer
+func SignedMultiTransfer(senderPriKey keypair.PrivateKey, nonce uint64, entries []action.TransferEntry, gasLimit uint64, gasPrice *big.Int) (action.SealedEnvelope, error) {
+	mt, err := action.NewMultiTransfer(nonce, entries, gasLimit, gasPrice)
+	if err != nil {
+		return action.SealedEnvelope{}, err
+	}
+	bd := &action.EnvelopeBuilder{}
+	elp := bd.SetNonce(nonce).
+		SetGasPrice(gasPrice).
+		SetGasLimit(gasLimit).
+		SetAction(mt).Build()
+	selp, err := action.Sign(elp, senderPriKey)
+	if err != nil {
+		return action.SealedEnvelope{}, errors.Wrapf(err, "failed to sign multi-transfer %v", elp)
+	}
+	return selp, nil
+}
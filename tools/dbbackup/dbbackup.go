@@ -0,0 +1,95 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// This is a tool that asks a running node to back up its databases through the admin http endpoint, or verifies a
+// backup against its manifest before it is restored
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	glog "log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+)
+
+var (
+	// adminEndpoint is the admin http endpoint of the node
+	adminEndpoint string
+	// backupDir is the directory of the backup
+	backupDir string
+	// chainID is the ID of the chain to back up
+	chainID uint
+	// verify tells to verify the backup instead of taking one
+	verify bool
+	// timeout is the timeout of taking a backup
+	timeout time.Duration
+)
+
+func init() {
+	flag.StringVar(&adminEndpoint, "admin-endpoint", "127.0.0.1:9009", "Admin http endpoint of the node")
+	flag.StringVar(&backupDir, "dir", "", "Backup directory, which must not exist when taking a backup")
+	flag.UintVar(&chainID, "chain-id", 0, "Chain ID, the root chain if 0")
+	flag.BoolVar(&verify, "verify", false, "Verify the backup against its manifest with the node config")
+	flag.DurationVar(&timeout, "timeout", time.Hour, "Timeout of taking a backup")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr,
+			"usage: dbbackup -dir=[string]\n -admin-endpoint=[string]\n -chain-id=[uint]\n"+
+				" or: dbbackup -verify -dir=[string] -config-path=[string]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func main() {
+	if backupDir == "" {
+		flag.Usage()
+	}
+	if verify {
+		cfg, err := config.New()
+		if err != nil {
+			glog.Fatalln("Failed to new config.", zap.Error(err))
+		}
+		manifest, err := blockchain.VerifyBackup(context.Background(), cfg, backupDir)
+		if err != nil {
+			log.L().Fatal("Failed to verify backup.", zap.Error(err))
+		}
+		log.L().Info("Verified backup.", zap.Uint64("height", manifest.Height), zap.String("blockHash", manifest.BlockHash))
+		return
+	}
+
+	query := url.Values{}
+	query.Set("dir", backupDir)
+	if chainID != 0 {
+		query.Set("chainID", strconv.FormatUint(uint64(chainID), 10))
+	}
+	client := &http.Client{Timeout: timeout}
+	resp, err := client.Post("http://"+adminEndpoint+"/backup?"+query.Encode(), "", nil)
+	if err != nil {
+		log.L().Fatal("Failed to request backup.", zap.Error(err))
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		log.L().Fatal("Failed to read backup response.", zap.Error(err))
+	}
+	if resp.StatusCode != http.StatusOK {
+		log.L().Fatal("Failed to back up.", zap.Int("status", resp.StatusCode), zap.ByteString("error", body))
+	}
+	fmt.Print(string(body))
+}
@@ -0,0 +1,54 @@
+// Copyright (c) 2019 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// This is a tool that migrates the chain database and the trie database to the latest schema version in place.
+// The node must be stopped while the tool runs. To use, run "make migrate MIGRATE_FLAGS='-config-path=... -dry-run'"
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	glog "log"
+	"os"
+
+	"go.uber.org/zap"
+
+	"github.com/iotexproject/iotex-core/blockchain"
+	"github.com/iotexproject/iotex-core/config"
+	"github.com/iotexproject/iotex-core/pkg/log"
+	"github.com/iotexproject/iotex-core/state/factory"
+)
+
+// dryRun tells to only log the migration steps and the numbers of writes they would make
+var dryRun bool
+
+func init() {
+	flag.BoolVar(&dryRun, "dry-run", false, "Run the migrations without writing anything")
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr,
+			"usage: dbmigrator -config-path=[string]\n -dry-run=[bool]\n")
+		flag.PrintDefaults()
+		os.Exit(2)
+	}
+	flag.Parse()
+}
+
+func main() {
+	cfg, err := config.New()
+	if err != nil {
+		glog.Fatalln("Failed to new config.", zap.Error(err))
+	}
+
+	ctx := context.Background()
+	if err := blockchain.MigrateChainDB(ctx, cfg, dryRun); err != nil {
+		log.L().Fatal("Failed to migrate chain db.", zap.Error(err))
+	}
+	if err := factory.MigrateTrieDB(ctx, cfg, dryRun); err != nil {
+		log.L().Fatal("Failed to migrate trie db.", zap.Error(err))
+	}
+	log.L().Info("Migrated chain db and trie db.", zap.Bool("dryRun", dryRun))
+}